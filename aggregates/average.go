@@ -3,6 +3,8 @@ package aggregates
 import (
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/cube2222/octosql/execution/nodes"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
@@ -24,6 +26,22 @@ var AverageOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Duration,
 		Prototype:    NewAverageDurationPrototype(),
 	},
+	{
+		ArgumentType: octosql.Decimal,
+		OutputType:   octosql.Decimal,
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			t = octosql.NonNullable(t)
+			if t.TypeID != octosql.TypeIDDecimal {
+				return octosql.Type{}, false
+			}
+			if t.Decimal.Precision == 0 {
+				return octosql.Decimal, true
+			}
+			scale := averageDecimalScale(t.Decimal.Scale)
+			return octosql.NewDecimalType(t.Decimal.Precision-t.Decimal.Scale+scale, scale), true
+		},
+		Prototype: NewAverageDecimalPrototype(),
+	},
 }
 
 type AverageInt struct {
@@ -94,3 +112,36 @@ func (c *AverageDuration) Add(retraction bool, value octosql.Value) bool {
 func (c *AverageDuration) Trigger() octosql.Value {
 	return octosql.NewDuration(c.sum.Trigger().Duration / time.Duration(c.count.Trigger().Int))
 }
+
+type AverageDecimal struct {
+	sum   SumDecimal
+	count Count
+}
+
+func NewAverageDecimalPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &AverageDecimal{
+			sum:   SumDecimal{},
+			count: Count{},
+		}
+	}
+}
+
+func (c *AverageDecimal) Add(retraction bool, value octosql.Value) bool {
+	c.sum.Add(retraction, value)
+	return c.count.Add(retraction, value)
+}
+
+func (c *AverageDecimal) Trigger() octosql.Value {
+	sum := c.sum.Trigger().Decimal
+	scale := averageDecimalScale(octosql.DecimalScale(sum))
+	return octosql.NewDecimal(sum.DivRound(decimal.NewFromInt(int64(c.count.Trigger().Int)), int32(scale)))
+}
+
+// averageDecimalScale is the number of digits after the decimal point the average of decimals is rounded to.
+func averageDecimalScale(scale int) int {
+	if scale < octosql.DecimalDivisionMinScale {
+		return octosql.DecimalDivisionMinScale
+	}
+	return scale
+}
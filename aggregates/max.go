@@ -32,6 +32,17 @@ var MaxOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Time,
 		Prototype:    NewMaxPrototype(),
 	},
+	{
+		ArgumentType: octosql.Decimal,
+		OutputType:   octosql.Decimal,
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			if t := octosql.NonNullable(t); t.TypeID == octosql.TypeIDDecimal {
+				return t, true
+			}
+			return octosql.Type{}, false
+		},
+		Prototype: NewMaxPrototype(),
+	},
 }
 
 type Max struct {
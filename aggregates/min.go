@@ -27,6 +27,17 @@ var MinOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Duration,
 		Prototype:    NewMinPrototype(),
 	},
+	{
+		ArgumentType: octosql.Decimal,
+		OutputType:   octosql.Decimal,
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			if t := octosql.NonNullable(t); t.TypeID == octosql.TypeIDDecimal {
+				return t, true
+			}
+			return octosql.Type{}, false
+		},
+		Prototype: NewMinPrototype(),
+	},
 }
 
 type Min struct {
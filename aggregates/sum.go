@@ -3,6 +3,8 @@ package aggregates
 import (
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/cube2222/octosql/execution/nodes"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
@@ -24,6 +26,22 @@ var SumOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Duration,
 		Prototype:    NewSumDurationPrototype(),
 	},
+	{
+		ArgumentType: octosql.Decimal,
+		OutputType:   octosql.Decimal,
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			t = octosql.NonNullable(t)
+			if t.TypeID != octosql.TypeIDDecimal {
+				return octosql.Type{}, false
+			}
+			if t.Decimal.Precision == 0 {
+				return octosql.Decimal, true
+			}
+			// The sum keeps the scale of the values, but may need all the digits.
+			return octosql.NewDecimalType(octosql.DecimalMaxPrecision, t.Decimal.Scale), true
+		},
+		Prototype: NewSumDecimalPrototype(),
+	},
 }
 
 type SumInt struct {
@@ -100,3 +118,28 @@ func (c *SumDuration) Add(retraction bool, value octosql.Value) bool {
 func (c *SumDuration) Trigger() octosql.Value {
 	return octosql.NewDuration(c.sum)
 }
+
+type SumDecimal struct {
+	sum decimal.Decimal
+}
+
+func NewSumDecimalPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &SumDecimal{
+			sum: decimal.Decimal{},
+		}
+	}
+}
+
+func (c *SumDecimal) Add(retraction bool, value octosql.Value) bool {
+	if !retraction {
+		c.sum = c.sum.Add(value.Decimal)
+	} else {
+		c.sum = c.sum.Sub(value.Decimal)
+	}
+	return c.sum.IsZero()
+}
+
+func (c *SumDecimal) Trigger() octosql.Value {
+	return octosql.NewDecimal(c.sum)
+}
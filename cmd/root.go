@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"runtime/trace"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/Masterminds/semver"
 	"github.com/pkg/profile"
@@ -32,6 +34,7 @@ import (
 	"github.com/cube2222/octosql/helpers/graph"
 	"github.com/cube2222/octosql/logical"
 	"github.com/cube2222/octosql/logs"
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/optimizer"
 	"github.com/cube2222/octosql/outputs/batch"
 	"github.com/cube2222/octosql/outputs/eager"
@@ -222,16 +225,27 @@ octosql "SELECT * FROM plugins.plugins"`,
 				Databases:    databases,
 				FileHandlers: fileHandlers,
 			},
-			PhysicalConfig:  nil,
+			PhysicalConfig: map[string]interface{}{
+				"max_recursive_iterations": maxRecursiveIterations,
+			},
 			VariableContext: nil,
 		}
+		queryParameters := make(map[string]string)
+		for _, param := range params {
+			parts := strings.SplitN(param, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("invalid query parameter '%s', should be name=value", param)
+			}
+			queryParameters[parts[0]] = parts[1]
+		}
+
 		statement, err := sqlparser.Parse(args[0])
 		if err != nil {
-			return fmt.Errorf("couldn't parse query: %w", err)
+			return describeErrorPosition(args[0], fmt.Errorf("couldn't parse query: %w", err))
 		}
-		logicalPlan, outputOptions, err := parser.ParseNode(statement.(sqlparser.SelectStatement))
+		logicalPlan, outputOptions, err := parser.ParseNode(statement.(sqlparser.SelectStatement), true)
 		if err != nil {
-			return fmt.Errorf("couldn't parse query: %w", err)
+			return describeErrorPosition(args[0], fmt.Errorf("couldn't parse query: %w", err))
 		}
 		tableValuedFunctions := map[string]logical.TableValuedFunctionDescription{
 			"max_diff_watermark": table_valued_functions.MaxDiffWatermark,
@@ -247,11 +261,12 @@ octosql "SELECT * FROM plugins.plugins"`,
 			logical.Environment{
 				CommonTableExpressions: map[string]logical.CommonTableExpression{},
 				TableValuedFunctions:   tableValuedFunctions,
+				QueryParameters:        queryParameters,
 				UniqueNameGenerator:    uniqueNameGenerator,
 			},
 		)
 		if err != nil {
-			return err
+			return describeErrorPosition(args[0], err)
 		}
 		reverseMapping := logical.ReverseMapping(mapping)
 
@@ -260,28 +275,30 @@ octosql "SELECT * FROM plugins.plugins"`,
 			physicalExpr, err := typecheckExpr(ctx, outputOptions.OrderByExpressions[i], env.WithRecordSchema(physicalPlan.Schema), logical.Environment{
 				CommonTableExpressions: map[string]logical.CommonTableExpression{},
 				TableValuedFunctions:   tableValuedFunctions,
+				QueryParameters:        queryParameters,
 				UniqueVariableNames: &logical.VariableMapping{
 					Mapping: mapping,
 				},
 				UniqueNameGenerator: uniqueNameGenerator,
 			})
 			if err != nil {
-				return fmt.Errorf("couldn't typecheck order by expression with index %d: %w", i, err)
+				return describeErrorPosition(args[0], fmt.Errorf("couldn't typecheck order by expression with index %d: %w", i, err))
 			}
 			physicalOrderByExpressions[i] = physicalExpr
 		}
 		var physicalLimitExpression *physical.Expression
 		if outputOptions.Limit != nil {
-			physicalExpr, err := typecheckExpr(ctx, *outputOptions.Limit, env.WithRecordSchema(physicalPlan.Schema), logical.Environment{
+			physicalExpr, err := typecheckExpectedExpr(ctx, octosql.Int, *outputOptions.Limit, env.WithRecordSchema(physicalPlan.Schema), logical.Environment{
 				CommonTableExpressions: map[string]logical.CommonTableExpression{},
 				TableValuedFunctions:   tableValuedFunctions,
+				QueryParameters:        queryParameters,
 				UniqueVariableNames: &logical.VariableMapping{
 					Mapping: mapping,
 				},
 				UniqueNameGenerator: uniqueNameGenerator,
 			})
 			if err != nil {
-				return fmt.Errorf("couldn't typecheck limit expression with index: %w", err)
+				return describeErrorPosition(args[0], fmt.Errorf("couldn't typecheck limit expression with index: %w", err))
 			}
 			physicalLimitExpression = &physicalExpr
 		}
@@ -410,7 +427,8 @@ octosql "SELECT * FROM plugins.plugins"`,
 				output == "live_table",
 			)
 		case "csv", "json":
-			if len(orderByExpressions) > 0 || (limitExpression != nil && !physicalPlan.Schema.NoRetractions) {
+			// These formats can't express retractions, so the final results have to be computed before printing them.
+			if len(orderByExpressions) > 0 || !physicalPlan.Schema.NoRetractions {
 				executionPlan = nodes.NewOrderSensitiveTransform(executionPlan, orderByExpressions, logical.DirectionsToMultipliers(outputOptions.OrderByDirections), limitExpression, physicalPlan.Schema.NoRetractions)
 			} else if limitExpression != nil {
 				executionPlan = nodes.NewLimit(executionPlan, *limitExpression)
@@ -468,22 +486,26 @@ func Execute(ctx context.Context) {
 
 var describe bool
 var explain int
+var maxRecursiveIterations int
 var optimize bool
 var output string
+var params []string
 var prof string
 
 func init() {
 	rootCmd.Flags().BoolVar(&describe, "describe", false, "Describe query output schema.")
 	rootCmd.Flags().IntVar(&explain, "explain", 0, "Describe query output schema.")
+	rootCmd.Flags().IntVar(&maxRecursiveIterations, "max-recursive-iterations", logical.DefaultMaxRecursiveIterations, "Maximum number of iterations of recursive common table expressions.")
 	rootCmd.Flags().BoolVar(&optimize, "optimize", true, "Whether OctoSQL should optimize the query.")
 	rootCmd.Flags().StringVarP(&output, "output", "o", "live_table", "Output format to use. Available options are live_table, batch_table, csv, json and stream_native.")
+	rootCmd.Flags().StringArrayVar(&params, "param", nil, "Query parameter value as name=value, can be repeated. Use the name for :name placeholders and the number for $1 placeholders. Values are Strings, unless compared with a value of another type or cast, like :name::int.")
 	rootCmd.Flags().StringVar(&prof, "profile", "", "Enable profiling of the given type: cpu, memory, trace.")
 }
 
 func typecheckNode(ctx context.Context, node logical.Node, env physical.Environment, logicalEnv logical.Environment) (_ physical.Node, _ map[string]string, outErr error) {
 	defer func() {
 		if r := recover(); r != nil {
-			outErr = fmt.Errorf("typecheck error: %s", r)
+			outErr = typecheckError(r)
 		}
 	}()
 	physicalNode, mapping := node.Typecheck(
@@ -497,7 +519,7 @@ func typecheckNode(ctx context.Context, node logical.Node, env physical.Environm
 func typecheckExpr(ctx context.Context, expr logical.Expression, env physical.Environment, logicalEnv logical.Environment) (_ physical.Expression, outErr error) {
 	defer func() {
 		if r := recover(); r != nil {
-			outErr = fmt.Errorf("typecheck error: %s", r)
+			outErr = typecheckError(r)
 		}
 	}()
 	physicalExpr := expr.Typecheck(
@@ -508,6 +530,57 @@ func typecheckExpr(ctx context.Context, expr logical.Expression, env physical.En
 	return physicalExpr, nil
 }
 
+// typecheckExpectedExpr is like typecheckExpr, but the expression must be of the expected type.
+func typecheckExpectedExpr(ctx context.Context, expected octosql.Type, expr logical.Expression, env physical.Environment, logicalEnv logical.Environment) (_ physical.Expression, outErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outErr = typecheckError(r)
+		}
+	}()
+	return logical.TypecheckExpression(ctx, env, logicalEnv, expected, expr), nil
+}
+
+// typecheckError turns the value typechecking panicked with into an error, keeping the wrapped error if there is one.
+func typecheckError(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return fmt.Errorf("typecheck error: %w", err)
+	}
+	return fmt.Errorf("typecheck error: %s", r)
+}
+
+// describeErrorPosition appends the line and column of the query the error occurred at, together with the line of the query and a caret pointing at the column.
+func describeErrorPosition(query string, err error) error {
+	var position int
+	var parseErr *sqlparser.PositionedError
+	var typecheckErr *logical.PositionedError
+	if errors.As(err, &parseErr) {
+		position = parseErr.Position
+	} else if errors.As(err, &typecheckErr) {
+		position = typecheckErr.Position
+	}
+	if position < 1 || position > len(query)+1 {
+		return err
+	}
+
+	offset := position - 1
+	lineStart := strings.LastIndex(query[:offset], "\n") + 1
+	lineEnd := len(query)
+	if index := strings.Index(query[offset:], "\n"); index != -1 {
+		lineEnd = offset + index
+	}
+	// Keep tabs in the indentation of the caret, so that it lines up with the query.
+	indentation := strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, query[lineStart:offset])
+
+	line := strings.Count(query[:offset], "\n") + 1
+	column := utf8.RuneCountInString(query[lineStart:offset]) + 1
+	return fmt.Errorf("%w\nat line %d, column %d:\n%s\n%s^", err, line, column, query[lineStart:lineEnd], indentation)
+}
+
 type fileTypeDatabaseCreator struct {
 	creator func(name string, options map[string]string) (physical.DatasourceImplementation, physical.Schema, error)
 }
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/valyala/fastjson/fastfloat"
 
 	. "github.com/cube2222/octosql/execution"
@@ -76,6 +77,17 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 				}
 			}
 
+			if octosql.Decimal.Is(d.fields[i].Type) == octosql.TypeRelationIs {
+				number, err := decimal.NewFromString(str)
+				if err == nil {
+					if number, err = octosql.FitDecimal(number, decimalAlternative(d.fields[i].Type)); err != nil {
+						return fmt.Errorf("invalid value of column '%s': %w", d.fields[i].Name, err)
+					}
+					values[i] = octosql.NewDecimal(number)
+					continue
+				}
+			}
+
 			if octosql.Float.Is(d.fields[i].Type) == octosql.TypeRelationIs {
 				float, err := fastfloat.Parse(str)
 				if err == nil {
@@ -110,3 +122,15 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 
 	return nil
 }
+
+// decimalAlternative returns the decimal type out of a possibly nullable decimal type.
+func decimalAlternative(t octosql.Type) octosql.Type {
+	if t.TypeID == octosql.TypeIDUnion {
+		for _, alternative := range t.Union.Alternatives {
+			if alternative.TypeID == octosql.TypeIDDecimal {
+				return alternative
+			}
+		}
+	}
+	return t
+}
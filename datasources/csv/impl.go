@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/files"
@@ -32,6 +33,15 @@ func Creator(separator rune) func(name string, options map[string]string) (physi
 			}
 		}
 
+		// Numbers with a fractional part are inferred as exact decimals instead of floats if the decimal option is set.
+		decimalNumbers := false
+		if decimalStr, ok := options["decimal"]; ok {
+			decimalNumbers, err = strconv.ParseBool(decimalStr)
+			if err != nil {
+				return nil, physical.Schema{}, errors.Wrap(err, "couldn't parse decimal option, must be true or false")
+			}
+		}
+
 		decoder := csv.NewReader(f)
 		decoder.Comma = separator
 		decoder.ReuseRecord = true
@@ -76,17 +86,35 @@ func Creator(separator rune) func(name string, options map[string]string) (physi
 					continue
 				}
 
-				_, err := strconv.ParseInt(str, 10, 64)
+				integer, err := strconv.ParseInt(str, 10, 64)
 				if err == nil {
 					if !filled[i] {
 						fields[i] = octosql.Int
 						filled[i] = true
+					} else if octosql.Decimal.Is(fields[i]) == octosql.TypeRelationIs {
+						// Integers in decimal columns only widen the decimal type.
+						fields[i] = octosql.TypeSum(fields[i], octosql.NewDecimal(decimal.NewFromInt(integer)).Type())
 					} else if !fields[i].Equals(octosql.Float) {
 						fields[i] = octosql.TypeSum(fields[i], octosql.Int)
 					}
 					continue
 				}
 
+				if decimalNumbers {
+					if d, err := decimal.NewFromString(str); err == nil {
+						t := octosql.NewDecimal(d).Type()
+						if !filled[i] {
+							fields[i] = t
+							filled[i] = true
+						} else if fields[i].Equals(octosql.Int) {
+							fields[i] = octosql.TypeSum(octosql.NewDecimalType(octosql.IntDecimalPrecision, 0), t)
+						} else {
+							fields[i] = octosql.TypeSum(fields[i], t)
+						}
+						continue
+					}
+				}
+
 				_, err = strconv.ParseFloat(str, 64)
 				if err == nil {
 					if !filled[i] {
@@ -53,7 +53,7 @@ func (d *functionSignaturesExecuting) Run(ctx ExecutionContext, produce ProduceF
 				case "strict":
 					row[i] = octosql.NewBoolean(descriptor.Strict)
 				case "simple_signature":
-					row[i] = octosql.NewBoolean(descriptor.TypeFn == nil)
+					row[i] = octosql.NewBoolean(descriptor.TypeFn == nil && descriptor.ConstantTypeFn == nil)
 				}
 			}
 			output = append(output, row)
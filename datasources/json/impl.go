@@ -5,12 +5,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
-	"time"
 
 	"github.com/valyala/fastjson"
 
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/files"
+	"github.com/cube2222/octosql/helpers/jsonvalues"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
@@ -22,6 +22,9 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 	}
 	defer f.Close()
 
+	// Numbers are inferred as exact decimals instead of floats if the decimal option is set.
+	decimalNumbers := options["decimal"] == "true"
+
 	fields := make(map[string]octosql.Type)
 
 	sc := bufio.NewScanner(f)
@@ -45,9 +48,9 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 
 		o.Visit(func(key []byte, v *fastjson.Value) {
 			if t, ok := fields[string(key)]; ok {
-				fields[string(key)] = octosql.TypeSum(t, getOctoSQLType(v))
+				fields[string(key)] = octosql.TypeSum(t, jsonvalues.GetOctoSQLTypeWithDecimals(v, decimalNumbers))
 			} else {
-				fields[string(key)] = getOctoSQLType(v)
+				fields[string(key)] = jsonvalues.GetOctoSQLTypeWithDecimals(v, decimalNumbers)
 			}
 		})
 	}
@@ -74,62 +77,6 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 		nil
 }
 
-func getOctoSQLType(value *fastjson.Value) octosql.Type {
-	switch value.Type() {
-	case fastjson.TypeNull:
-		return octosql.Null
-	case fastjson.TypeString:
-		v, _ := value.StringBytes()
-		if _, err := time.Parse(time.RFC3339Nano, string(v)); err == nil {
-			return octosql.Time
-		} else {
-			return octosql.String
-		}
-	case fastjson.TypeNumber:
-		return octosql.Float
-	case fastjson.TypeTrue, fastjson.TypeFalse:
-		return octosql.Boolean
-	case fastjson.TypeObject:
-		obj, _ := value.Object()
-		fields := make([]octosql.StructField, 0, obj.Len())
-		obj.Visit(func(key []byte, v *fastjson.Value) {
-			fields = append(fields, octosql.StructField{
-				Name: string(key),
-				Type: getOctoSQLType(v),
-			})
-		})
-		sort.Slice(fields, func(i, j int) bool {
-			return fields[i].Name < fields[j].Name
-		})
-		return octosql.Type{
-			TypeID: octosql.TypeIDStruct,
-			Struct: struct{ Fields []octosql.StructField }{Fields: fields},
-		}
-	case fastjson.TypeArray:
-		arr, _ := value.Array()
-		var elementType *octosql.Type
-		for i := range arr {
-			if elementType != nil {
-				t := octosql.TypeSum(*elementType, getOctoSQLType(arr[i]))
-				elementType = &t
-			} else {
-				t := getOctoSQLType(arr[i])
-				elementType = &t
-			}
-		}
-		return octosql.Type{
-			TypeID: octosql.TypeIDList,
-			List: struct {
-				Element *octosql.Type
-			}{
-				Element: elementType,
-			},
-		}
-	}
-
-	panic(fmt.Sprintf("unexhaustive json input value match: %s %+v", value.Type().String(), value))
-}
-
 type impl struct {
 	path string
 	tail bool
@@ -143,6 +90,10 @@ func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema
 	}, nil
 }
 
+func (i *impl) Unbounded() bool {
+	return i.tail
+}
+
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
 	return newPredicates, []physical.Expression{}, false
 }
@@ -58,6 +58,10 @@ func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema
 	}, nil
 }
 
+func (i *impl) Unbounded() bool {
+	return i.tail
+}
+
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
 	return newPredicates, []physical.Expression{}, false
 }
@@ -0,0 +1,88 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/segmentio/encoding/thrift"
+	"github.com/segmentio/parquet-go"
+	"github.com/segmentio/parquet-go/deprecated"
+	"github.com/segmentio/parquet-go/format"
+	"github.com/shopspring/decimal"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// decimalColumns maps the indexes of the leaf columns with the DECIMAL logical type to their decimal types.
+type decimalColumns map[int]octosql.Type
+
+// readDecimalColumns finds the decimal columns of the parquet file.
+// The parquet library doesn't expose the DECIMAL logical type of the columns it reads, so the file metadata in the footer gets decoded here.
+func readDecimalColumns(r io.ReaderAt, size int64) (decimalColumns, error) {
+	b := make([]byte, 8)
+	if _, err := r.ReadAt(b, size-8); err != nil {
+		return nil, fmt.Errorf("couldn't read footer length: %w", err)
+	}
+	footerSize := int64(binary.LittleEndian.Uint32(b[:4]))
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-(footerSize+8)); err != nil {
+		return nil, fmt.Errorf("couldn't read footer: %w", err)
+	}
+	var metadata format.FileMetaData
+	if err := thrift.Unmarshal(&thrift.CompactProtocol{}, footer, &metadata); err != nil {
+		return nil, fmt.Errorf("couldn't decode file metadata: %w", err)
+	}
+	if len(metadata.Schema) == 0 {
+		return nil, fmt.Errorf("file metadata has no schema")
+	}
+
+	// The schema elements are a depth-first traversal of the schema tree, so the leaves are in column order.
+	out := decimalColumns{}
+	columnIndex := 0
+	for _, element := range metadata.Schema[1:] {
+		if element.NumChildren > 0 {
+			continue
+		}
+		switch {
+		case element.LogicalType != nil && element.LogicalType.Decimal != nil:
+			out[columnIndex] = octosql.NewDecimalType(int(element.LogicalType.Decimal.Precision), int(element.LogicalType.Decimal.Scale))
+		case element.ConvertedType != nil && *element.ConvertedType == deprecated.Decimal && element.Precision != nil:
+			scale := 0
+			if element.Scale != nil {
+				scale = int(*element.Scale)
+			}
+			out[columnIndex] = octosql.NewDecimalType(int(*element.Precision), scale)
+		}
+		columnIndex++
+	}
+	return out, nil
+}
+
+// assignDecimal converts the unscaled decimal stored in the parquet value.
+// Byte arrays hold big-endian two's complement numbers.
+func assignDecimal(dst *octosql.Value, src parquet.Value, scale int) error {
+	if src.IsNull() {
+		*dst = octosql.ZeroValue
+		return nil
+	}
+
+	var unscaled *big.Int
+	switch src.Kind() {
+	case parquet.Int32:
+		unscaled = big.NewInt(int64(src.Int32()))
+	case parquet.Int64:
+		unscaled = big.NewInt(src.Int64())
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		bytes := src.ByteArray()
+		unscaled = new(big.Int).SetBytes(bytes)
+		if len(bytes) > 0 && bytes[0]&0x80 != 0 {
+			unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(bytes)*8)))
+		}
+	default:
+		return fmt.Errorf("invalid physical type of decimal column: %s", src.Kind())
+	}
+	*dst = octosql.NewDecimal(decimal.NewFromBigInt(unscaled, int32(-scale)))
+	return nil
+}
@@ -14,8 +14,9 @@ import (
 )
 
 type DatasourceExecuting struct {
-	path   string
-	fields []physical.SchemaField
+	path     string
+	fields   []physical.SchemaField
+	decimals decimalColumns
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
@@ -38,7 +39,7 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 		usedFields[i] = d.fields[i].Name
 	}
 	pf.Schema().MakeColumnReadRowFunc(usedFields)
-	reconstruct := reconstructFuncOfSchemaFields(pf.Schema(), usedFields)
+	reconstruct := reconstructFuncOfSchemaFields(pf.Schema(), usedFields, d.decimals)
 
 	var row parquet.Row
 	pr := parquet.NewReader(pf)
@@ -30,11 +30,16 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't open parquet file: %w", err)
 	}
+	decimals, err := readDecimalColumns(f, stat.Size())
+	if err != nil {
+		return nil, physical.Schema{}, fmt.Errorf("couldn't read decimal columns: %w", err)
+	}
 	schema := pr.Schema()
 	schemaFields := schema.Fields()
 	outSchemaFields := make([]physical.SchemaField, 0, len(schemaFields))
+	columnIndex := 0
 	for _, field := range schemaFields {
-		fieldName, fieldType, ok := getOctoSQLField(field)
+		fieldName, fieldType, ok := getOctoSQLField(field, decimals, &columnIndex)
 		if ok {
 			outSchemaFields = append(outSchemaFields, physical.SchemaField{
 				Name: fieldName,
@@ -44,20 +49,25 @@ func Creator(name string, options map[string]string) (physical.DatasourceImpleme
 	}
 
 	return &impl{
-			path: name,
+			path:     name,
+			decimals: decimals,
 		},
 		physical.NewSchema(outSchemaFields, -1, physical.WithNoRetractions(true)),
 		nil
 }
 
-func getOctoSQLField(field parquet.Field) (string, octosql.Type, bool) {
-	t, ok := getOctoSQLNode(field)
+// getOctoSQLField returns the name and type of the field.
+// The column index gets advanced past all the leaf columns of the field, as the decimal columns are looked up by it.
+func getOctoSQLField(field parquet.Field, decimals decimalColumns, columnIndex *int) (string, octosql.Type, bool) {
+	t, ok := getOctoSQLNode(field, decimals, columnIndex)
 	return field.Name(), t, ok
 }
 
-func getOctoSQLNode(node parquet.Node) (octosql.Type, bool) {
+func getOctoSQLNode(node parquet.Node, decimals decimalColumns, columnIndex *int) (octosql.Type, bool) {
 	var outType octosql.Type
 	if node.Leaf() {
+		decimalType, isDecimal := decimals[*columnIndex]
+		*columnIndex++
 		if node.Type().String() == "NULL" {
 			return octosql.Type{}, false
 		}
@@ -79,11 +89,14 @@ func getOctoSQLNode(node parquet.Node) (octosql.Type, bool) {
 		case parquet.FixedLenByteArray:
 			outType = octosql.String
 		}
+		if isDecimal {
+			outType = decimalType
+		}
 	} else {
 		switch {
 		case isList(node):
 			elem := listElementOf(node)
-			elemType, ok := getOctoSQLNode(elem)
+			elemType, ok := getOctoSQLNode(elem, decimals, columnIndex)
 			if !ok {
 				return octosql.Type{}, false
 			}
@@ -94,7 +107,7 @@ func getOctoSQLNode(node parquet.Node) (octosql.Type, bool) {
 		default:
 			var fields []octosql.StructField
 			for _, child := range node.Fields() {
-				childName, childType, ok := getOctoSQLField(child)
+				childName, childType, ok := getOctoSQLField(child, decimals, columnIndex)
 				if ok {
 					fields = append(fields, octosql.StructField{
 						Name: childName,
@@ -127,13 +140,15 @@ func getOctoSQLNode(node parquet.Node) (octosql.Type, bool) {
 }
 
 type impl struct {
-	path string
+	path     string
+	decimals decimalColumns
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
 	return &DatasourceExecuting{
-		path:   i.path,
-		fields: schema.Fields,
+		path:     i.path,
+		fields:   schema.Fields,
+		decimals: i.decimals,
 	}, nil
 }
 
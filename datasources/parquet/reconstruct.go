@@ -41,7 +41,7 @@ type levels struct {
 
 type reconstructFunc func(*octosql.Value, levels, parquet.Row) (parquet.Row, error)
 
-func reconstructFuncOfSchemaFields(node parquet.Node, usedFieldNames []string) reconstructFunc {
+func reconstructFuncOfSchemaFields(node parquet.Node, usedFieldNames []string, decimals decimalColumns) reconstructFunc {
 	var columnIndex int16 = 0
 	fields := node.Fields()
 	funcs := make([]reconstructFunc, 0, len(fields))
@@ -49,7 +49,7 @@ func reconstructFuncOfSchemaFields(node parquet.Node, usedFieldNames []string) r
 
 	for _, field := range fields {
 		var curFunc reconstructFunc
-		columnIndex, curFunc = reconstructFuncOf(columnIndex, field)
+		columnIndex, curFunc = reconstructFuncOf(columnIndex, field, decimals)
 		if slices.Contains(usedFieldNames, field.Name()) {
 			columnIndexes = append(columnIndexes, columnIndex)
 			funcs = append(funcs, curFunc)
@@ -75,18 +75,18 @@ func reconstructFuncOfSchemaFields(node parquet.Node, usedFieldNames []string) r
 	}
 }
 
-func reconstructFuncOf(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
+func reconstructFuncOf(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
 	switch {
 	case node.Optional():
-		return reconstructFuncOfOptional(columnIndex, node)
+		return reconstructFuncOfOptional(columnIndex, node, decimals)
 	case node.Repeated():
-		return reconstructFuncOfRepeated(columnIndex, node)
+		return reconstructFuncOfRepeated(columnIndex, node, decimals)
 	case isList(node):
-		return reconstructFuncOfList(columnIndex, node)
+		return reconstructFuncOfList(columnIndex, node, decimals)
 	// case isMap(node):
 	// 	return reconstructFuncOfMap(columnIndex, node)
 	default:
-		return reconstructFuncOfRequired(columnIndex, node)
+		return reconstructFuncOfRequired(columnIndex, node, decimals)
 	}
 }
 
@@ -96,8 +96,8 @@ func isList(node parquet.Node) bool {
 }
 
 //go:noinline
-func reconstructFuncOfOptional(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
-	nextColumnIndex, reconstruct := reconstructFuncOf(columnIndex, parquet.Required(node))
+func reconstructFuncOfOptional(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
+	nextColumnIndex, reconstruct := reconstructFuncOf(columnIndex, parquet.Required(node), decimals)
 	rowLength := nextColumnIndex - columnIndex
 	return nextColumnIndex, func(value *octosql.Value, levels levels, row parquet.Row) (parquet.Row, error) {
 		if !startsWith(row, columnIndex) {
@@ -119,8 +119,8 @@ func reconstructFuncOfOptional(columnIndex int16, node parquet.Node) (int16, rec
 }
 
 //go:noinline
-func reconstructFuncOfRepeated(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
-	nextColumnIndex, reconstruct := reconstructFuncOf(columnIndex, parquet.Required(node))
+func reconstructFuncOfRepeated(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
+	nextColumnIndex, reconstruct := reconstructFuncOf(columnIndex, parquet.Required(node), decimals)
 	rowLength := nextColumnIndex - columnIndex
 	return nextColumnIndex, func(value *octosql.Value, lvls levels, row parquet.Row) (parquet.Row, error) {
 		c := 10
@@ -173,17 +173,17 @@ func reconstructRepeated(columnIndex, rowLength int16, levels levels, row parque
 	return row, err
 }
 
-func reconstructFuncOfRequired(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
+func reconstructFuncOfRequired(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
 	switch {
 	case node.Leaf():
-		return reconstructFuncOfLeaf(columnIndex, node)
+		return reconstructFuncOfLeaf(columnIndex, node, decimals)
 	default:
-		return reconstructFuncOfGroup(columnIndex, node)
+		return reconstructFuncOfGroup(columnIndex, node, decimals)
 	}
 }
 
-func reconstructFuncOfList(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
-	return reconstructFuncOf(columnIndex, parquet.Repeated(listElementOf(node)))
+func reconstructFuncOfList(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
+	return reconstructFuncOf(columnIndex, parquet.Repeated(listElementOf(node)), decimals)
 }
 
 func listElementOf(node parquet.Node) parquet.Node {
@@ -236,14 +236,14 @@ func childByName(node parquet.Node, name string) parquet.Node {
 // }
 
 //go:noinline
-func reconstructFuncOfGroup(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
+func reconstructFuncOfGroup(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
 	fields := node.Fields()
 	funcs := make([]reconstructFunc, 0, len(fields))
 	columnIndexes := make([]int16, 0, len(fields))
 
 	for _, field := range fields {
 		var curFunc reconstructFunc
-		columnIndex, curFunc = reconstructFuncOf(columnIndex, field)
+		columnIndex, curFunc = reconstructFuncOf(columnIndex, field, decimals)
 		if field.Name() != "rate_code_id" {
 			columnIndexes = append(columnIndexes, columnIndex)
 			funcs = append(funcs, curFunc)
@@ -270,11 +270,15 @@ func reconstructFuncOfGroup(columnIndex int16, node parquet.Node) (int16, recons
 }
 
 //go:noinline
-func reconstructFuncOfLeaf(columnIndex int16, node parquet.Node) (int16, reconstructFunc) {
+func reconstructFuncOfLeaf(columnIndex int16, node parquet.Node, decimals decimalColumns) (int16, reconstructFunc) {
+	decimalType, isDecimal := decimals[int(columnIndex)]
 	return columnIndex + 1, func(value *octosql.Value, _ levels, row parquet.Row) (parquet.Row, error) {
 		if !startsWith(row, columnIndex) {
 			return row, fmt.Errorf("no values found in parquet row for column %d", columnIndex)
 		}
+		if isDecimal {
+			return row[1:], assignDecimal(value, row[0], decimalType.Decimal.Scale)
+		}
 		return row[1:], assignValue(value, row[0])
 	}
 }
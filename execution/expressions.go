@@ -253,6 +253,35 @@ func (e *MultiColumnQueryExpression) Evaluate(ctx ExecutionContext) (octosql.Val
 	return octosql.NewList(values), nil
 }
 
+type Exists struct {
+	source Node
+}
+
+func NewExists(source Node) *Exists {
+	return &Exists{
+		source: source,
+	}
+}
+
+func (e *Exists) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	count := 0
+	if err := e.source.Run(
+		ctx,
+		func(ctx ProduceContext, record Record) error {
+			if record.Retraction {
+				count--
+			} else {
+				count++
+			}
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error { return nil },
+	); err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't run exists subquery: %w", err)
+	}
+	return octosql.NewBoolean(count > 0), nil
+}
+
 type LayoutMapping struct {
 	Struct *struct {
 		SourceIndex   []int
@@ -432,6 +461,43 @@ func (c *Coalesce) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	return octosql.NewNull(), nil
 }
 
+type Case struct {
+	conditions        []Expression
+	values            []Expression
+	elseValue         Expression
+	objectLayoutFixer *ObjectLayoutFixer
+}
+
+func NewCase(conditions []Expression, values []Expression, elseValue Expression, objectLayoutFixer *ObjectLayoutFixer) *Case {
+	return &Case{
+		conditions:        conditions,
+		values:            values,
+		elseValue:         elseValue,
+		objectLayoutFixer: objectLayoutFixer,
+	}
+}
+
+func (c *Case) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	for i := range c.conditions {
+		condition, err := c.conditions[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE condition: %w", i, err)
+		}
+		if condition.TypeID == octosql.TypeIDBoolean && condition.Boolean {
+			value, err := c.values[i].Evaluate(ctx)
+			if err != nil {
+				return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE value: %w", i, err)
+			}
+			return c.objectLayoutFixer.FixLayout(i, value), nil
+		}
+	}
+	value, err := c.elseValue.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate CASE else value: %w", err)
+	}
+	return c.objectLayoutFixer.FixLayout(len(c.values), value), nil
+}
+
 type Tuple struct {
 	args []Expression
 }
@@ -454,6 +520,197 @@ func (c *Tuple) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	return octosql.NewTuple(values), nil
 }
 
+type List struct {
+	elements          []Expression
+	objectLayoutFixer *ObjectLayoutFixer
+}
+
+func NewList(elements []Expression, objectLayoutFixer *ObjectLayoutFixer) *List {
+	return &List{
+		elements:          elements,
+		objectLayoutFixer: objectLayoutFixer,
+	}
+}
+
+func (c *List) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	values := make([]octosql.Value, len(c.elements))
+	for i := range c.elements {
+		value, err := c.elements[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d list element: %w", i, err)
+		}
+		values[i] = c.objectLayoutFixer.FixLayout(i, value)
+	}
+	return octosql.NewList(values), nil
+}
+
+type Object struct {
+	values []Expression
+}
+
+func NewObject(values []Expression) *Object {
+	return &Object{
+		values: values,
+	}
+}
+
+func (c *Object) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	values := make([]octosql.Value, len(c.values))
+	for i := range c.values {
+		value, err := c.values[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d object field: %w", i, err)
+		}
+		values[i] = value
+	}
+	return octosql.NewStruct(values), nil
+}
+
+// evaluateLambda evaluates the lambda body with the arguments as an additional record in the variable context.
+func evaluateLambda(ctx ExecutionContext, body Expression, args ...octosql.Value) (octosql.Value, error) {
+	return body.Evaluate(ExecutionContext{
+		Context: ctx.Context,
+		VariableContext: &VariableContext{
+			Parent: ctx.VariableContext,
+			Values: args,
+		},
+	})
+}
+
+type ListTransform struct {
+	list, body Expression
+}
+
+func NewListTransform(list, body Expression) *ListTransform {
+	return &ListTransform{
+		list: list,
+		body: body,
+	}
+}
+
+func (c *ListTransform) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	list, err := c.list.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate list: %w", err)
+	}
+	if list.TypeID == octosql.TypeIDNull {
+		return octosql.NewNull(), nil
+	}
+
+	out := make([]octosql.Value, len(list.List))
+	for i := range list.List {
+		value, err := evaluateLambda(ctx, c.body, list.List[i])
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate lambda for %d list element: %w", i, err)
+		}
+		out[i] = value
+	}
+	return octosql.NewList(out), nil
+}
+
+type ListFilter struct {
+	list, body Expression
+}
+
+func NewListFilter(list, body Expression) *ListFilter {
+	return &ListFilter{
+		list: list,
+		body: body,
+	}
+}
+
+func (c *ListFilter) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	list, err := c.list.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate list: %w", err)
+	}
+	if list.TypeID == octosql.TypeIDNull {
+		return octosql.NewNull(), nil
+	}
+
+	out := make([]octosql.Value, 0, len(list.List))
+	for i := range list.List {
+		value, err := evaluateLambda(ctx, c.body, list.List[i])
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate lambda for %d list element: %w", i, err)
+		}
+		if value.TypeID == octosql.TypeIDBoolean && value.Boolean {
+			out = append(out, list.List[i])
+		}
+	}
+	return octosql.NewList(out), nil
+}
+
+// ListMatch checks whether the lambda returns true for any, or if all is set, all of the list elements.
+type ListMatch struct {
+	list, body Expression
+	all        bool
+}
+
+func NewListMatch(list, body Expression, all bool) *ListMatch {
+	return &ListMatch{
+		list: list,
+		body: body,
+		all:  all,
+	}
+}
+
+func (c *ListMatch) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	list, err := c.list.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate list: %w", err)
+	}
+	if list.TypeID == octosql.TypeIDNull {
+		return octosql.NewNull(), nil
+	}
+
+	for i := range list.List {
+		value, err := evaluateLambda(ctx, c.body, list.List[i])
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate lambda for %d list element: %w", i, err)
+		}
+		matches := value.TypeID == octosql.TypeIDBoolean && value.Boolean
+		if matches != c.all {
+			return octosql.NewBoolean(matches), nil
+		}
+	}
+	return octosql.NewBoolean(c.all), nil
+}
+
+type ListReduce struct {
+	list, initial, body Expression
+}
+
+func NewListReduce(list, initial, body Expression) *ListReduce {
+	return &ListReduce{
+		list:    list,
+		initial: initial,
+		body:    body,
+	}
+}
+
+func (c *ListReduce) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	list, err := c.list.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate list: %w", err)
+	}
+	if list.TypeID == octosql.TypeIDNull {
+		return octosql.NewNull(), nil
+	}
+	acc, err := c.initial.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate initial value: %w", err)
+	}
+
+	for i := range list.List {
+		acc, err = evaluateLambda(ctx, c.body, acc, list.List[i])
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate lambda for %d list element: %w", i, err)
+		}
+	}
+	return acc, nil
+}
+
 type ObjectFieldAccess struct {
 	object     Expression
 	fieldIndex int
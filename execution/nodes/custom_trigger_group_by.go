@@ -14,6 +14,7 @@ type CustomTriggerGroupBy struct {
 	aggregatePrototypes []func() Aggregate
 	aggregateExprs      []Expression
 	keyExprs            []Expression
+	groupingSets        [][]int
 	keyEventTimeIndex   int
 	source              Node
 	triggerPrototype    func() Trigger
@@ -23,6 +24,7 @@ func NewCustomTriggerGroupBy(
 	aggregatePrototypes []func() Aggregate,
 	aggregateExprs []Expression,
 	keyExprs []Expression,
+	groupingSets [][]int,
 	keyEventTimeIndex int,
 	source Node,
 	triggerPrototype func() Trigger,
@@ -31,6 +33,7 @@ func NewCustomTriggerGroupBy(
 		aggregatePrototypes: aggregatePrototypes,
 		aggregateExprs:      aggregateExprs,
 		keyExprs:            keyExprs,
+		groupingSets:        groupingSets,
 		keyEventTimeIndex:   keyEventTimeIndex,
 		source:              NewEventTimeBuffer(source),
 		triggerPrototype:    triggerPrototype,
@@ -64,7 +67,9 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 	previouslySentValues := btree.New(BTreeDefaultDegree)
 	trigger := g.triggerPrototype()
 
-	if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+	var watermark time.Time
+
+	receiveRecord := func(produceCtx ProduceContext, record Record) error {
 		ctx := ctx.WithRecord(record)
 
 		key := make(GroupKey, len(g.keyExprs))
@@ -85,7 +90,7 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 			aggregateInputs[i] = value
 		}
 
-		{
+		for _, key := range g.groupingSetKeys(key) {
 			item := aggregates.Get(key)
 			var itemTyped *aggregatesItem
 
@@ -111,12 +116,7 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 			} else {
 				itemTyped.OverallRecordCount--
 			}
-			for i, expr := range g.aggregateExprs {
-				aggregateInput, err := expr.Evaluate(ctx)
-				if err != nil {
-					return fmt.Errorf("couldn't evaluate %d aggregate expression: %w", i, err)
-				}
-
+			for i, aggregateInput := range aggregateInputs {
 				if aggregateInput.TypeID != octosql.TypeIDNull {
 					if !record.Retraction {
 						itemTyped.AggregatedSetSize[i]++
@@ -140,15 +140,30 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 		}
 
 		return nil
-	}, func(ctx ProduceContext, msg MetadataMessage) error {
+	}
+	receiveMetadata := func(ctx ProduceContext, msg MetadataMessage) error {
 		if msg.Type == MetadataMessageTypeWatermark {
+			watermark = msg.Watermark
 			trigger.WatermarkReceived(msg.Watermark)
 			if err := g.trigger(ctx, aggregates, previouslySentValues, trigger, msg.Watermark, produce); err != nil {
 				return fmt.Errorf("couldn't trigger keys on watermark: %w", err)
 			}
 		}
 		return metaSend(ctx, msg)
-	}); err != nil {
+	}
+
+	if timedTrigger, ok := trigger.(TimedTrigger); ok {
+		// Keys may have to be triggered while the source is idle, so it's run in the background.
+		triggerPending := func() error {
+			if err := g.trigger(ProduceFromExecutionContext(ctx), aggregates, previouslySentValues, trigger, watermark, produce); err != nil {
+				return fmt.Errorf("couldn't trigger keys on timer: %w", err)
+			}
+			return nil
+		}
+		if err := g.runWithTimer(ctx, timedTrigger, receiveRecord, receiveMetadata, triggerPending); err != nil {
+			return err
+		}
+	} else if err := g.source.Run(ctx, receiveRecord, receiveMetadata); err != nil {
 		return fmt.Errorf("couldn't run source: %w", err)
 	}
 
@@ -161,6 +176,107 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 	return nil
 }
 
+// runWithTimer runs the source in a separate goroutine, so that pending keys of the timed trigger
+// get triggered once their time comes, even if the source doesn't send anything in the meantime.
+func (g *CustomTriggerGroupBy) runWithTimer(ctx ExecutionContext, timedTrigger TimedTrigger, receiveRecord ProduceFn, receiveMetadata MetaSendFn, triggerPending func() error) error {
+	type chanMessage struct {
+		metadata        bool
+		metadataMessage MetadataMessage
+		record          Record
+		produceCtx      ProduceContext
+		err             error
+	}
+
+	messages := make(chan chanMessage, 10000)
+
+	go func() {
+		if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			messages <- chanMessage{
+				metadata:   false,
+				record:     record,
+				produceCtx: produceCtx,
+			}
+
+			return nil
+		}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+			messages <- chanMessage{
+				metadata:        true,
+				metadataMessage: msg,
+				produceCtx:      produceCtx,
+			}
+
+			return nil
+		}); err != nil {
+			messages <- chanMessage{
+				err: fmt.Errorf("couldn't run source: %w", err),
+			}
+		}
+
+		close(messages)
+	}()
+
+	for {
+		var timer *time.Timer
+		var timerChan <-chan time.Time
+		if triggerTime, ok := timedTrigger.NextTriggerTime(); ok {
+			timer = time.NewTimer(time.Until(triggerTime))
+			timerChan = timer.C
+		}
+
+		select {
+		case msg, ok := <-messages:
+			if timer != nil {
+				timer.Stop()
+			}
+			if !ok {
+				return nil
+			}
+			if msg.err != nil {
+				return msg.err
+			}
+			if msg.metadata {
+				if err := receiveMetadata(msg.produceCtx, msg.metadataMessage); err != nil {
+					// TODO: Fix goroutine leak.
+					return err
+				}
+			} else {
+				if err := receiveRecord(msg.produceCtx, msg.record); err != nil {
+					// TODO: Fix goroutine leak.
+					return err
+				}
+			}
+		case <-timerChan:
+			if err := triggerPending(); err != nil {
+				// TODO: Fix goroutine leak.
+				return err
+			}
+		}
+	}
+}
+
+// groupingSetKeys returns the keys the record gets aggregated under, one for each grouping set.
+// Key values not in the grouping set are replaced with NULL and the index of the grouping set is appended,
+// so that groups of different grouping sets never collide.
+func (g *CustomTriggerGroupBy) groupingSetKeys(key GroupKey) []GroupKey {
+	if g.groupingSets == nil {
+		return []GroupKey{key}
+	}
+
+	keys := make([]GroupKey, len(g.groupingSets))
+	for setIndex, set := range g.groupingSets {
+		setKey := make(GroupKey, len(key)+1)
+		for i := range key {
+			setKey[i] = octosql.NewNull()
+		}
+		for _, keyIndex := range set {
+			setKey[keyIndex] = key[keyIndex]
+		}
+		setKey[len(key)] = octosql.NewInt(setIndex)
+		keys[setIndex] = setKey
+	}
+	return keys
+}
+
 func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, aggregates, previouslySentValues *btree.BTree, trigger Trigger, curEventTime time.Time, produce ProduceFn) error {
 	toTrigger := trigger.Poll()
 
@@ -0,0 +1,76 @@
+package nodes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cube2222/octosql/aggregates"
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// idleSource produces its records and then waits, without sending anything, until it's released.
+type idleSource struct {
+	records []Record
+	release chan struct{}
+}
+
+func (s *idleSource) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	for _, record := range s.records {
+		if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+			return err
+		}
+	}
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func TestDelayTriggerIdleSource(t *testing.T) {
+	source := &idleSource{
+		records: []Record{
+			NewRecord([]octosql.Value{octosql.NewInt(1)}, false, time.Time{}),
+			NewRecord([]octosql.Value{octosql.NewInt(1)}, false, time.Time{}),
+		},
+		release: make(chan struct{}),
+	}
+	groupBy := nodes.NewCustomTriggerGroupBy(
+		[]func() nodes.Aggregate{aggregates.NewCountPrototype()},
+		[]Expression{NewVariable(0, 0)},
+		[]Expression{NewVariable(0, 0)},
+		nil,
+		-1,
+		source,
+		NewDelayTriggerPrototype(time.Millisecond*50),
+	)
+
+	produced := make(chan Record, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- groupBy.Run(ExecutionContext{Context: context.Background()}, func(ctx ProduceContext, record Record) error {
+			produced <- record
+			return nil
+		}, func(ctx ProduceContext, msg MetadataMessage) error {
+			return nil
+		})
+	}()
+
+	// The source stays idle, so only the delay may trigger the key.
+	select {
+	case record := <-produced:
+		assert.Equal(t, []octosql.Value{octosql.NewInt(1), octosql.NewInt(2)}, record.Values)
+		assert.False(t, record.Retraction)
+	case <-time.After(time.Second * 5):
+		t.Fatal("key not triggered while the source was idle")
+	}
+
+	close(source.release)
+	assert.NoError(t, <-done)
+	assert.Empty(t, produced)
+}
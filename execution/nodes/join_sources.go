@@ -0,0 +1,211 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+// joinSourcesReceiveFn processes a record of the left or right source.
+// oneStreamRemains is true once the other source is done and all of its records have been processed.
+type joinSourcesReceiveFn func(ctx ExecutionContext, amLeft bool, record Record, oneStreamRemains bool) error
+
+// runJoinSources runs both sources concurrently and passes their records to receive.
+// Records with an event time are buffered until the minimum watermark of both sources reaches them, which is also the watermark sent on.
+// onOneStreamRemains, if not nil, gets called once only one source is left and there won't be any more records from the other one.
+func runJoinSources(ctx ExecutionContext, name string, left, right Node, metaSend MetaSendFn, receive joinSourcesReceiveFn, onOneStreamRemains func(leftRemains bool)) error {
+	type chanMessage struct {
+		metadata        bool
+		metadataMessage MetadataMessage
+		record          Record
+		err             error
+	}
+
+	leftMessages := make(chan chanMessage, 10000)
+	rightMessages := make(chan chanMessage, 10000)
+
+	runSource := func(source Node, side string, messages chan chanMessage) {
+		if err := source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			messages <- chanMessage{
+				metadata: false,
+				record:   record,
+			}
+
+			return nil
+		}, func(ctx ProduceContext, msg MetadataMessage) error {
+			messages <- chanMessage{
+				metadata:        true,
+				metadataMessage: msg,
+			}
+
+			return nil
+		}); err != nil {
+			messages <- chanMessage{
+				err: fmt.Errorf("couldn't run %s %s source: %w", side, name, err),
+			}
+		}
+
+		close(messages)
+	}
+	go runSource(left, "left", leftMessages)
+	go runSource(right, "right", rightMessages)
+
+	var leftDone bool
+
+	var leftWatermark, rightWatermark, minWatermark time.Time
+
+	leftRecordBuffer := NewRecordEventTimeBuffer()
+	rightRecordBuffer := NewRecordEventTimeBuffer()
+
+	receiveRecord := func(amLeft bool, record Record, oneStreamRemains bool) error {
+		if err := receive(ctx, amLeft, record, oneStreamRemains); err != nil {
+			side := "right"
+			if amLeft {
+				side = "left"
+			}
+			// TODO: Fix goroutine leak.
+			return fmt.Errorf("couldn't process record from %s: %w", side, err)
+		}
+		return nil
+	}
+
+	processRecordsUpTo := func(watermark time.Time, oneStreamRemains bool) error {
+		if err := leftRecordBuffer.Emit(watermark, func(record Record) error {
+			return receiveRecord(true, record, oneStreamRemains)
+		}); err != nil {
+			return err
+		}
+
+		return rightRecordBuffer.Emit(watermark, func(record Record) error {
+			return receiveRecord(false, record, oneStreamRemains)
+		})
+	}
+
+receiveLoop:
+	for {
+		var msg chanMessage
+		var ok, fromLeft bool
+		select {
+		case msg, ok = <-leftMessages:
+			fromLeft = true
+		case msg, ok = <-rightMessages:
+			fromLeft = false
+		}
+		if !ok {
+			leftDone = fromLeft
+			break receiveLoop
+		}
+		if msg.err != nil {
+			return msg.err
+		}
+		if msg.metadata {
+			if fromLeft {
+				leftWatermark = msg.metadataMessage.Watermark
+			} else {
+				rightWatermark = msg.metadataMessage.Watermark
+			}
+
+			min := leftWatermark
+			if rightWatermark.Before(min) {
+				min = rightWatermark
+			}
+			if min.After(minWatermark) {
+				minWatermark = min
+
+				if err := processRecordsUpTo(minWatermark, false); err != nil {
+					return err
+				}
+
+				if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+					Type:      MetadataMessageTypeWatermark,
+					Watermark: minWatermark,
+				}); err != nil {
+					return fmt.Errorf("couldn't send metadata: %w", err)
+				}
+			}
+
+			continue
+		}
+		if msg.record.EventTime.IsZero() {
+			// If the event time is zero, don't buffer, there's no point.
+			// There won't be any record with an event time less than zero.
+			if err := receiveRecord(fromLeft, msg.record, false); err != nil {
+				return err
+			}
+		} else if fromLeft {
+			leftRecordBuffer.AddRecord(msg.record)
+		} else {
+			rightRecordBuffer.AddRecord(msg.record)
+		}
+		// TODO: Add backpressure
+	}
+
+	var openChannel chan chanMessage
+	var myRecordBuffer, otherRecordBuffer *RecordEventTimeBuffer
+	if !leftDone {
+		openChannel = leftMessages
+		myRecordBuffer = leftRecordBuffer
+		minWatermark = leftWatermark
+		otherRecordBuffer = rightRecordBuffer
+	} else {
+		openChannel = rightMessages
+		myRecordBuffer = rightRecordBuffer
+		minWatermark = rightWatermark
+		otherRecordBuffer = leftRecordBuffer
+	}
+
+	if err := processRecordsUpTo(minWatermark, true); err != nil {
+		return err
+	}
+
+	oneStreamRemains := false
+	markOneStreamRemains := func() {
+		if oneStreamRemains {
+			return
+		}
+		oneStreamRemains = true
+		if onOneStreamRemains != nil {
+			onOneStreamRemains(!leftDone)
+		}
+	}
+	if otherRecordBuffer.Empty() {
+		markOneStreamRemains()
+	}
+
+	for msg := range openChannel {
+		if msg.err != nil {
+			return msg.err
+		}
+		if msg.metadata {
+			if err := processRecordsUpTo(msg.metadataMessage.Watermark, oneStreamRemains); err != nil {
+				return err
+			}
+
+			if otherRecordBuffer.Empty() {
+				markOneStreamRemains()
+			}
+
+			if err := metaSend(ProduceFromExecutionContext(ctx), msg.metadataMessage); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+			continue
+		}
+
+		if msg.record.EventTime.IsZero() {
+			// If the event time is zero, don't buffer, there's no point.
+			// There won't be any record with an event time less than zero.
+			if err := receiveRecord(!leftDone, msg.record, oneStreamRemains); err != nil {
+				return err
+			}
+		} else {
+			myRecordBuffer.AddRecord(msg.record)
+		}
+	}
+
+	if err := processRecordsUpTo(WatermarkMaxValue, oneStreamRemains); err != nil {
+		return err
+	}
+
+	return nil
+}
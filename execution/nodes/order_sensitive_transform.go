@@ -74,7 +74,7 @@ func (o *OrderSensitiveTransform) Run(execCtx ExecutionContext, produce ProduceF
 	}
 
 	recordCounts := btree.New(BTreeDefaultDegree)
-	o.source.Run(
+	if err := o.source.Run(
 		execCtx,
 		func(ctx ProduceContext, record Record) error {
 			key := make([]octosql.Value, len(o.orderByKeyExprs))
@@ -122,7 +122,9 @@ func (o *OrderSensitiveTransform) Run(execCtx ExecutionContext, produce ProduceF
 		func(ctx ProduceContext, msg MetadataMessage) error {
 			return nil
 		},
-	)
+	); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
 
 	if err := produceOrderByItems(ProduceFromExecutionContext(execCtx), recordCounts, limit, produce); err != nil {
 		return fmt.Errorf("couldn't produce ordered items: %w", err)
@@ -131,18 +133,19 @@ func (o *OrderSensitiveTransform) Run(execCtx ExecutionContext, produce ProduceF
 }
 
 func produceOrderByItems(ctx ProduceContext, recordCounts *btree.BTree, limit *int, produce ProduceFn) error {
-	i := 0
+	produced := 0
 	var outErr error
 	recordCounts.Ascend(func(item btree.Item) bool {
-		if limit != nil && i >= *limit {
-			return false
-		}
-		i++
 		itemTyped, ok := item.(*orderByItem)
 		if !ok {
 			panic(fmt.Sprintf("invalid order by item: %v", item))
 		}
 		for i := 0; i < itemTyped.Count; i++ {
+			// Duplicate records share an item, so the limit has to be checked per record.
+			if limit != nil && produced >= *limit {
+				return false
+			}
+			produced++
 			if err := produce(ctx, NewRecord(itemTyped.Values, false, time.Time{})); err != nil {
 				outErr = err
 				return false
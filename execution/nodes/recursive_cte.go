@@ -0,0 +1,116 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// RecursiveCTE evaluates a recursive common table expression using semi-naive evaluation.
+// The anchor is evaluated first. Then, the recursive part is evaluated repeatedly,
+// each time only over the records produced by the previous iteration, until an iteration produces no records.
+type RecursiveCTE struct {
+	anchor, recursive  Node
+	input              *RecursiveCTEInput
+	distinct           bool
+	maxIterations      int
+	objectLayoutFixers []*ObjectLayoutFixer
+}
+
+func NewRecursiveCTE(anchor, recursive Node, input *RecursiveCTEInput, distinct bool, maxIterations int, objectLayoutFixers []*ObjectLayoutFixer) *RecursiveCTE {
+	return &RecursiveCTE{
+		anchor:             anchor,
+		recursive:          recursive,
+		input:              input,
+		distinct:           distinct,
+		maxIterations:      maxIterations,
+		objectLayoutFixers: objectLayoutFixers,
+	}
+}
+
+func (node *RecursiveCTE) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	produceCtx := ProduceFromExecutionContext(ctx)
+	seen := btree.New(BTreeDefaultDegree)
+
+	delta, err := node.evaluate(ctx, node.anchor, 0, seen)
+	if err != nil {
+		return fmt.Errorf("couldn't evaluate anchor: %w", err)
+	}
+	for iteration := 1; len(delta) > 0; iteration++ {
+		for i := range delta {
+			if err := produce(produceCtx, NewRecord(delta[i], false, time.Time{})); err != nil {
+				return err
+			}
+		}
+		if iteration > node.maxIterations {
+			return fmt.Errorf("recursive common table expression didn't finish within %d iterations", node.maxIterations)
+		}
+
+		node.input.records = delta
+		delta, err = node.evaluate(ctx, node.recursive, 1, seen)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate recursive part in iteration %d: %w", iteration, err)
+		}
+	}
+
+	return nil
+}
+
+// evaluate runs the source to completion and returns the records it produced, with retractions applied.
+// If distinct is set, records which have already been seen are discarded.
+func (node *RecursiveCTE) evaluate(ctx ExecutionContext, source Node, sourceIndex int, seen *btree.BTree) ([][]octosql.Value, error) {
+	records := btree.New(BTreeDefaultDegree)
+	if err := source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		values := make([]octosql.Value, len(record.Values))
+		for i := range record.Values {
+			values[i] = node.objectLayoutFixers[i].FixLayout(sourceIndex, record.Values[i])
+		}
+		addToMultiset(records, &orderByItem{Values: values}, record.Retraction)
+		return nil
+	}, func(ctx ProduceContext, msg MetadataMessage) error {
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var out [][]octosql.Value
+	records.Ascend(func(item btree.Item) bool {
+		itemTyped := item.(*orderByItem)
+		if !node.distinct {
+			for i := 0; i < itemTyped.Count; i++ {
+				out = append(out, itemTyped.Values)
+			}
+			return true
+		}
+		if seen.Has(itemTyped) {
+			return true
+		}
+		seen.ReplaceOrInsert(&orderByItem{Values: itemTyped.Values, Count: 1})
+		out = append(out, itemTyped.Values)
+		return true
+	})
+	return out, nil
+}
+
+// RecursiveCTEInput produces the records of the previous iteration of a recursive common table expression.
+type RecursiveCTEInput struct {
+	records [][]octosql.Value
+}
+
+func NewRecursiveCTEInput() *RecursiveCTEInput {
+	return &RecursiveCTEInput{}
+}
+
+func (input *RecursiveCTEInput) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	produceCtx := ProduceFromExecutionContext(ctx)
+	for i := range input.records {
+		if err := produce(produceCtx, NewRecord(input.records[i], false, time.Time{})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package nodes
+
+import (
+	"fmt"
+
+	tbtree "github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// SemiJoin outputs the left records which have a matching record on the right side.
+// As an anti join, it outputs the left records which don't have a matching record on the right side.
+// When the matching state of a key changes, the left records for that key get produced or retracted.
+type SemiJoin struct {
+	left, right                 Node
+	keyExprsLeft, keyExprsRight []Expression
+	isAnti                      bool
+}
+
+func NewSemiJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, isAnti bool) *SemiJoin {
+	return &SemiJoin{
+		left:          left,
+		right:         right,
+		keyExprsLeft:  keyExprsLeft,
+		keyExprsRight: keyExprsRight,
+		isAnti:        isAnti,
+	}
+}
+
+func (s *SemiJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	leftRecords := tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
+		return CompareValueSlices(a.GroupKey, b.GroupKey)
+	}, tbtree.Options{
+		NoLocks: true,
+	})
+	rightRecords := tbtree.NewGenericOptions[*streamJoinItem](func(a, b *streamJoinItem) bool {
+		return CompareValueSlices(a.GroupKey, b.GroupKey)
+	}, tbtree.Options{
+		NoLocks: true,
+	})
+
+	// Both record trees are needed until the end, as right records may change the matching state of left records at any time.
+	return runJoinSources(ctx, "semi join", s.left, s.right, metaSend, func(ctx ExecutionContext, amLeft bool, record Record, oneStreamRemains bool) error {
+		if amLeft {
+			return s.receiveRecord(ctx, produce, leftRecords, rightRecords, true, record)
+		}
+		return s.receiveRecord(ctx, produce, rightRecords, leftRecords, false, record)
+	}, nil)
+}
+
+func (s *SemiJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *tbtree.Generic[*streamJoinItem], amLeft bool, record Record) error {
+	ctx = ctx.WithRecord(record)
+
+	var keyExprs []Expression
+	if amLeft {
+		keyExprs = s.keyExprsLeft
+	} else {
+		keyExprs = s.keyExprsRight
+	}
+
+	key := make(GroupKey, len(keyExprs))
+	hasNullKeyPart := false
+	for i, expr := range keyExprs {
+		value, err := expr.Evaluate(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate %d semi join key expression: %w", i, err)
+		}
+		key[i] = value
+		if value.TypeID == octosql.TypeIDNull {
+			hasNullKeyPart = true
+		}
+	}
+
+	if hasNullKeyPart {
+		// Null is never equal to anything, so this record can't ever match.
+		if amLeft && s.isAnti {
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		return nil
+	}
+
+	firstRecordForThatKeyOnThisSide := false
+	lastRetractionForThatKeyOnThisSide := false
+	{
+		// Update count in my record tree
+		itemTyped, ok := myRecords.Get(&streamJoinItem{GroupKey: key})
+
+		if !ok {
+			itemTyped = &streamJoinItem{GroupKey: key, values: tbtree.NewGenericOptions(func(a, b *streamJoinSubitem) bool {
+				return CompareValueSlices(a.GroupKey, b.GroupKey)
+			}, tbtree.Options{NoLocks: true})}
+			myRecords.Set(itemTyped)
+			firstRecordForThatKeyOnThisSide = true
+		}
+
+		{
+			subitemTyped, ok := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
+
+			if !ok {
+				subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
+				itemTyped.values.Set(subitemTyped)
+			}
+			if !record.Retraction {
+				subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
+			} else {
+				// TODO: This should delete the matching event time.
+				subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
+			}
+			if len(subitemTyped.EventTimes) == 0 {
+				itemTyped.values.Delete(subitemTyped)
+			}
+		}
+
+		if itemTyped.values.Len() == 0 {
+			myRecords.Delete(itemTyped)
+			lastRetractionForThatKeyOnThisSide = true
+		}
+	}
+
+	if amLeft {
+		_, matched := otherRecords.Get(&streamJoinItem{GroupKey: key})
+		if matched != s.isAnti {
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if firstRecordForThatKeyOnThisSide == lastRetractionForThatKeyOnThisSide {
+		// The matching state of this key didn't change.
+		return nil
+	}
+
+	itemTyped, ok := otherRecords.Get(&streamJoinItem{GroupKey: key})
+	if !ok {
+		// Nothing to trigger
+		return nil
+	}
+
+	// For a semi join, left records get produced when the key starts matching and retracted when it stops matching.
+	// For an anti join it's the other way around.
+	retraction := lastRetractionForThatKeyOnThisSide != s.isAnti
+
+	var outErr error
+	itemTyped.values.Scan(func(subitemTyped *streamJoinSubitem) bool {
+		for i := 0; i < len(subitemTyped.EventTimes); i++ {
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(subitemTyped.GroupKey, retraction, subitemTyped.EventTimes[i])); err != nil {
+				outErr = fmt.Errorf("couldn't produce: %w", err)
+				return false
+			}
+		}
+
+		return true
+	})
+	if outErr != nil {
+		return outErr
+	}
+
+	return nil
+}
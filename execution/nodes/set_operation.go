@@ -0,0 +1,119 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// SetOperation implements INTERSECT and EXCEPT.
+// It keeps the count of each record in both sources, so that it can update its output when either of them retracts records.
+type SetOperation struct {
+	first, second      Node
+	isExcept           bool
+	distinct           bool
+	objectLayoutFixers []*ObjectLayoutFixer
+}
+
+func NewSetOperation(first, second Node, isExcept, distinct bool, objectLayoutFixers []*ObjectLayoutFixer) *SetOperation {
+	return &SetOperation{
+		first:              first,
+		second:             second,
+		isExcept:           isExcept,
+		distinct:           distinct,
+		objectLayoutFixers: objectLayoutFixers,
+	}
+}
+
+type setOperationItem struct {
+	Values      []octosql.Value
+	FirstCount  int
+	SecondCount int
+}
+
+func (node *SetOperation) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	recordCounts := btree.NewGenericOptions(func(item, than *setOperationItem) bool {
+		for i := 0; i < len(item.Values); i++ {
+			if comp := item.Values[i].Compare(than.Values[i]); comp != 0 {
+				return comp == -1
+			}
+		}
+
+		return false
+	}, btree.Options{
+		NoLocks: true,
+	})
+
+	return runJoinSources(ctx, "set operation", node.first, node.second, metaSend, func(ctx ExecutionContext, amFirst bool, record Record, oneStreamRemains bool) error {
+		index := 1
+		if amFirst {
+			index = 0
+		}
+
+		values := make([]octosql.Value, len(record.Values))
+		for i := range record.Values {
+			values[i] = node.objectLayoutFixers[i].FixLayout(index, record.Values[i])
+		}
+
+		item, ok := recordCounts.Get(&setOperationItem{Values: values})
+		if !ok {
+			item = &setOperationItem{Values: values}
+			recordCounts.Set(item)
+		}
+		previousCount := node.outputCount(item)
+
+		delta := 1
+		if record.Retraction {
+			delta = -1
+		}
+		if amFirst {
+			item.FirstCount += delta
+		} else {
+			item.SecondCount += delta
+		}
+		if item.FirstCount == 0 && item.SecondCount == 0 {
+			recordCounts.Delete(item)
+		}
+
+		// Send the difference between the previous and current output as new records or retractions.
+		newCount := node.outputCount(item)
+		for i := previousCount; i < newCount; i++ {
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(item.Values, false, time.Time{})); err != nil {
+				return fmt.Errorf("couldn't produce record: %w", err)
+			}
+		}
+		for i := newCount; i < previousCount; i++ {
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(item.Values, true, time.Time{})); err != nil {
+				return fmt.Errorf("couldn't retract record: %w", err)
+			}
+		}
+		return nil
+	}, nil)
+}
+
+// outputCount returns how many times the record should be present in the output, based on its counts in both sources.
+func (node *SetOperation) outputCount(item *setOperationItem) int {
+	var count int
+	if node.isExcept {
+		count = item.FirstCount - item.SecondCount
+		if node.distinct && item.SecondCount > 0 {
+			count = 0
+		}
+	} else {
+		count = item.FirstCount
+		if item.SecondCount < count {
+			count = item.SecondCount
+		}
+	}
+	if count < 0 {
+		return 0
+	}
+	if node.distinct && count > 1 {
+		return 1
+	}
+	return count
+}
@@ -0,0 +1,124 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type UnionAll struct {
+	first, second      Node
+	objectLayoutFixers []*ObjectLayoutFixer
+}
+
+func NewUnionAll(first, second Node, objectLayoutFixers []*ObjectLayoutFixer) *UnionAll {
+	return &UnionAll{
+		first:              first,
+		second:             second,
+		objectLayoutFixers: objectLayoutFixers,
+	}
+}
+
+func (u *UnionAll) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	type chanMessage struct {
+		metadata        bool
+		metadataMessage MetadataMessage
+		record          Record
+		err             error
+	}
+
+	runSource := func(source Node, messages chan<- chanMessage, name string) {
+		if err := source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			messages <- chanMessage{
+				metadata: false,
+				record:   record,
+			}
+
+			return nil
+		}, func(ctx ProduceContext, msg MetadataMessage) error {
+			messages <- chanMessage{
+				metadata:        true,
+				metadataMessage: msg,
+			}
+
+			return nil
+		}); err != nil {
+			messages <- chanMessage{
+				err: fmt.Errorf("couldn't run %s union source: %w", name, err),
+			}
+		}
+
+		close(messages)
+	}
+
+	firstMessages := make(chan chanMessage, 10000)
+	secondMessages := make(chan chanMessage, 10000)
+
+	go runSource(u.first, firstMessages, "first")
+	go runSource(u.second, secondMessages, "second")
+
+	// A finished source won't send any more records, so its watermark is effectively the maximum.
+	var firstWatermark, secondWatermark, minWatermark time.Time
+
+	for firstMessages != nil || secondMessages != nil {
+		var msg chanMessage
+		var ok bool
+		var index int
+		select {
+		case msg, ok = <-firstMessages:
+			if !ok {
+				firstMessages = nil
+				firstWatermark = WatermarkMaxValue
+			}
+			index = 0
+		case msg, ok = <-secondMessages:
+			if !ok {
+				secondMessages = nil
+				secondWatermark = WatermarkMaxValue
+			}
+			index = 1
+		}
+		if ok {
+			if msg.err != nil {
+				// TODO: Fix goroutine leak.
+				return msg.err
+			}
+			if !msg.metadata {
+				values := make([]octosql.Value, len(msg.record.Values))
+				for i := range msg.record.Values {
+					values[i] = u.objectLayoutFixers[i].FixLayout(index, msg.record.Values[i])
+				}
+				if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, msg.record.Retraction, msg.record.EventTime)); err != nil {
+					return fmt.Errorf("couldn't produce record: %w", err)
+				}
+				continue
+			}
+			if msg.metadataMessage.Type != MetadataMessageTypeWatermark {
+				continue
+			}
+			if index == 0 {
+				firstWatermark = msg.metadataMessage.Watermark
+			} else {
+				secondWatermark = msg.metadataMessage.Watermark
+			}
+		}
+
+		min := firstWatermark
+		if secondWatermark.Before(min) {
+			min = secondWatermark
+		}
+		if min.After(minWatermark) && (firstMessages != nil || secondMessages != nil) {
+			minWatermark = min
+			if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: minWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
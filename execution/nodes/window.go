@@ -0,0 +1,475 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/btree"
+	tbtree "github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// Window computes window functions over partitions of the source records, ordered by the order by key.
+// Each output record consists of the source record values followed by the window function values.
+//
+// Whenever records get added to or retracted from a partition, the partition is marked as dirty.
+// Dirty partitions are recomputed when a watermark arrives and at the end of the stream.
+// Output records which changed get retracted and the new ones get produced in their place.
+type Window struct {
+	source                      Node
+	partitionByExprs            []Expression
+	orderByKeyExprs             []Expression
+	orderByDirectionMultipliers []int
+	functions                   []WindowFunction
+	timeFieldIndex              int
+}
+
+func NewWindow(source Node, partitionByExprs, orderByKeyExprs []Expression, orderByDirectionMultipliers []int, functions []WindowFunction, timeFieldIndex int) *Window {
+	return &Window{
+		source:                      source,
+		partitionByExprs:            partitionByExprs,
+		orderByKeyExprs:             orderByKeyExprs,
+		orderByDirectionMultipliers: orderByDirectionMultipliers,
+		functions:                   functions,
+		timeFieldIndex:              timeFieldIndex,
+	}
+}
+
+// WindowFunction computes its value for each row of an ordered partition.
+type WindowFunction interface {
+	Evaluate(ctx ExecutionContext, partition *WindowPartitionRows) ([]octosql.Value, error)
+}
+
+// WindowPartitionRows holds the rows of a partition, in order.
+type WindowPartitionRows struct {
+	Values [][]octosql.Value
+	// PeerGroupStart and PeerGroupEnd hold, for each row, the indices of the first and last row with an equal order by key.
+	PeerGroupStart, PeerGroupEnd []int
+}
+
+// WindowFrame describes the rows a window function is computed over, as offsets relative to the current row.
+// A nil bound is unbounded. A nil frame is the default frame, which spans from the first row up to the last peer of the current row.
+type WindowFrame struct {
+	Start, End *int
+}
+
+// Bounds returns the inclusive range of rows of the frame for the given row.
+// If the frame is empty, end will be less than start.
+func (frame *WindowFrame) Bounds(partition *WindowPartitionRows, row int) (start, end int) {
+	if frame == nil {
+		return 0, partition.PeerGroupEnd[row]
+	}
+	start, end = 0, len(partition.Values)-1
+	if frame.Start != nil {
+		start = row + *frame.Start
+	}
+	if frame.End != nil {
+		end = row + *frame.End
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end > len(partition.Values)-1 {
+		end = len(partition.Values) - 1
+	}
+	return start, end
+}
+
+type windowPartition struct {
+	GroupKey
+	rows    *btree.BTree
+	emitted *btree.BTree
+	dirty   bool
+}
+
+func (w *Window) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	partitions := tbtree.NewGenericOptions[*windowPartition](func(a, b *windowPartition) bool {
+		return CompareValueSlices(a.GroupKey, b.GroupKey)
+	}, tbtree.Options{
+		NoLocks: true,
+	})
+	var dirtyPartitions []*windowPartition
+
+	flush := func(produceCtx ProduceContext) error {
+		for _, partition := range dirtyPartitions {
+			if err := w.recomputePartition(ctx, produceCtx, partition, produce); err != nil {
+				return err
+			}
+			partition.dirty = false
+			if partition.rows.Len() == 0 {
+				partitions.Delete(partition)
+			}
+		}
+		dirtyPartitions = dirtyPartitions[:0]
+		return nil
+	}
+
+	if err := w.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		recordCtx := ctx.WithRecord(record)
+
+		partitionKey := make(GroupKey, len(w.partitionByExprs))
+		for i := range w.partitionByExprs {
+			value, err := w.partitionByExprs[i].Evaluate(recordCtx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d partition by expression: %w", i, err)
+			}
+			partitionKey[i] = value
+		}
+		orderKey := make([]octosql.Value, len(w.orderByKeyExprs))
+		for i := range w.orderByKeyExprs {
+			value, err := w.orderByKeyExprs[i].Evaluate(recordCtx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d order by key expression: %w", i, err)
+			}
+			orderKey[i] = value
+		}
+
+		partition, ok := partitions.Get(&windowPartition{GroupKey: partitionKey})
+		if !ok {
+			partition = &windowPartition{
+				GroupKey: partitionKey,
+				rows:     btree.New(BTreeDefaultDegree),
+				emitted:  btree.New(BTreeDefaultDegree),
+			}
+			partitions.Set(partition)
+		}
+		if !partition.dirty {
+			partition.dirty = true
+			dirtyPartitions = append(dirtyPartitions, partition)
+		}
+
+		addToMultiset(partition.rows, &orderByItem{
+			Key:                  orderKey,
+			Values:               record.Values,
+			DirectionMultipliers: w.orderByDirectionMultipliers,
+		}, record.Retraction)
+
+		return nil
+	}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+		if msg.Type == MetadataMessageTypeWatermark {
+			if err := flush(produceCtx); err != nil {
+				return err
+			}
+		}
+		return metaSend(produceCtx, msg)
+	}); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	return flush(ProduceFromExecutionContext(ctx))
+}
+
+func addToMultiset(tree *btree.BTree, item *orderByItem, retraction bool) {
+	if existing := tree.Get(item); existing != nil {
+		item = existing.(*orderByItem)
+	}
+	if !retraction {
+		item.Count++
+	} else {
+		item.Count--
+	}
+	if item.Count > 0 {
+		tree.ReplaceOrInsert(item)
+	} else {
+		tree.Delete(item)
+	}
+}
+
+func (w *Window) recomputePartition(ctx ExecutionContext, produceCtx ProduceContext, partition *windowPartition, produce ProduceFn) error {
+	rows := &WindowPartitionRows{}
+	var keys [][]octosql.Value
+	partition.rows.Ascend(func(item btree.Item) bool {
+		itemTyped := item.(*orderByItem)
+		for i := 0; i < itemTyped.Count; i++ {
+			rows.Values = append(rows.Values, itemTyped.Values)
+			keys = append(keys, itemTyped.Key)
+		}
+		return true
+	})
+
+	rows.PeerGroupStart = make([]int, len(rows.Values))
+	rows.PeerGroupEnd = make([]int, len(rows.Values))
+	for i := range keys {
+		if i > 0 && compareKeys(keys[i-1], keys[i]) {
+			rows.PeerGroupStart[i] = rows.PeerGroupStart[i-1]
+		} else {
+			rows.PeerGroupStart[i] = i
+		}
+	}
+	for i := len(keys) - 1; i >= 0; i-- {
+		if i < len(keys)-1 && compareKeys(keys[i], keys[i+1]) {
+			rows.PeerGroupEnd[i] = rows.PeerGroupEnd[i+1]
+		} else {
+			rows.PeerGroupEnd[i] = i
+		}
+	}
+
+	functionValues := make([][]octosql.Value, len(w.functions))
+	for i := range w.functions {
+		values, err := w.functions[i].Evaluate(ctx, rows)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate %d window function: %w", i, err)
+		}
+		functionValues[i] = values
+	}
+
+	outputs := btree.New(BTreeDefaultDegree)
+	for i := range rows.Values {
+		outputValues := make([]octosql.Value, len(rows.Values[i])+len(w.functions))
+		copy(outputValues, rows.Values[i])
+		for j := range w.functions {
+			outputValues[len(rows.Values[i])+j] = functionValues[j][i]
+		}
+		addToMultiset(outputs, &orderByItem{Values: outputValues}, false)
+	}
+
+	// Retract the output records which aren't valid anymore and produce the new ones.
+	var outErr error
+	partition.emitted.Ascend(func(item btree.Item) bool {
+		itemTyped := item.(*orderByItem)
+		count := itemTyped.Count
+		if newItem := outputs.Get(itemTyped); newItem != nil {
+			count -= newItem.(*orderByItem).Count
+		}
+		for i := 0; i < count; i++ {
+			if err := produce(produceCtx, NewRecord(itemTyped.Values, true, w.eventTime(itemTyped.Values))); err != nil {
+				outErr = fmt.Errorf("couldn't produce: %w", err)
+				return false
+			}
+		}
+		return true
+	})
+	if outErr != nil {
+		return outErr
+	}
+	outputs.Ascend(func(item btree.Item) bool {
+		itemTyped := item.(*orderByItem)
+		count := itemTyped.Count
+		if oldItem := partition.emitted.Get(itemTyped); oldItem != nil {
+			count -= oldItem.(*orderByItem).Count
+		}
+		for i := 0; i < count; i++ {
+			if err := produce(produceCtx, NewRecord(itemTyped.Values, false, w.eventTime(itemTyped.Values))); err != nil {
+				outErr = fmt.Errorf("couldn't produce: %w", err)
+				return false
+			}
+		}
+		return true
+	})
+	if outErr != nil {
+		return outErr
+	}
+
+	partition.emitted = outputs
+	return nil
+}
+
+func (w *Window) eventTime(values []octosql.Value) time.Time {
+	if w.timeFieldIndex == -1 {
+		return time.Time{}
+	}
+	return values[w.timeFieldIndex].Time
+}
+
+func compareKeys(a, b []octosql.Value) bool {
+	for i := range a {
+		if a[i].Compare(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func evaluateWindowArgument(ctx ExecutionContext, arg Expression, values []octosql.Value) (octosql.Value, error) {
+	return arg.Evaluate(ctx.WithRecord(NewRecord(values, false, time.Time{})))
+}
+
+type RowNumber struct {
+}
+
+func NewRowNumber() *RowNumber {
+	return &RowNumber{}
+}
+
+func (f *RowNumber) Evaluate(ctx ExecutionContext, partition *WindowPartitionRows) ([]octosql.Value, error) {
+	out := make([]octosql.Value, len(partition.Values))
+	for i := range out {
+		out[i] = octosql.NewInt(i + 1)
+	}
+	return out, nil
+}
+
+type Rank struct {
+	dense bool
+}
+
+func NewRank(dense bool) *Rank {
+	return &Rank{dense: dense}
+}
+
+func (f *Rank) Evaluate(ctx ExecutionContext, partition *WindowPartitionRows) ([]octosql.Value, error) {
+	out := make([]octosql.Value, len(partition.Values))
+	denseRank := 0
+	for i := range out {
+		if partition.PeerGroupStart[i] == i {
+			denseRank++
+		}
+		if f.dense {
+			out[i] = octosql.NewInt(denseRank)
+		} else {
+			out[i] = octosql.NewInt(partition.PeerGroupStart[i] + 1)
+		}
+	}
+	return out, nil
+}
+
+// Lag returns the value of the argument at the row the given offset before the current one.
+// As Lead, it returns the value at the row the given offset after the current one.
+type Lag struct {
+	arg, defaultValue Expression
+	offset            int
+	isLead            bool
+}
+
+func NewLag(arg Expression, offset int, defaultValue Expression, isLead bool) *Lag {
+	return &Lag{
+		arg:          arg,
+		offset:       offset,
+		defaultValue: defaultValue,
+		isLead:       isLead,
+	}
+}
+
+func (f *Lag) Evaluate(ctx ExecutionContext, partition *WindowPartitionRows) ([]octosql.Value, error) {
+	out := make([]octosql.Value, len(partition.Values))
+	for i := range out {
+		target := i - f.offset
+		if f.isLead {
+			target = i + f.offset
+		}
+		var err error
+		if target < 0 || target >= len(partition.Values) {
+			out[i], err = evaluateWindowArgument(ctx, f.defaultValue, partition.Values[i])
+			if err != nil {
+				return nil, fmt.Errorf("couldn't evaluate default value: %w", err)
+			}
+			continue
+		}
+		out[i], err = evaluateWindowArgument(ctx, f.arg, partition.Values[target])
+		if err != nil {
+			return nil, fmt.Errorf("couldn't evaluate argument: %w", err)
+		}
+	}
+	return out, nil
+}
+
+// FrameValue returns the value of the argument at the first row of the frame.
+// If last is set, it returns the value at the last row of the frame instead.
+type FrameValue struct {
+	arg   Expression
+	frame *WindowFrame
+	last  bool
+}
+
+func NewFrameValue(arg Expression, frame *WindowFrame, last bool) *FrameValue {
+	return &FrameValue{
+		arg:   arg,
+		frame: frame,
+		last:  last,
+	}
+}
+
+func (f *FrameValue) Evaluate(ctx ExecutionContext, partition *WindowPartitionRows) ([]octosql.Value, error) {
+	out := make([]octosql.Value, len(partition.Values))
+	for i := range out {
+		start, end := f.frame.Bounds(partition, i)
+		if end < start {
+			out[i] = octosql.NewNull()
+			continue
+		}
+		target := start
+		if f.last {
+			target = end
+		}
+		var err error
+		out[i], err = evaluateWindowArgument(ctx, f.arg, partition.Values[target])
+		if err != nil {
+			return nil, fmt.Errorf("couldn't evaluate argument: %w", err)
+		}
+	}
+	return out, nil
+}
+
+// WindowAggregate computes an aggregate over the frame of each row.
+// Frame bounds only ever move forward, so a single aggregate is used,
+// adding records entering the frame and retracting records leaving it.
+type WindowAggregate struct {
+	prototype func() Aggregate
+	arg       Expression
+	frame     *WindowFrame
+}
+
+func NewWindowAggregate(prototype func() Aggregate, arg Expression, frame *WindowFrame) *WindowAggregate {
+	return &WindowAggregate{
+		prototype: prototype,
+		arg:       arg,
+		frame:     frame,
+	}
+}
+
+func (f *WindowAggregate) Evaluate(ctx ExecutionContext, partition *WindowPartitionRows) ([]octosql.Value, error) {
+	argValues := make([]octosql.Value, len(partition.Values))
+	for i := range partition.Values {
+		value, err := evaluateWindowArgument(ctx, f.arg, partition.Values[i])
+		if err != nil {
+			return nil, fmt.Errorf("couldn't evaluate argument: %w", err)
+		}
+		argValues[i] = value
+	}
+
+	aggregate := f.prototype()
+	aggregatedSetSize := 0
+	update := func(index int, retraction bool) {
+		if argValues[index].TypeID == octosql.TypeIDNull {
+			return
+		}
+		if !retraction {
+			aggregatedSetSize++
+		} else {
+			aggregatedSetSize--
+		}
+		aggregate.Add(retraction, argValues[index])
+	}
+
+	out := make([]octosql.Value, len(partition.Values))
+	// The rows in [curStart, curEnd) are currently in the aggregate.
+	curStart, curEnd := 0, 0
+	for i := range out {
+		start, end := f.frame.Bounds(partition, i)
+		end++
+		if end < start {
+			end = start
+		}
+		if start > curEnd {
+			for ; curStart < curEnd; curStart++ {
+				update(curStart, true)
+			}
+			curStart, curEnd = start, start
+		}
+		for ; curEnd < end; curEnd++ {
+			update(curEnd, false)
+		}
+		for ; curStart < start; curStart++ {
+			update(curStart, true)
+		}
+
+		if aggregatedSetSize > 0 {
+			out[i] = aggregate.Trigger()
+		} else {
+			out[i] = octosql.NewNull()
+		}
+	}
+	return out, nil
+}
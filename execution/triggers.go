@@ -15,6 +15,12 @@ type Trigger interface {
 	// ExpireKeysBeforeTime(time time.Time)
 }
 
+// TimedTrigger is implemented by triggers which trigger keys once enough processing time passes, without any new input.
+type TimedTrigger interface {
+	// NextTriggerTime returns the earliest time a pending key gets triggered at, if there are any pending keys.
+	NextTriggerTime() (time.Time, bool)
+}
+
 type CountingTrigger struct {
 	triggerAfter uint
 
@@ -180,6 +186,108 @@ func (c *WatermarkTrigger) Poll() []GroupKey {
 	return c.outputKeysSlice
 }
 
+type delayTriggerKey struct {
+	Time     time.Time
+	GroupKey GroupKey
+}
+
+func (key delayTriggerKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(delayTriggerKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	if key.Time == thanTyped.Time {
+		return key.GroupKey.Less(thanTyped.GroupKey)
+	} else {
+		return key.Time.Before(thanTyped.Time)
+	}
+}
+
+// DelayTrigger triggers a key after the given amount of processing time has passed since its first update.
+type DelayTrigger struct {
+	delay time.Duration
+	now   func() time.Time
+
+	// Pending keys ordered by trigger time.
+	timeKeys           *btree.BTree
+	pendingKeys        *btree.BTree
+	endOfStreamReached bool
+
+	outputKeysSlice []GroupKey
+}
+
+func NewDelayTriggerPrototype(delay time.Duration) func() Trigger {
+	return func() Trigger {
+		return &DelayTrigger{
+			delay:              delay,
+			now:                time.Now,
+			timeKeys:           btree.New(BTreeDefaultDegree),
+			pendingKeys:        btree.New(BTreeDefaultDegree),
+			endOfStreamReached: false,
+			outputKeysSlice:    make([]GroupKey, 0),
+		}
+	}
+}
+
+func (c *DelayTrigger) EndOfStreamReached() {
+	c.endOfStreamReached = true
+}
+
+func (c *DelayTrigger) WatermarkReceived(watermark time.Time) {}
+
+func (c *DelayTrigger) KeyReceived(key GroupKey) {
+	if c.pendingKeys.Has(key) {
+		// The key will be triggered after the delay since its first update.
+		return
+	}
+	triggerTime := c.now().Add(c.delay)
+	c.pendingKeys.ReplaceOrInsert(key)
+	c.timeKeys.ReplaceOrInsert(delayTriggerKey{
+		Time:     triggerTime,
+		GroupKey: key,
+	})
+}
+
+// The returned slice will be made invalid after following operations on the trigger.
+func (c *DelayTrigger) Poll() []GroupKey {
+	c.outputKeysSlice = c.outputKeysSlice[:0]
+	now := c.now()
+	var toDelete []delayTriggerKey
+	c.timeKeys.Ascend(func(item btree.Item) bool {
+		itemTyped, ok := item.(delayTriggerKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+
+		if !c.endOfStreamReached && itemTyped.Time.After(now) {
+			return false
+		}
+
+		c.outputKeysSlice = append(c.outputKeysSlice, itemTyped.GroupKey)
+		toDelete = append(toDelete, itemTyped)
+
+		return true
+	})
+	for i := range toDelete {
+		c.timeKeys.Delete(toDelete[i])
+		c.pendingKeys.Delete(toDelete[i].GroupKey)
+	}
+	return c.outputKeysSlice
+}
+
+func (c *DelayTrigger) NextTriggerTime() (time.Time, bool) {
+	item := c.timeKeys.Min()
+	if item == nil {
+		return time.Time{}, false
+	}
+	itemTyped, ok := item.(delayTriggerKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid received item: %v", item))
+	}
+	return itemTyped.Time, true
+}
+
 type EndOfStreamTrigger struct {
 	keys               *btree.BTree
 	endOfStreamReached bool
@@ -232,9 +340,15 @@ func NewMultiTriggerPrototype(triggerPrototypes []func() Trigger) func() Trigger
 		for i := range triggerPrototypes {
 			triggers[i] = triggerPrototypes[i]()
 		}
-		return &MultiTrigger{
+		multiTrigger := &MultiTrigger{
 			triggers: triggers,
 		}
+		for i := range triggers {
+			if _, ok := triggers[i].(TimedTrigger); ok {
+				return &TimedMultiTrigger{MultiTrigger: multiTrigger}
+			}
+		}
+		return multiTrigger
 	}
 }
 
@@ -263,3 +377,24 @@ func (c *MultiTrigger) Poll() []GroupKey {
 	}
 	return output
 }
+
+// TimedMultiTrigger is a MultiTrigger with at least one timed trigger.
+type TimedMultiTrigger struct {
+	*MultiTrigger
+}
+
+func (c *TimedMultiTrigger) NextTriggerTime() (time.Time, bool) {
+	var out time.Time
+	var found bool
+	for i := range c.triggers {
+		timed, ok := c.triggers[i].(TimedTrigger)
+		if !ok {
+			continue
+		}
+		if t, ok := timed.NextTriggerTime(); ok && (!found || t.Before(out)) {
+			out = t
+			found = true
+		}
+	}
+	return out, found
+}
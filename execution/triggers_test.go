@@ -2,6 +2,7 @@ package execution
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -17,3 +18,35 @@ func TestCountingTrigger(t *testing.T) {
 	assert.Len(t, polled, 1)
 	assert.Equal(t, polled[0], GroupKey{octosql.NewInt(2), octosql.NewInt(3)})
 }
+
+func TestDelayTrigger(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	trigger := NewDelayTriggerPrototype(time.Second)().(*DelayTrigger)
+	trigger.now = func() time.Time { return now }
+
+	_, ok := trigger.NextTriggerTime()
+	assert.False(t, ok)
+
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	assert.Empty(t, trigger.Poll())
+	next, ok := trigger.NextTriggerTime()
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Second), next)
+
+	now = now.Add(time.Millisecond * 500)
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	trigger.KeyReceived(GroupKey{octosql.NewInt(2)})
+	assert.Empty(t, trigger.Poll())
+
+	now = now.Add(time.Millisecond * 500)
+	polled := trigger.Poll()
+	assert.Len(t, polled, 1)
+	assert.Equal(t, GroupKey{octosql.NewInt(1)}, polled[0])
+
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	trigger.EndOfStreamReached()
+	polled = trigger.Poll()
+	assert.Len(t, polled, 2)
+	assert.Equal(t, GroupKey{octosql.NewInt(2)}, polled[0])
+	assert.Equal(t, GroupKey{octosql.NewInt(1)}, polled[1])
+}
@@ -0,0 +1,237 @@
+package functions
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// Decimal types with an unknown precision make the output types of arithmetic on them unknown as well.
+// Values of a decimal type with a known precision are always stored at the scale of the type.
+
+// decimalSumType is the output type of adding or subtracting decimals.
+func decimalSumType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	scale := maxInt(left.Decimal.Scale, right.Decimal.Scale)
+	integerDigits := maxInt(left.Decimal.Precision-left.Decimal.Scale, right.Decimal.Precision-right.Decimal.Scale)
+	return octosql.NewDecimalType(integerDigits+scale+1, scale)
+}
+
+// decimalProductType is the output type of multiplying decimals.
+func decimalProductType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	return octosql.NewDecimalType(left.Decimal.Precision+right.Decimal.Precision, left.Decimal.Scale+right.Decimal.Scale)
+}
+
+// decimalQuotientScale is the number of digits after the decimal point the quotient of decimals gets rounded to.
+func decimalQuotientScale(leftScale, rightScale int) int {
+	return maxInt(octosql.DecimalDivisionMinScale, maxInt(leftScale, rightScale))
+}
+
+// decimalQuotientType is the output type of dividing decimals.
+func decimalQuotientType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	scale := decimalQuotientScale(left.Decimal.Scale, right.Decimal.Scale)
+	return octosql.NewDecimalType(left.Decimal.Precision-left.Decimal.Scale+right.Decimal.Scale+scale, scale)
+}
+
+// decimalRemainderType is the output type of the remainder of dividing decimals.
+func decimalRemainderType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	scale := maxInt(left.Decimal.Scale, right.Decimal.Scale)
+	integerDigits := minInt(left.Decimal.Precision-left.Decimal.Scale, right.Decimal.Precision-right.Decimal.Scale)
+	return octosql.NewDecimalType(integerDigits+scale, scale)
+}
+
+// decimalIntegerType is the output type of rounding a decimal to an integer.
+func decimalIntegerType(t octosql.Type) octosql.Type {
+	return decimalRoundType(t, 0)
+}
+
+// decimalRoundType is the output type of rounding a decimal to the given number of digits after the decimal point.
+// Rounding may carry over to a new integer digit, like 9.5 to 10.
+func decimalRoundType(t octosql.Type, digits int) octosql.Type {
+	if t.Decimal.Precision == 0 || digits >= t.Decimal.Scale {
+		return t
+	}
+	if digits < 0 {
+		digits = 0
+	}
+	return octosql.NewDecimalType(t.Decimal.Precision-t.Decimal.Scale+1+digits, digits)
+}
+
+// decimalBinaryDescriptor returns the descriptor of an operator on two decimals, with the output type computed from their types.
+func decimalBinaryDescriptor(outputType func(left, right octosql.Type) octosql.Type, fn func(left, right decimal.Decimal) (decimal.Decimal, error)) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+			if len(types) != 2 || types[0].TypeID != octosql.TypeIDDecimal || types[1].TypeID != octosql.TypeIDDecimal {
+				return octosql.Type{}, false
+			}
+			return outputType(types[0], types[1]), true
+		},
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			out, err := fn(values[0].Decimal, values[1].Decimal)
+			if err != nil {
+				return octosql.Value{}, err
+			}
+			return octosql.NewDecimal(out), nil
+		},
+	}
+}
+
+// decimalUnaryDescriptor returns the descriptor of a function of a single decimal, with the output type computed from its type.
+func decimalUnaryDescriptor(outputType func(octosql.Type) octosql.Type, fn func(decimal.Decimal) decimal.Decimal) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+			if len(types) != 1 || types[0].TypeID != octosql.TypeIDDecimal {
+				return octosql.Type{}, false
+			}
+			return outputType(types[0]), true
+		},
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			return octosql.NewDecimal(fn(values[0].Decimal)), nil
+		},
+	}
+}
+
+func sameDecimalType(t octosql.Type) octosql.Type {
+	return t
+}
+
+func divideDecimals(left, right decimal.Decimal) (decimal.Decimal, error) {
+	if right.IsZero() {
+		return decimal.Decimal{}, fmt.Errorf("division by zero: %s / 0", octosql.FormatDecimal(left))
+	}
+	scale := decimalQuotientScale(octosql.DecimalScale(left), octosql.DecimalScale(right))
+	return left.DivRound(right, int32(scale)), nil
+}
+
+func decimalRemainder(left, right decimal.Decimal) (decimal.Decimal, error) {
+	if right.IsZero() {
+		return decimal.Decimal{}, fmt.Errorf("modulo by zero: %s %% 0", octosql.FormatDecimal(left))
+	}
+	// The remainder is computed at the larger scale of the arguments, to keep it at the scale of its type.
+	scale := maxInt(octosql.DecimalScale(left), octosql.DecimalScale(right))
+	return octosql.RescaleDecimal(left.Mod(right), scale), nil
+}
+
+// roundDecimal rounds the value half away from zero to the given number of decimal digits, which may be negative.
+// Values are never padded with zeros beyond the scale they're stored with.
+func roundDecimal(d decimal.Decimal, digits int) decimal.Decimal {
+	if scale := octosql.DecimalScale(d); digits >= scale {
+		return d
+	}
+	out := d.Round(int32(digits))
+	if digits < 0 {
+		// Round keeps the trailing zeros in the exponent, we want an integer at scale 0.
+		out = octosql.RescaleDecimal(out, 0)
+	}
+	return out
+}
+
+// decimalRoundDescriptor is the descriptor of rounding a decimal to a number of digits given as the second argument.
+// The output type is only known if the number of digits is a constant.
+var decimalRoundDescriptor = physical.FunctionDescriptor{
+	ConstantTypeFn: func(types []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+		if len(types) != 2 || types[0].TypeID != octosql.TypeIDDecimal || types[1].TypeID != octosql.TypeIDInt {
+			return octosql.Type{}, false
+		}
+		if constants[1] == nil || constants[1].TypeID != octosql.TypeIDInt {
+			return octosql.Decimal, true
+		}
+		return decimalRoundType(types[0], constants[1].Int), true
+	},
+	Strict: true,
+	Function: func(values []octosql.Value) (octosql.Value, error) {
+		return octosql.NewDecimal(roundDecimal(values[0].Decimal, values[1].Int)), nil
+	},
+}
+
+// toDecimal converts the Int, Float, String or Decimal value to a decimal.
+func toDecimal(value octosql.Value) (decimal.Decimal, error) {
+	switch value.TypeID {
+	case octosql.TypeIDInt:
+		return decimal.NewFromInt(int64(value.Int)), nil
+	case octosql.TypeIDFloat:
+		if math.IsNaN(value.Float) || math.IsInf(value.Float, 0) {
+			return decimal.Decimal{}, fmt.Errorf("can't convert %v to a decimal", value.Float)
+		}
+		return decimal.NewFromFloat(value.Float), nil
+	case octosql.TypeIDString:
+		return decimal.NewFromString(value.Str)
+	case octosql.TypeIDDecimal:
+		return value.Decimal, nil
+	default:
+		return decimal.Decimal{}, fmt.Errorf("can't convert %s to a decimal", value.TypeID)
+	}
+}
+
+// decimalConversionDescriptor is the descriptor of the decimal function called with the precision and scale to convert to.
+// Strings which aren't valid numbers are converted to NULL, values which don't fit the precision result in an error.
+var decimalConversionDescriptor = physical.FunctionDescriptor{
+	ConstantTypeFn: func(types []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+		if len(types) != 3 || types[1].TypeID != octosql.TypeIDInt || types[2].TypeID != octosql.TypeIDInt {
+			return octosql.Type{}, false
+		}
+		switch types[0].TypeID {
+		case octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDString, octosql.TypeIDDecimal:
+		default:
+			return octosql.Type{}, false
+		}
+		if constants[1] == nil || constants[2] == nil {
+			panic(fmt.Errorf("decimal precision and scale must be constants"))
+		}
+		precision, scale := constants[1].Int, constants[2].Int
+		if precision < 1 || precision > octosql.DecimalMaxPrecision {
+			panic(fmt.Errorf("decimal precision must be between 1 and %d, got %d", octosql.DecimalMaxPrecision, precision))
+		}
+		if scale < 0 || scale > precision {
+			panic(fmt.Errorf("decimal scale must be between 0 and its precision, got %d", scale))
+		}
+		outputType := octosql.NewDecimalType(precision, scale)
+		if types[0].TypeID == octosql.TypeIDString {
+			return octosql.TypeSum(outputType, octosql.Null), true
+		}
+		return outputType, true
+	},
+	Strict: true,
+	TypedFunction: func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+		decimalType := octosql.NonNullable(outputType)
+		return func(values []octosql.Value) (octosql.Value, error) {
+			d, err := toDecimal(values[0])
+			if err != nil {
+				if values[0].TypeID == octosql.TypeIDString {
+					log.Printf("couldn't parse string '%s' as decimal: %s", values[0].Str, err)
+					return octosql.NewNull(), nil
+				}
+				return octosql.Value{}, err
+			}
+			if d, err = octosql.FitDecimal(d, decimalType); err != nil {
+				return octosql.Value{}, err
+			}
+			return octosql.NewDecimal(d), nil
+		}
+	},
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -1,17 +1,32 @@
 package functions
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"math"
+	"math/rand"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 
+	"github.com/cespare/xxhash"
 	"github.com/dgraph-io/ristretto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/valyala/fastjson"
 
+	"github.com/cube2222/octosql/helpers/jsonvalues"
 	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/outputs/formats"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -60,11 +75,10 @@ func FunctionMap() map[string]physical.FunctionDetails {
 			Descriptors: []physical.FunctionDescriptor{
 				// TODO: Specializations for concrete primitive types.
 				{
-					ArgumentTypes: []octosql.Type{octosql.Any, octosql.Any},
-					OutputType:    octosql.Boolean,
-					Strict:        true,
+					TypeFn: equalityTypeFn,
+					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewBoolean(values[0].Equal(values[1])), nil
+						return octosql.NewBoolean(equal(values[0], values[1])), nil
 					},
 				},
 			},
@@ -73,11 +87,10 @@ func FunctionMap() map[string]physical.FunctionDetails {
 			Descriptors: []physical.FunctionDescriptor{
 				// TODO: Specializations for concrete primitive types.
 				{
-					ArgumentTypes: []octosql.Type{octosql.Any, octosql.Any},
-					OutputType:    octosql.Boolean,
-					Strict:        true,
+					TypeFn: equalityTypeFn,
+					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewBoolean(!values[0].Equal(values[1])), nil
+						return octosql.NewBoolean(!equal(values[0], values[1])), nil
 					},
 				},
 			},
@@ -152,6 +165,70 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"is distinct from": {
+			Description: "Returns true if the arguments are not equal, treating null as a comparable value.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: equalityTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(!notDistinct(values[0], values[1])), nil
+					},
+				},
+			},
+		},
+		"is not distinct from": {
+			Description: "Returns true if the arguments are equal, treating null as a comparable value.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: equalityTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(notDistinct(values[0], values[1])), nil
+					},
+				},
+			},
+		},
+		"between": {
+			Description: "Returns true if the first argument is greater than or equal to the second and less than or equal to the third one.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 3 {
+							return octosql.Type{}, false
+						}
+						if !types[0].Equals(types[1]) || !types[0].Equals(types[2]) {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) >= 0 && values[0].Compare(values[2]) <= 0), nil
+					},
+				},
+			},
+		},
+		"not between": {
+			Description: "Returns true if the first argument is less than the second or greater than the third one.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 3 {
+							return octosql.Type{}, false
+						}
+						if !types[0].Equals(types[1]) || !types[0].Equals(types[2]) {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) < 0 || values[0].Compare(values[2]) > 0), nil
+					},
+				},
+			},
+		},
 		// arithmetic operators
 		"+": {
 			Descriptors: []physical.FunctionDescriptor{
@@ -171,6 +248,9 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(values[0].Float + values[1].Float), nil
 					},
 				},
+				decimalBinaryDescriptor(decimalSumType, func(left, right decimal.Decimal) (decimal.Decimal, error) {
+					return left.Add(right), nil
+				}),
 				{
 					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Duration},
 					OutputType:    octosql.Duration,
@@ -195,6 +275,46 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewTime(values[1].Time.Add(values[0].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(values[1].Interval)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Duration},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(octosql.CalendarInterval{Duration: values[1].Duration})), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[1].Interval.Add(octosql.CalendarInterval{Duration: values[0].Duration})), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.Interval},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(values[1].Interval.AddTo(values[0].Time)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(values[0].Interval.AddTo(values[1].Time)), nil
+					},
+				},
 				{
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
 					OutputType:    octosql.String,
@@ -231,6 +351,9 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(values[0].Float - values[1].Float), nil
 					},
 				},
+				decimalBinaryDescriptor(decimalSumType, func(left, right decimal.Decimal) (decimal.Decimal, error) {
+					return left.Sub(right), nil
+				}),
 				{
 					ArgumentTypes: []octosql.Type{octosql.Float},
 					OutputType:    octosql.Float,
@@ -239,6 +362,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(-values[0].Float), nil
 					},
 				},
+				decimalUnaryDescriptor(sameDecimalType, decimal.Decimal.Neg),
 				{
 					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Duration},
 					OutputType:    octosql.Duration,
@@ -263,6 +387,30 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewTime(values[0].Time.Add(-values[1].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(values[1].Interval.Negate())), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Negate()), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.Interval},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(values[1].Interval.Negate().AddTo(values[0].Time)), nil
+					},
+				},
 			},
 		},
 		"*": {
@@ -283,6 +431,9 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(values[0].Float * values[1].Float), nil
 					},
 				},
+				decimalBinaryDescriptor(decimalProductType, func(left, right decimal.Decimal) (decimal.Decimal, error) {
+					return left.Mul(right), nil
+				}),
 				{
 					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Int},
 					OutputType:    octosql.Duration,
@@ -299,6 +450,22 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewDuration(values[1].Duration * time.Duration(values[0].Int)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Int},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Multiply(values[1].Int)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[1].Interval.Multiply(values[0].Int)), nil
+					},
+				},
 				{
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
 					OutputType:    octosql.String,
@@ -335,6 +502,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(values[0].Float / values[1].Float), nil
 					},
 				},
+				decimalBinaryDescriptor(decimalQuotientType, divideDecimals),
 				{
 					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Int},
 					OutputType:    octosql.Duration,
@@ -353,6 +521,9 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"%": {
+			Descriptors: modDescriptors(),
+		},
 		// math
 		"abs": {
 			Description: "Returns absolute value of argument.",
@@ -376,6 +547,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(math.Abs(values[0].Float)), nil
 					},
 				},
+				decimalUnaryDescriptor(sameDecimalType, decimal.Decimal.Abs),
 			},
 		},
 		"sqrt": {
@@ -402,6 +574,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(math.Ceil(values[0].Float)), nil
 					},
 				},
+				decimalUnaryDescriptor(decimalIntegerType, decimal.Decimal.Ceil),
 			},
 		},
 		"floor": {
@@ -415,6 +588,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(math.Floor(values[0].Float)), nil
 					},
 				},
+				decimalUnaryDescriptor(decimalIntegerType, decimal.Decimal.Floor),
 			},
 		},
 		"log2": {
@@ -469,6 +643,212 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"round": {
+			Description: "Rounds the number half away from zero, to the number of decimal digits given in the optional second argument. Negative digits round to tens, hundreds and so on.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(roundInt(values[0].Int, values[1].Int)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Round(values[0].Float)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Int},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(roundFloat(values[0].Float, values[1].Int)), nil
+					},
+				},
+				decimalUnaryDescriptor(decimalIntegerType, func(d decimal.Decimal) decimal.Decimal {
+					return roundDecimal(d, 0)
+				}),
+				decimalRoundDescriptor,
+			},
+		},
+		"mod": {
+			Description: "Returns the remainder of the division of the first argument by the second. The result has the sign of the first argument.",
+			Descriptors: modDescriptors(),
+		},
+		"sign": {
+			Description: "Returns -1, 0 or 1, depending on the sign of the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						switch {
+						case values[0].Int > 0:
+							return octosql.NewInt(1), nil
+						case values[0].Int < 0:
+							return octosql.NewInt(-1), nil
+						default:
+							return octosql.NewInt(0), nil
+						}
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						switch {
+						case values[0].Float > 0:
+							return octosql.NewFloat(1), nil
+						case values[0].Float < 0:
+							return octosql.NewFloat(-1), nil
+						default:
+							// Keeps zero and NaN as is.
+							return values[0], nil
+						}
+					},
+				},
+				decimalUnaryDescriptor(func(t octosql.Type) octosql.Type {
+					return octosql.NewDecimalType(1, 0)
+				}, func(d decimal.Decimal) decimal.Decimal {
+					return decimal.NewFromInt(int64(d.Sign()))
+				}),
+			},
+		},
+		"exp": {
+			Description: "Returns e to the power of the argument.",
+			Descriptors: floatFunctionDescriptors(math.Exp),
+		},
+		"ln": {
+			Description: "Returns the natural logarithm of the argument.",
+			Descriptors: floatFunctionDescriptors(math.Log),
+		},
+		"pi": {
+			Description: "Returns the value of π.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Pi), nil
+					},
+				},
+			},
+		},
+		"sin": {
+			Description: "Returns the sine of the argument, given in radians.",
+			Descriptors: floatFunctionDescriptors(math.Sin),
+		},
+		"cos": {
+			Description: "Returns the cosine of the argument, given in radians.",
+			Descriptors: floatFunctionDescriptors(math.Cos),
+		},
+		"tan": {
+			Description: "Returns the tangent of the argument, given in radians.",
+			Descriptors: floatFunctionDescriptors(math.Tan),
+		},
+		"cot": {
+			Description: "Returns the cotangent of the argument, given in radians.",
+			Descriptors: floatFunctionDescriptors(func(x float64) float64 {
+				return 1 / math.Tan(x)
+			}),
+		},
+		"asin": {
+			Description: "Returns the arcsine of the argument, in radians.",
+			Descriptors: floatFunctionDescriptors(math.Asin),
+		},
+		"acos": {
+			Description: "Returns the arccosine of the argument, in radians.",
+			Descriptors: floatFunctionDescriptors(math.Acos),
+		},
+		"atan": {
+			Description: "Returns the arctangent of the argument, in radians.",
+			Descriptors: floatFunctionDescriptors(math.Atan),
+		},
+		"atan2": {
+			Description: "Returns the arctangent of the first argument divided by the second one, in radians, using the signs of both to determine the quadrant.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Atan2(values[0].Float, values[1].Float)), nil
+					},
+				},
+			},
+		},
+		"degrees": {
+			Description: "Converts the angle in radians to degrees.",
+			Descriptors: floatFunctionDescriptors(func(x float64) float64 {
+				return x * 180 / math.Pi
+			}),
+		},
+		"radians": {
+			Description: "Converts the angle in degrees to radians.",
+			Descriptors: floatFunctionDescriptors(func(x float64) float64 {
+				return x * math.Pi / 180
+			}),
+		},
+		"greatest": {
+			Description: "Returns the greatest of the arguments, ignoring NULLs. Returns NULL only if all arguments are NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: extremeTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return extreme(values, true), nil
+					},
+				},
+			},
+		},
+		"least": {
+			Description: "Returns the least of the arguments, ignoring NULLs. Returns NULL only if all arguments are NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: extremeTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return extreme(values, false), nil
+					},
+				},
+			},
+		},
+		"random": {
+			Description: "Returns a random float in the range [0, 1). If a seed is given, each call of the function returns the next number of a reproducible sequence.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(rand.Float64()), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					TypedFunction: seededRandom,
+				},
+			},
+			NonDeterministic: true,
+		},
 		// logic
 		"not": {
 			Description: "Returns the negation of the argument.",
@@ -483,6 +863,57 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"nullif": {
+			Description: "Returns NULL if the arguments are equal, the first argument otherwise.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 2 {
+							return octosql.Type{}, false
+						}
+						t, ok := commonType(types)
+						if !ok {
+							return octosql.Type{}, false
+						}
+						return octosql.TypeSum(t, octosql.Null), true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID != octosql.TypeIDNull && values[0].Equal(values[1]) {
+							return octosql.NewNull(), nil
+						}
+						return values[0], nil
+					},
+				},
+			},
+		},
+		"ifnull": {
+			Description: "Returns the first argument if it's not NULL, the second argument otherwise.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 2 {
+							return octosql.Type{}, false
+						}
+						t, ok := commonType(types)
+						if !ok {
+							return octosql.Type{}, false
+						}
+						if allNullable(types) {
+							return octosql.TypeSum(t, octosql.Null), true
+						}
+						return t, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID == octosql.TypeIDNull {
+							return values[1], nil
+						}
+						return values[0], nil
+					},
+				},
+			},
+		},
 		// strings
 		"like": {
 			Description: "Implements the LIKE operator. Returns whether the first argument matches the pattern in the seconds one. '_' can be used to match a single arbitrary character and '%' can be used to match any number (including 0) of characters.",
@@ -666,13 +1097,199 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
-		// TODO: Regexp Match with capture of first group.
-		"upper": {
-			Description: "Returns the argument upper cased.",
+		"regexp_extract": {
+			Description: "Returns the part of the first argument matching the regex pattern in the second argument, or the group with the index provided in the third argument. Returns null if there's no match.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String},
-					OutputType:    octosql.String,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function:      regexpExtract(),
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.Int},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function:      regexpExtract(),
+				},
+			},
+		},
+		"regexp_extract_all": {
+			Description: "Returns all parts of the first argument matching the regex pattern in the second argument, or the group with the index provided in the third argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}},
+					Strict:        true,
+					Function:      regexpExtractAll(),
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.Int},
+					OutputType:    octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}},
+					Strict:        true,
+					Function:      regexpExtractAll(),
+				},
+			},
+		},
+		"regexp_replace": {
+			Description: "Replaces all parts of the first argument matching the regex pattern in the second argument by the third argument. The replacement can reference groups using $1 or ${name}.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCache("regexp_replace")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.Value{}, err
+							}
+
+							return octosql.NewString(reg.ReplaceAllString(values[0].Str, values[2].Str)), nil
+						}
+					}(),
+				},
+			},
+		},
+		"regexp_split": {
+			Description: "Splits the first argument into the parts separated by matches of the regex pattern in the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}},
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCache("regexp_split")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.Value{}, err
+							}
+
+							parts := reg.Split(values[0].Str, -1)
+							out := make([]octosql.Value, len(parts))
+							for i := range parts {
+								out[i] = octosql.NewString(parts[i])
+							}
+							return octosql.NewList(out), nil
+						}
+					}(),
+				},
+			},
+		},
+		"regexp_named_groups": {
+			Description: "Returns the named groups of the regex pattern in the second argument, matched against the first argument. If the pattern is a constant, the result is an object with a field for each group, otherwise it's a list of objects with the name and value of each group. Group names must be unique. Returns null if there's no match.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ConstantTypeFn: func(ts []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						if ts[0].TypeID != octosql.TypeIDString || ts[1].TypeID != octosql.TypeIDString {
+							return octosql.Type{}, false
+						}
+						if constants[1] == nil {
+							return octosql.Type{}, false
+						}
+						reg, err := regexp.Compile(constants[1].Str)
+						if err != nil {
+							panic(fmt.Errorf("couldn't compile regexp_named_groups pattern regexp expression: '%s': %w", constants[1].Str, err))
+						}
+						names, err := regexpGroupNames(reg)
+						if err != nil {
+							panic(fmt.Errorf("invalid regexp_named_groups pattern '%s': %w", constants[1].Str, err))
+						}
+
+						fields := make([]octosql.StructField, len(names))
+						for i := range names {
+							fields[i] = octosql.StructField{
+								Name: names[i],
+								Type: octosql.TypeSum(octosql.String, octosql.Null),
+							}
+						}
+						return octosql.TypeSum(octosql.Type{
+							TypeID: octosql.TypeIDStruct,
+							Struct: struct{ Fields []octosql.StructField }{Fields: fields},
+						}, octosql.Null), true
+					},
+					Strict: true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCache("regexp_named_groups")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.Value{}, err
+							}
+							match := reg.FindStringSubmatchIndex(values[0].Str)
+							if match == nil {
+								return octosql.NewNull(), nil
+							}
+							names, _ := regexpGroupNames(reg)
+							out := make([]octosql.Value, len(names))
+							for i := range names {
+								out[i] = regexpGroupValue(reg, match, values[0].Str, names[i])
+							}
+							return octosql.NewStruct(out), nil
+						}
+					}(),
+				},
+				{
+					// The group names of a non-constant pattern are only known while running the query.
+					ConstantTypeFn: func(ts []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						if ts[0].TypeID != octosql.TypeIDString || ts[1].TypeID != octosql.TypeIDString {
+							return octosql.Type{}, false
+						}
+						if constants[1] != nil {
+							return octosql.Type{}, false
+						}
+						return octosql.TypeSum(octosql.Type{
+							TypeID: octosql.TypeIDList,
+							List:   struct{ Element *octosql.Type }{Element: &regexpNamedGroupType},
+						}, octosql.Null), true
+					},
+					Strict: true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCache("regexp_named_groups")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.Value{}, err
+							}
+							names, err := regexpGroupNames(reg)
+							if err != nil {
+								return octosql.Value{}, fmt.Errorf("invalid regexp_named_groups pattern '%s': %w", values[1].Str, err)
+							}
+							match := reg.FindStringSubmatchIndex(values[0].Str)
+							if match == nil {
+								return octosql.NewNull(), nil
+							}
+							out := make([]octosql.Value, len(names))
+							for i := range names {
+								out[i] = octosql.NewStruct([]octosql.Value{
+									octosql.NewString(names[i]),
+									regexpGroupValue(reg, match, values[0].Str, names[i]),
+								})
+							}
+							return octosql.NewList(out), nil
+						}
+					}(),
+				},
+			},
+		},
+		"upper": {
+			Description: "Returns the argument upper cased.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
 						return octosql.NewString(strings.ToUpper(values[0].Str)), nil
@@ -680,221 +1297,848 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
-		"lower": {
-			Description: "Returns the argument lower cased.",
+		"lower": {
+			Description: "Returns the argument lower cased.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.ToLower(values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"reverse": {
+			Description: "Returns the argument reversed.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						out := make([]rune, len(values[0].Str))
+						for i, ch := range values[0].Str {
+							out[len(out)-i-1] = ch
+						}
+						return octosql.NewString(string(out)), nil
+					},
+				},
+			},
+		},
+		"substr": {
+			Description: "Returns a substring of the first argument beginning at the index provided in the second argument and optionally limiting the length using the third argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if len(values[0].Str) <= values[1].Int {
+							return octosql.NewString(""), nil
+						}
+						return octosql.NewString(values[0].Str[values[1].Int:]), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if len(values[0].Str) <= values[1].Int {
+							return octosql.NewString(""), nil
+						}
+						end := values[1].Int + values[2].Int
+						if end > len(values[0].Str) {
+							end = len(values[0].Str)
+						}
+						return octosql.NewString(values[0].Str[values[1].Int:end]), nil
+					},
+				},
+			},
+		},
+		"replace": {
+			Description: "Replaces all occurrences of the second argument in the first argument by the third argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.Replace(values[0].Str, values[1].Str, values[2].Str, -1)), nil
+					},
+				},
+			},
+		},
+		"position": {
+			Description: "Finds the first occurrence of the second argument in the first argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.Int, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						i := strings.Index(values[0].Str, values[1].Str)
+						if i == -1 {
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewInt(i), nil
+					},
+				},
+			},
+		},
+		"len": {
+			Description: "Returns the length of the collection: string, list, object or tuple.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].Str)), nil
+					},
+				},
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 1 {
+							return octosql.Type{}, false
+						}
+						if types[0].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						return octosql.Int, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].List)), nil
+					},
+				},
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 1 {
+							return octosql.Type{}, false
+						}
+						if types[0].TypeID != octosql.TypeIDStruct {
+							return octosql.Type{}, false
+						}
+						return octosql.Int, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].Struct)), nil
+					},
+				},
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) != 1 {
+							return octosql.Type{}, false
+						}
+						if types[0].TypeID != octosql.TypeIDTuple {
+							return octosql.Type{}, false
+						}
+						return octosql.Int, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].Tuple)), nil
+					},
+				},
+			},
+		},
+		"split": {
+			Description: "Splits the first argument into a list of the parts separated by the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}},
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						parts := strings.Split(values[0].Str, values[1].Str)
+						out := make([]octosql.Value, len(parts))
+						for i := range parts {
+							out[i] = octosql.NewString(parts[i])
+						}
+						return octosql.NewList(out), nil
+					},
+				},
+			},
+		},
+		"concat_ws": {
+			Description: "Concatenates all arguments but the first one, using the first argument as the separator. Null arguments are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) < 2 {
+							return octosql.Type{}, false
+						}
+						for i := range types {
+							if types[i].Is(octosql.TypeSum(octosql.String, octosql.Null)) < octosql.TypeRelationIs {
+								return octosql.Type{}, false
+							}
+						}
+						if octosql.Null.Is(types[0]) == octosql.TypeRelationIs {
+							return octosql.TypeSum(octosql.String, octosql.Null), true
+						}
+						return octosql.String, true
+					},
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID == octosql.TypeIDNull {
+							return octosql.NewNull(), nil
+						}
+						parts := make([]string, 0, len(values)-1)
+						for _, value := range values[1:] {
+							if value.TypeID != octosql.TypeIDNull {
+								parts = append(parts, value.Str)
+							}
+						}
+						return octosql.NewString(strings.Join(parts, values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"trim": {
+			Description: "Removes whitespace from both ends of the first argument. If the second argument is provided, removes the characters contained in it instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimSpace(values[0].Str)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.Trim(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"ltrim": {
+			Description: "Removes whitespace from the start of the first argument. If the second argument is provided, removes the characters contained in it instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimLeftFunc(values[0].Str, unicode.IsSpace)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimLeft(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"rtrim": {
+			Description: "Removes whitespace from the end of the first argument. If the second argument is provided, removes the characters contained in it instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimRightFunc(values[0].Str, unicode.IsSpace)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimRight(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"lpad": {
+			Description: "Pads the first argument to the length provided in the second argument by adding spaces, or the third argument repeated, at the start. Longer strings are truncated to that length.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, " ", true)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, values[2].Str, true)), nil
+					},
+				},
+			},
+		},
+		"rpad": {
+			Description: "Pads the first argument to the length provided in the second argument by adding spaces, or the third argument repeated, at the end. Longer strings are truncated to that length.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, " ", false)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, values[2].Str, false)), nil
+					},
+				},
+			},
+		},
+		"starts_with": {
+			Description: "Checks whether the first argument starts with the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(strings.HasPrefix(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"ends_with": {
+			Description: "Checks whether the first argument ends with the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(strings.HasSuffix(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"repeat": {
+			Description: "Repeats the first argument the number of times provided in the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[1].Int <= 0 {
+							return octosql.NewString(""), nil
+						}
+						return octosql.NewString(strings.Repeat(values[0].Str, values[1].Int)), nil
+					},
+				},
+			},
+		},
+		"initcap": {
+			Description: "Converts the first letter of each word in the argument to upper case and the rest to lower case.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(initcap(values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"format": {
+			Description: "Formats the arguments using the printf-style format string in the first argument, e.g. '%s has %d items (%.2f%%)'. The verbs are the ones of the Go fmt package: https://pkg.go.dev/fmt Null arguments are formatted as NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
+						if len(types) < 1 {
+							return octosql.Type{}, false
+						}
+						if types[0].Is(octosql.TypeSum(octosql.String, octosql.Null)) < octosql.TypeRelationIs {
+							return octosql.Type{}, false
+						}
+						if octosql.Null.Is(types[0]) == octosql.TypeRelationIs {
+							return octosql.TypeSum(octosql.String, octosql.Null), true
+						}
+						return octosql.String, true
+					},
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID == octosql.TypeIDNull {
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(format(values[0].Str, values[1:])), nil
+					},
+				},
+			},
+		},
+		"levenshtein": {
+			Description: "Returns the Levenshtein edit distance between the arguments.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(levenshtein(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		// time
+		"now": {
+			Description: "Returns the current time.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(time.Now()), nil
+					},
+				},
+			},
+			NonDeterministic: true,
+		},
+		"parse_time": {
+			Description: "Parses the time in the second argument using the pattern in the first argument. The pattern should be specified as in the Go standard library time.Parse function: https://pkg.go.dev/time#pkg-constants",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.Time, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := time.Parse(values[0].Str, values[1].Str)
+						if err != nil {
+							log.Printf("error parsing time: %s", err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+			},
+		},
+		"time_from_unix": {
+			Description: "Parses the unix timestamp as a time.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(time.Unix(int64(values[0].Int), 0)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						i, f := math.Modf(values[0].Float)
+						return octosql.NewTime(time.Unix(int64(i), int64(float64(time.Second)*f))), nil
+					},
+				},
+			},
+		},
+		"time_to_unix": {
+			Description: "Converts time to unix timestamp.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(values[0].Time.Unix())), nil
+					},
+				},
+			},
+		},
+		"date_trunc": {
+			Description: "Truncates the time in the second argument to the unit in the first argument, in the time zone of the time. The unit is one of: microsecond, millisecond, second, minute, hour, day, week, month, quarter, year. Weeks start on Monday.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := truncateTime(values[1].Time, values[0].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+			},
+		},
+		"date_part": {
+			Description: "Returns the part of the time in the second argument named in the first argument, in the time zone of the time. Can also be used as EXTRACT(part FROM time). The part is one of: year, quarter, month, week (ISO 8601), day, dow (day of week, Sunday is 0), isodow (Monday is 1, Sunday is 7), doy (day of year), hour, minute, second, millisecond, microsecond, nanosecond (the fractional part of the second in those units), epoch (seconds since the unix epoch).",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						part, err := timePart(values[1].Time, values[0].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(part), nil
+					},
+				},
+			},
+		},
+		"format_time": {
+			Description: "Formats the time in the first argument using the strftime layout in the second argument, e.g. '%Y-%m-%d %H:%M:%S'.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						formatted, err := formatTime(values[0].Time, values[1].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewString(formatted), nil
+					},
+				},
+			},
+		},
+		"at_time_zone": {
+			Description: "Converts the time in the first argument to the time zone named in the second argument, e.g. 'Europe/Warsaw' or 'UTC'. Can also be used as time AT TIME ZONE zone.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.String},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						loadLocation := newLocationCache()
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							location, err := loadLocation(values[1].Str)
+							if err != nil {
+								return octosql.Value{}, err
+							}
+							return octosql.NewTime(values[0].Time.In(location)), nil
+						}
+					}(),
+				},
+			},
+		},
+		// json
+		"parse_json": {
+			Description: "Parses the JSON in the first argument. The second argument is an optional constant type schema, written the way types are shown by --describe, e.g. '{name: String; tags: [String]; age: Int | NULL}'. With a schema, the result has that type, or is null if the JSON doesn't match it. Without a schema, the type of a constant argument is inferred from its JSON, otherwise the result is of type Any and can't contain objects. Returns null for invalid JSON.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Any,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						v, err := fastjson.Parse(values[0].Str)
+						if err != nil {
+							return octosql.NewNull(), nil
+						}
+						t := jsonvalues.GetOctoSQLType(v)
+						if containsStruct(t) {
+							return octosql.Value{}, fmt.Errorf("parse_json without a type schema can't return objects, as their fields must be known before running the query, provide a type schema as the second argument")
+						}
+						out, _ := jsonvalues.GetOctoSQLValue(t, v)
+						return out, nil
+					},
+				},
+				{
+					// The fields of objects in a constant are known before running the query.
+					ConstantTypeFn: func(ts []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+						if len(ts) != 1 || ts[0].TypeID != octosql.TypeIDString || constants[0] == nil {
+							return octosql.Type{}, false
+						}
+						v, err := fastjson.Parse(constants[0].Str)
+						if err != nil {
+							return octosql.Type{}, false
+						}
+						t := jsonvalues.GetOctoSQLType(v)
+						if !containsStruct(t) {
+							return octosql.Type{}, false
+						}
+						return t, true
+					},
+					Strict: true,
+					TypedFunction: func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+						return func(values []octosql.Value) (octosql.Value, error) {
+							v, err := fastjson.Parse(values[0].Str)
+							if err != nil {
+								return octosql.NewNull(), nil
+							}
+							out, ok := jsonvalues.GetOctoSQLValue(outputType, v)
+							if !ok {
+								return octosql.NewNull(), nil
+							}
+							return out, nil
+						}
+					},
+				},
+				{
+					ConstantTypeFn: func(ts []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						if ts[0].TypeID != octosql.TypeIDString || ts[1].TypeID != octosql.TypeIDString {
+							return octosql.Type{}, false
+						}
+						if constants[1] == nil {
+							panic(fmt.Errorf("parse_json type schema must be a constant"))
+						}
+						t, err := octosql.ParseType(constants[1].Str)
+						if err != nil {
+							panic(fmt.Errorf("invalid parse_json type schema: %w", err))
+						}
+						return octosql.TypeSum(t, octosql.Null), true
+					},
+					Strict: true,
+					TypedFunction: func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+						return func(values []octosql.Value) (octosql.Value, error) {
+							v, err := fastjson.Parse(values[0].Str)
+							if err != nil {
+								return octosql.NewNull(), nil
+							}
+							out, ok := jsonvalues.GetOctoSQLValue(outputType, v)
+							if !ok {
+								return octosql.NewNull(), nil
+							}
+							return out, nil
+						}
+					},
+				},
+			},
+		},
+		"to_json": {
+			Description: "Serializes the argument as JSON.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 1 {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					TypedFunction: func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+						return func(values []octosql.Value) (octosql.Value, error) {
+							var arena fastjson.Arena
+							return octosql.NewString(string(formats.ValueToJson(&arena, argumentTypes[0], values[0]).MarshalTo(nil))), nil
+						}
+					},
+				},
+			},
+		},
+		"json_extract": {
+			Description: "Extracts the value at the JSON path in the second argument, e.g. '$.items[0].name', from the JSON in the first argument. Strings and booleans are returned as such, numbers as Floats, objects and arrays as their JSON text. Returns null if there's no value at the path or the JSON is invalid.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.TypeSum(octosql.String, octosql.Float), octosql.TypeSum(octosql.Boolean, octosql.Null)),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						keys, err := parseJSONPath(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						v, err := fastjson.Parse(values[0].Str)
+						if err != nil {
+							return octosql.NewNull(), nil
+						}
+						if v = v.Get(keys...); v == nil {
+							return octosql.NewNull(), nil
+						}
+						return jsonToScalar(v), nil
+					},
+				},
+			},
+		},
+		// hashing and encoding
+		"md5": {
+			Description: "Returns the MD5 hash of the string, hex-encoded.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					ArgumentTypes: []octosql.Type{octosql.String},
 					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewString(strings.ToLower(values[0].Str)), nil
+						sum := md5.Sum([]byte(values[0].Str))
+						return octosql.NewString(hex.EncodeToString(sum[:])), nil
 					},
 				},
 			},
 		},
-		"reverse": {
-			Description: "Returns the argument reversed.",
+		"sha1": {
+			Description: "Returns the SHA-1 hash of the string, hex-encoded.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					ArgumentTypes: []octosql.Type{octosql.String},
 					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						out := make([]rune, len(values[0].Str))
-						for i, ch := range values[0].Str {
-							out[len(out)-i-1] = ch
-						}
-						return octosql.NewString(string(out)), nil
+						sum := sha1.Sum([]byte(values[0].Str))
+						return octosql.NewString(hex.EncodeToString(sum[:])), nil
 					},
 				},
 			},
 		},
-		"substr": {
-			Description: "Returns a substring of the first argument beginning at the index provided in the second argument and optionally limiting the length using the third argument.",
+		"sha256": {
+			Description: "Returns the SHA-256 hash of the string, hex-encoded.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					ArgumentTypes: []octosql.Type{octosql.String},
 					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						if len(values[0].Str) <= values[1].Int {
-							return octosql.NewString(""), nil
-						}
-						return octosql.NewString(values[0].Str[values[1].Int:]), nil
+						sum := sha256.Sum256([]byte(values[0].Str))
+						return octosql.NewString(hex.EncodeToString(sum[:])), nil
 					},
 				},
+			},
+		},
+		"xxhash64": {
+			Description: "Returns the 64-bit xxHash of the string, as a (possibly negative) integer.",
+			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.Int},
-					OutputType:    octosql.String,
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Int,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						if len(values[0].Str) <= values[1].Int {
-							return octosql.NewString(""), nil
-						}
-						end := values[1].Int + values[2].Int
-						if end > len(values[0].Str) {
-							end = len(values[0].Str)
-						}
-						return octosql.NewString(values[0].Str[values[1].Int:end]), nil
+						return octosql.NewInt(int(int64(xxhash.Sum64String(values[0].Str)))), nil
 					},
 				},
 			},
 		},
-		"replace": {
-			Description: "Replaces all occurrences of the second argument in the first argument by the third argument.",
+		"base64_encode": {
+			Description: "Encodes the string using standard base64 encoding, with padding.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					ArgumentTypes: []octosql.Type{octosql.String},
 					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewString(strings.Replace(values[0].Str, values[1].Str, values[2].Str, -1)), nil
+						return octosql.NewString(base64.StdEncoding.EncodeToString([]byte(values[0].Str))), nil
 					},
 				},
 			},
 		},
-		"position": {
-			Description: "Finds the first occurrence of the second argument in the first argument.",
+		"base64_decode": {
+			Description: "Decodes the standard base64 encoded string. Returns NULL if the string isn't valid base64.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
-					OutputType:    octosql.TypeSum(octosql.Int, octosql.Null),
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						i := strings.Index(values[0].Str, values[1].Str)
-						if i == -1 {
+						decoded, err := base64.StdEncoding.DecodeString(values[0].Str)
+						if err != nil {
+							log.Printf("couldn't decode base64 string '%s': %s", values[0].Str, err)
 							return octosql.NewNull(), nil
 						}
-						return octosql.NewInt(i), nil
+						return octosql.NewString(string(decoded)), nil
 					},
 				},
 			},
 		},
-		"len": {
-			Description: "Returns the length of the collection: string, list, object or tuple.",
+		"hex": {
+			Description: "Returns the hexadecimal representation of the bytes of the string, or of the integer.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					ArgumentTypes: []octosql.Type{octosql.String},
-					OutputType:    octosql.Int,
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(len(values[0].Str)), nil
-					},
-				},
-				{
-					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
-						if len(types) != 1 {
-							return octosql.Type{}, false
-						}
-						if types[0].TypeID != octosql.TypeIDList {
-							return octosql.Type{}, false
-						}
-						return octosql.Int, true
-					},
-					Strict: true,
-					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(len(values[0].List)), nil
-					},
-				},
-				{
-					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
-						if len(types) != 1 {
-							return octosql.Type{}, false
-						}
-						if types[0].TypeID != octosql.TypeIDStruct {
-							return octosql.Type{}, false
-						}
-						return octosql.Int, true
-					},
-					Strict: true,
-					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(len(values[0].Struct)), nil
-					},
-				},
-				{
-					TypeFn: func(types []octosql.Type) (octosql.Type, bool) {
-						if len(types) != 1 {
-							return octosql.Type{}, false
-						}
-						if types[0].TypeID != octosql.TypeIDTuple {
-							return octosql.Type{}, false
-						}
-						return octosql.Int, true
-					},
-					Strict: true,
-					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(len(values[0].Tuple)), nil
+						return octosql.NewString(hex.EncodeToString([]byte(values[0].Str))), nil
 					},
 				},
-			},
-		},
-		// time
-		"now": {
-			Description: "Returns the current time.",
-			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{},
-					OutputType:    octosql.Time,
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewTime(time.Now()), nil
+						// Negative numbers are printed as their two's complement.
+						return octosql.NewString(strconv.FormatUint(uint64(values[0].Int), 16)), nil
 					},
 				},
 			},
 		},
-		"parse_time": {
-			Description: "Parses the time in the second argument using the pattern in the first argument. The pattern should be specified as in the Go standard library time.Parse function: https://pkg.go.dev/time#pkg-constants",
+		"unhex": {
+			Description: "Decodes the hexadecimal string into the bytes it represents. Returns NULL if the string isn't valid hex.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
-					OutputType:    octosql.TypeSum(octosql.Time, octosql.Null),
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						t, err := time.Parse(values[0].Str, values[1].Str)
+						decoded, err := hex.DecodeString(values[0].Str)
 						if err != nil {
-							log.Printf("error parsing time: %s", err)
+							log.Printf("couldn't decode hex string '%s': %s", values[0].Str, err)
 							return octosql.NewNull(), nil
 						}
-						return octosql.NewTime(t), nil
+						return octosql.NewString(string(decoded)), nil
 					},
 				},
 			},
 		},
-		"time_from_unix": {
-			Description: "Parses the unix timestamp as a time.",
+		"url_encode": {
+			Description: "Escapes the string so it can be safely placed in a URL query.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.Int},
-					OutputType:    octosql.Time,
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewTime(time.Unix(int64(values[0].Int), 0)), nil
+						return octosql.NewString(url.QueryEscape(values[0].Str)), nil
 					},
 				},
+			},
+		},
+		"url_decode": {
+			Description: "Unescapes the URL query encoded string. Returns NULL if the string contains invalid escapes.",
+			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.Float},
-					OutputType:    octosql.Time,
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						i, f := math.Modf(values[0].Float)
-						return octosql.NewTime(time.Unix(int64(i), int64(float64(time.Second)*f))), nil
+						decoded, err := url.QueryUnescape(values[0].Str)
+						if err != nil {
+							log.Printf("couldn't decode url encoded string '%s': %s", values[0].Str, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(decoded), nil
 					},
 				},
 			},
 		},
-		"time_to_unix": {
-			Description: "Converts time to unix timestamp.",
+		"uuid": {
+			Description: "Returns a new random (version 4) UUID. Each call returns a different one.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.Time},
-					OutputType:    octosql.Int,
+					ArgumentTypes: []octosql.Type{},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(int(values[0].Time.Unix())), nil
+						id, err := uuid.NewRandom()
+						if err != nil {
+							return octosql.Value{}, fmt.Errorf("couldn't generate uuid: %w", err)
+						}
+						return octosql.NewString(id.String()), nil
 					},
 				},
 			},
+			NonDeterministic: true,
 		},
 		// Conversions
 		"int": {
@@ -939,6 +2183,14 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewInt(int(values[0].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Decimal},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(values[0].Decimal.IntPart())), nil
+					},
+				},
 			},
 		},
 		"float": {
@@ -983,6 +2235,73 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(float64(values[0].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Decimal},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						f, _ := values[0].Decimal.Float64()
+						return octosql.NewFloat(f), nil
+					},
+				},
+				intUnionConversionDescriptor(octosql.Float, func(i int) octosql.Value {
+					return octosql.NewFloat(float64(i))
+				}),
+			},
+		},
+		"decimal": {
+			Description: "Converts the argument to an exact decimal number. The optional second and third arguments are the total number of digits and the number of digits after the decimal point.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.NewDecimalType(octosql.IntDecimalPrecision, 0),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDecimal(decimal.NewFromInt(int64(values[0].Int))), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Decimal,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						d, err := toDecimal(values[0])
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewDecimal(d), nil
+					},
+				},
+				{
+					// Constant strings are parsed during typechecking already, so that they can be used as decimal literals.
+					ConstantTypeFn: func(types []octosql.Type, constants []*octosql.Value) (octosql.Type, bool) {
+						if len(types) != 1 || types[0].TypeID != octosql.TypeIDString {
+							return octosql.Type{}, false
+						}
+						if constants[0] != nil {
+							if d, err := decimal.NewFromString(constants[0].Str); err == nil {
+								return octosql.NewDecimal(d).Type(), true
+							}
+						}
+						return octosql.TypeSum(octosql.Decimal, octosql.Null), true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						d, err := decimal.NewFromString(values[0].Str)
+						if err != nil {
+							log.Printf("couldn't parse string '%s' as decimal: %s", values[0].Str, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewDecimal(d), nil
+					},
+				},
+				decimalUnaryDescriptor(sameDecimalType, func(d decimal.Decimal) decimal.Decimal {
+					return d
+				}),
+				decimalConversionDescriptor,
+				intUnionConversionDescriptor(octosql.NewDecimalType(octosql.IntDecimalPrecision, 0), func(i int) octosql.Value {
+					return octosql.NewDecimal(decimal.NewFromInt(int64(i)))
+				}),
 			},
 		},
 		"string": {
@@ -1000,7 +2319,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 		},
 		// Array Functions
 		"[]": {
-			Description: "Implements the indexing operator: list[index]",
+			Description: "Implements the indexing operator: list[index]. Returns null if the index is out of range.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
@@ -1021,7 +2340,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						if values[1].Int >= len(values[0].List) {
+						if values[1].Int < 0 || values[1].Int >= len(values[0].List) {
 							return octosql.NewNull(), nil
 						}
 						return values[0].List[values[1].Int], nil
@@ -1029,6 +2348,49 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"[:]": {
+			Description: "Implements the slicing operator: list[from:to]. The end of the list is used if to is omitted. Both bounds get clamped to the list.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 && len(ts) != 3 {
+							return octosql.Type{}, false
+						}
+						if ts[0].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						for _, t := range ts[1:] {
+							if t.TypeID != octosql.TypeIDInt {
+								return octosql.Type{}, false
+							}
+						}
+						return ts[0], true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						list := values[0].List
+						clamp := func(i int) int {
+							if i < 0 {
+								return 0
+							}
+							if i > len(list) {
+								return len(list)
+							}
+							return i
+						}
+						from := clamp(values[1].Int)
+						to := len(list)
+						if len(values) == 3 {
+							to = clamp(values[2].Int)
+						}
+						if from >= to {
+							return octosql.NewList(nil), nil
+						}
+						return octosql.NewList(list[from:to]), nil
+					},
+				},
+			},
+		},
 		"in": {
 			Description: "",
 			Descriptors: []physical.FunctionDescriptor{
@@ -1135,3 +2497,210 @@ func FunctionMap() map[string]physical.FunctionDetails {
 		},
 	}
 }
+
+var nonDeterministicFunctions map[string]bool
+var nonDeterministicFunctionsOnce sync.Once
+
+// IsNonDeterministic checks if the built-in function with the given name is marked as non-deterministic.
+func IsNonDeterministic(name string) bool {
+	nonDeterministicFunctionsOnce.Do(func() {
+		nonDeterministicFunctions = make(map[string]bool)
+		for name, details := range FunctionMap() {
+			if details.NonDeterministic {
+				nonDeterministicFunctions[name] = true
+			}
+		}
+	})
+	return nonDeterministicFunctions[name]
+}
+
+// newRegexpCache returns a function compiling regexp patterns, which keeps the compiled ones in a cache.
+func newRegexpCache(functionName string) func(pattern string) (*regexp.Regexp, error) {
+	regexpCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 128,     // number of keys to track frequency of (10M).
+		MaxCost:     1 << 26, // maximum cost of cache (64MB).
+		BufferItems: 64,      // number of keys per Get buffer.
+	})
+	if err != nil {
+		panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+	}
+
+	return func(pattern string) (*regexp.Regexp, error) {
+		if cached, ok := regexpCache.Get(pattern); ok {
+			return cached.(*regexp.Regexp), nil
+		}
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't compile %s pattern regexp expression: '%s': %w", functionName, pattern, err)
+		}
+
+		regexpCache.Set(pattern, compiled, 1)
+		return compiled, nil
+	}
+}
+
+// regexpGroup returns the index of the group provided in the optional third argument, or 0 for the whole match.
+func regexpGroup(functionName string, reg *regexp.Regexp, values []octosql.Value) (int, error) {
+	if len(values) < 3 {
+		return 0, nil
+	}
+	group := values[2].Int
+	if group < 0 || group > reg.NumSubexp() {
+		return 0, fmt.Errorf("%s group index %d out of range, pattern '%s' has %d groups", functionName, group, reg.String(), reg.NumSubexp())
+	}
+	return group, nil
+}
+
+func regexpExtract() func(values []octosql.Value) (octosql.Value, error) {
+	compile := newRegexpCache("regexp_extract")
+
+	return func(values []octosql.Value) (octosql.Value, error) {
+		reg, err := compile(values[1].Str)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		group, err := regexpGroup("regexp_extract", reg, values)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+
+		match := reg.FindStringSubmatchIndex(values[0].Str)
+		if match == nil || match[2*group] == -1 {
+			return octosql.NewNull(), nil
+		}
+		return octosql.NewString(values[0].Str[match[2*group]:match[2*group+1]]), nil
+	}
+}
+
+func regexpExtractAll() func(values []octosql.Value) (octosql.Value, error) {
+	compile := newRegexpCache("regexp_extract_all")
+
+	return func(values []octosql.Value) (octosql.Value, error) {
+		reg, err := compile(values[1].Str)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+		group, err := regexpGroup("regexp_extract_all", reg, values)
+		if err != nil {
+			return octosql.Value{}, err
+		}
+
+		matches := reg.FindAllStringSubmatchIndex(values[0].Str, -1)
+		out := make([]octosql.Value, 0, len(matches))
+		for _, match := range matches {
+			// Matches in which the group doesn't participate are skipped.
+			if match[2*group] == -1 {
+				continue
+			}
+			out = append(out, octosql.NewString(values[0].Str[match[2*group]:match[2*group+1]]))
+		}
+		return octosql.NewList(out), nil
+	}
+}
+
+// regexpGroupNames returns the names of the named groups of the pattern, in order.
+// Group names must be unique, as they're used as field names.
+func regexpGroupNames(reg *regexp.Regexp) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range reg.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate group name '%s'", name)
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// regexpNamedGroupType is the type of the name and value of a named group, returned by regexp_named_groups for non-constant patterns.
+var regexpNamedGroupType = octosql.Type{
+	TypeID: octosql.TypeIDStruct,
+	Struct: struct{ Fields []octosql.StructField }{Fields: []octosql.StructField{
+		{Name: "name", Type: octosql.String},
+		{Name: "value", Type: octosql.TypeSum(octosql.String, octosql.Null)},
+	}},
+}
+
+// regexpGroupValue returns the text matched by the named group, or null if the group didn't participate in the match.
+func regexpGroupValue(reg *regexp.Regexp, match []int, text string, name string) octosql.Value {
+	group := reg.SubexpIndex(name)
+	if match[2*group] == -1 {
+		return octosql.NewNull()
+	}
+	return octosql.NewString(text[match[2*group]:match[2*group+1]])
+}
+
+// equalityTypeFn accepts any two arguments, other than a Decimal and a Float.
+// Like in arithmetic and ordering, exact Decimals aren't mixed with approximate Floats.
+func equalityTypeFn(types []octosql.Type) (octosql.Type, bool) {
+	if len(types) != 2 {
+		return octosql.Type{}, false
+	}
+	left, right := octosql.NonNullable(types[0]).TypeID, octosql.NonNullable(types[1]).TypeID
+	if left == octosql.TypeIDDecimal && right == octosql.TypeIDFloat || left == octosql.TypeIDFloat && right == octosql.TypeIDDecimal {
+		return octosql.Type{}, false
+	}
+	return octosql.Boolean, true
+}
+
+// equal checks if the values are equal, like octosql.Value.Equal.
+// Ints are compared numerically with Floats and Decimals, as they may meet at runtime in a union.
+func equal(left, right octosql.Value) bool {
+	if left.TypeID == octosql.TypeIDNull && right.TypeID == octosql.TypeIDNull {
+		return false
+	}
+	return notDistinct(left, right)
+}
+
+// notDistinct checks if the values are equal, with NULLs being equal to each other.
+// Ints are compared numerically with Floats and Decimals, so 1 isn't distinct from 1.0.
+func notDistinct(left, right octosql.Value) bool {
+	switch {
+	case left.TypeID == octosql.TypeIDInt && right.TypeID == octosql.TypeIDFloat:
+		return float64(left.Int) == right.Float
+	case left.TypeID == octosql.TypeIDFloat && right.TypeID == octosql.TypeIDInt:
+		return left.Float == float64(right.Int)
+	case left.TypeID == octosql.TypeIDInt && right.TypeID == octosql.TypeIDDecimal:
+		return decimal.NewFromInt(int64(left.Int)).Equal(right.Decimal)
+	case left.TypeID == octosql.TypeIDDecimal && right.TypeID == octosql.TypeIDInt:
+		return left.Decimal.Equal(decimal.NewFromInt(int64(right.Int)))
+	}
+	return left.Compare(right) == 0
+}
+
+// intUnionConversionDescriptor converts the Int alternative of a union of Ints and values of the output type, like Int | Float.
+// Values of the output type are passed through unchanged, so that the result of i.e. a set operation on Ints and Floats is a Float.
+func intUnionConversionDescriptor(outputType octosql.Type, convert func(int) octosql.Value) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+			if len(ts) != 1 || ts[0].TypeID != octosql.TypeIDUnion {
+				return octosql.Type{}, false
+			}
+			out := outputType
+			hasInt, hasOutputType := false, false
+			for _, alternative := range ts[0].Union.Alternatives {
+				switch alternative.TypeID {
+				case octosql.TypeIDInt:
+					hasInt = true
+				case outputType.TypeID:
+					hasOutputType = true
+					out = octosql.TypeSum(out, alternative)
+				default:
+					return octosql.Type{}, false
+				}
+			}
+			return out, hasInt && hasOutputType
+		},
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			if values[0].TypeID != octosql.TypeIDInt {
+				return values[0], nil
+			}
+			return convert(values[0].Int), nil
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package functions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// parseJSONPath parses a JSON path like $.items[0].name or $['key with spaces'] into the keys to follow.
+// Array indexes are kept as decimal numbers, as expected by fastjson.Value.Get.
+func parseJSONPath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid JSON path '%s': must start with '$'", path)
+	}
+
+	var keys []string
+	for i := 1; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			start := i
+			for i < len(path) && path[i] != '.' && path[i] != '[' {
+				i++
+			}
+			if start == i {
+				return nil, fmt.Errorf("invalid JSON path '%s': empty key at position %d", path, start)
+			}
+			keys = append(keys, path[start:i])
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid JSON path '%s': unterminated '[' at position %d", path, i)
+			}
+			inner := path[i+1 : i+end]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				keys = append(keys, inner[1:len(inner)-1])
+			} else if index, err := strconv.Atoi(inner); err == nil && index >= 0 {
+				keys = append(keys, inner)
+			} else {
+				return nil, fmt.Errorf("invalid JSON path '%s': '[%s]' must be a non-negative index or a quoted key", path, inner)
+			}
+			i += end + 1
+		default:
+			return nil, fmt.Errorf("invalid JSON path '%s': unexpected '%c' at position %d", path, path[i], i)
+		}
+	}
+	return keys, nil
+}
+
+// jsonToScalar converts the JSON value to a string, number, boolean or null.
+// Objects and arrays are returned as their JSON text.
+func jsonToScalar(value *fastjson.Value) octosql.Value {
+	switch value.Type() {
+	case fastjson.TypeString:
+		v, _ := value.StringBytes()
+		return octosql.NewString(string(v))
+	case fastjson.TypeNumber:
+		v, _ := value.Float64()
+		return octosql.NewFloat(v)
+	case fastjson.TypeTrue:
+		return octosql.NewBoolean(true)
+	case fastjson.TypeFalse:
+		return octosql.NewBoolean(false)
+	case fastjson.TypeObject, fastjson.TypeArray:
+		return octosql.NewString(value.String())
+	default:
+		return octosql.NewNull()
+	}
+}
+
+// containsStruct checks if any value of the type may contain an object.
+func containsStruct(t octosql.Type) bool {
+	switch t.TypeID {
+	case octosql.TypeIDStruct:
+		return true
+	case octosql.TypeIDList:
+		return t.List.Element != nil && containsStruct(*t.List.Element)
+	case octosql.TypeIDUnion:
+		for _, alternative := range t.Union.Alternatives {
+			if containsStruct(alternative) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// roundFloat rounds the number half away from zero to the given number of decimal digits.
+// Negative digits round to tens, hundreds and so on.
+func roundFloat(x float64, digits int) float64 {
+	if digits >= 0 {
+		scale := math.Pow(10, float64(digits))
+		if scaled := x * scale; !math.IsInf(scaled, 0) {
+			return math.Round(scaled) / scale
+		}
+		// The number has no more digits to round at this scale.
+		return x
+	}
+	scale := math.Pow(10, float64(-digits))
+	return math.Round(x/scale) * scale
+}
+
+// roundInt rounds the integer half away from zero to the given number of decimal digits.
+// Only negative digits change the number.
+func roundInt(x int, digits int) int {
+	if digits >= 0 {
+		return x
+	}
+	if digits < -18 {
+		return 0
+	}
+	scale := 1
+	for i := 0; i < -digits; i++ {
+		scale *= 10
+	}
+	if x < 0 {
+		return -((-x + scale/2) / scale * scale)
+	}
+	return (x + scale/2) / scale * scale
+}
+
+// modDescriptors are shared by the % operator and the mod function.
+// The result has the sign of the dividend.
+func modDescriptors() []physical.FunctionDescriptor {
+	return []physical.FunctionDescriptor{
+		{
+			ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+			OutputType:    octosql.Int,
+			Strict:        true,
+			Function: func(values []octosql.Value) (octosql.Value, error) {
+				if values[1].Int == 0 {
+					return octosql.Value{}, fmt.Errorf("modulo by zero: %d %% 0", values[0].Int)
+				}
+				return octosql.NewInt(values[0].Int % values[1].Int), nil
+			},
+		},
+		{
+			ArgumentTypes: []octosql.Type{octosql.Float, octosql.Float},
+			OutputType:    octosql.Float,
+			Strict:        true,
+			Function: func(values []octosql.Value) (octosql.Value, error) {
+				return octosql.NewFloat(math.Mod(values[0].Float, values[1].Float)), nil
+			},
+		},
+		decimalBinaryDescriptor(decimalRemainderType, decimalRemainder),
+	}
+}
+
+// floatFunctionDescriptors returns the descriptors of a function taking a single float argument.
+// Int arguments get converted to floats during typechecking.
+func floatFunctionDescriptors(fn func(float64) float64) []physical.FunctionDescriptor {
+	return []physical.FunctionDescriptor{
+		{
+			ArgumentTypes: []octosql.Type{octosql.Float},
+			OutputType:    octosql.Float,
+			Strict:        true,
+			Function: func(values []octosql.Value) (octosql.Value, error) {
+				return octosql.NewFloat(fn(values[0].Float)), nil
+			},
+		},
+	}
+}
+
+// seededRandom returns a random number generator function, seeded with the value of its argument.
+// It's seeded again whenever the seed changes, so a constant seed gives a reproducible sequence.
+func seededRandom(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+	var generator *rand.Rand
+	var seed int
+	return func(values []octosql.Value) (octosql.Value, error) {
+		if generator == nil || values[0].Int != seed {
+			seed = values[0].Int
+			generator = rand.New(rand.NewSource(int64(seed)))
+		}
+		return octosql.NewFloat(generator.Float64()), nil
+	}
+}
+
+// commonType returns the type shared by all the arguments, ignoring nullability.
+// Arguments which are always NULL match any type.
+func commonType(types []octosql.Type) (octosql.Type, bool) {
+	out := octosql.Null
+	for _, t := range types {
+		t = octosql.NonNullable(t)
+		if t.TypeID == octosql.TypeIDNull {
+			continue
+		}
+		if out.TypeID == octosql.TypeIDNull {
+			out = t
+			continue
+		}
+		if !out.Equals(t) {
+			return octosql.Type{}, false
+		}
+		// Decimal types get widened to fit all the arguments.
+		out = octosql.TypeSum(out, t)
+	}
+	return out, true
+}
+
+// allNullable checks if all the types may be NULL.
+func allNullable(types []octosql.Type) bool {
+	for _, t := range types {
+		if octosql.Null.Is(t) != octosql.TypeRelationIs {
+			return false
+		}
+	}
+	return true
+}
+
+// extremeTypeFn is the type function of greatest and least.
+func extremeTypeFn(types []octosql.Type) (octosql.Type, bool) {
+	if len(types) == 0 {
+		return octosql.Type{}, false
+	}
+	t, ok := commonType(types)
+	if !ok {
+		return octosql.Type{}, false
+	}
+	if allNullable(types) {
+		return octosql.TypeSum(t, octosql.Null), true
+	}
+	return t, true
+}
+
+// extreme returns the greatest or least of the non-null values, or NULL if all of them are NULL.
+func extreme(values []octosql.Value, greatest bool) octosql.Value {
+	out := octosql.NewNull()
+	for _, value := range values {
+		if value.TypeID == octosql.TypeIDNull {
+			continue
+		}
+		if out.TypeID == octosql.TypeIDNull {
+			out = value
+			continue
+		}
+		if cmp := value.Compare(out); (greatest && cmp > 0) || (!greatest && cmp < 0) {
+			out = value
+		}
+	}
+	return out
+}
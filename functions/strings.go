@@ -0,0 +1,116 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// pad pads the string to the given length in runes, using the fill string.
+// If the string is longer than the length, it gets truncated.
+func pad(s string, length int, fill string, left bool) string {
+	runes := []rune(s)
+	if length <= len(runes) {
+		if length < 0 {
+			length = 0
+		}
+		return string(runes[:length])
+	}
+	fillRunes := []rune(fill)
+	if len(fillRunes) == 0 {
+		return s
+	}
+
+	padding := make([]rune, length-len(runes))
+	for i := range padding {
+		padding[i] = fillRunes[i%len(fillRunes)]
+	}
+	if left {
+		return string(padding) + s
+	}
+	return s + string(padding)
+}
+
+// initcap converts the first letter of each word to upper case and the rest to lower case.
+// Words are sequences of letters and digits.
+func initcap(s string) string {
+	var builder strings.Builder
+	builder.Grow(len(s))
+	inWord := false
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if inWord {
+				builder.WriteRune(unicode.ToLower(r))
+			} else {
+				builder.WriteRune(unicode.ToUpper(r))
+			}
+			inWord = true
+		} else {
+			builder.WriteRune(r)
+			inWord = false
+		}
+	}
+	return builder.String()
+}
+
+// levenshtein returns the edit distance between the two strings, counted in runes.
+func levenshtein(a, b string) int {
+	left, right := []rune(a), []rune(b)
+	previous := make([]int, len(right)+1)
+	current := make([]int, len(right)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(left); i++ {
+		current[0] = i
+		for j := 1; j <= len(right); j++ {
+			cost := 1
+			if left[i-1] == right[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(right)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// formatArgument converts the value to a Go value which can be used with the fmt package verbs.
+func formatArgument(value octosql.Value) interface{} {
+	switch value.TypeID {
+	case octosql.TypeIDNull:
+		return "NULL"
+	case octosql.TypeIDInt:
+		return value.Int
+	case octosql.TypeIDFloat:
+		return value.Float
+	case octosql.TypeIDBoolean:
+		return value.Boolean
+	case octosql.TypeIDString:
+		return value.Str
+	case octosql.TypeIDTime:
+		return value.Time
+	case octosql.TypeIDDuration:
+		return value.Duration
+	default:
+		return value.String()
+	}
+}
+
+// format formats the arguments according to the printf-style format string.
+func format(formatString string, values []octosql.Value) string {
+	args := make([]interface{}, len(values))
+	for i := range values {
+		args[i] = formatArgument(values[i])
+	}
+	return fmt.Sprintf(formatString, args...)
+}
@@ -0,0 +1,208 @@
+package functions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+)
+
+// truncateTime truncates the time to the given unit, in the location of the time.
+func truncateTime(t time.Time, unit string) (time.Time, error) {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "microsecond":
+		return time.Date(year, month, day, hour, min, sec, t.Nanosecond()/1000*1000, t.Location()), nil
+	case "millisecond":
+		return time.Date(year, month, day, hour, min, sec, t.Nanosecond()/1000000*1000000, t.Location()), nil
+	case "second":
+		return time.Date(year, month, day, hour, min, sec, 0, t.Location()), nil
+	case "minute":
+		return time.Date(year, month, day, hour, min, 0, 0, t.Location()), nil
+	case "hour":
+		return time.Date(year, month, day, hour, 0, 0, 0, t.Location()), nil
+	case "day":
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location()), nil
+	case "week":
+		// Weeks start on Monday, as in ISO 8601.
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location()), nil
+	case "month":
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location()), nil
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, t.Location()), nil
+	case "year":
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location()), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid date_trunc unit '%s', must be one of: microsecond, millisecond, second, minute, hour, day, week, month, quarter, year", unit)
+	}
+}
+
+// timePart returns the given part of the time, in the location of the time.
+func timePart(t time.Time, part string) (int, error) {
+	switch strings.ToLower(part) {
+	case "year":
+		return t.Year(), nil
+	case "quarter":
+		return (int(t.Month())-1)/3 + 1, nil
+	case "month":
+		return int(t.Month()), nil
+	case "week":
+		_, week := t.ISOWeek()
+		return week, nil
+	case "day":
+		return t.Day(), nil
+	case "dow", "dayofweek":
+		// Sunday is 0.
+		return int(t.Weekday()), nil
+	case "isodow":
+		// Monday is 1, Sunday is 7.
+		return (int(t.Weekday())+6)%7 + 1, nil
+	case "doy", "dayofyear":
+		return t.YearDay(), nil
+	case "hour":
+		return t.Hour(), nil
+	case "minute":
+		return t.Minute(), nil
+	case "second":
+		return t.Second(), nil
+	case "millisecond":
+		return t.Nanosecond() / int(time.Millisecond), nil
+	case "microsecond":
+		return t.Nanosecond() / int(time.Microsecond), nil
+	case "nanosecond":
+		return t.Nanosecond(), nil
+	case "epoch":
+		return int(t.Unix()), nil
+	default:
+		return 0, fmt.Errorf("invalid date_part part '%s', must be one of: year, quarter, month, week, day, dow, isodow, doy, hour, minute, second, millisecond, microsecond, nanosecond, epoch", part)
+	}
+}
+
+var weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+var monthNames = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+
+// formatTime formats the time using a strftime layout.
+func formatTime(t time.Time, layout string) (string, error) {
+	var builder strings.Builder
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			builder.WriteByte(layout[i])
+			continue
+		}
+		i++
+		if i == len(layout) {
+			return "", fmt.Errorf("format_time layout '%s' ends with an unfinished directive", layout)
+		}
+
+		switch layout[i] {
+		case 'Y':
+			builder.WriteString(strconv.Itoa(t.Year()))
+		case 'y':
+			fmt.Fprintf(&builder, "%02d", t.Year()%100)
+		case 'm':
+			fmt.Fprintf(&builder, "%02d", int(t.Month()))
+		case 'B':
+			builder.WriteString(monthNames[t.Month()-1])
+		case 'b':
+			builder.WriteString(monthNames[t.Month()-1][:3])
+		case 'd':
+			fmt.Fprintf(&builder, "%02d", t.Day())
+		case 'e':
+			fmt.Fprintf(&builder, "%2d", t.Day())
+		case 'j':
+			fmt.Fprintf(&builder, "%03d", t.YearDay())
+		case 'A':
+			builder.WriteString(weekdayNames[t.Weekday()])
+		case 'a':
+			builder.WriteString(weekdayNames[t.Weekday()][:3])
+		case 'w':
+			builder.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'u':
+			builder.WriteString(strconv.Itoa((int(t.Weekday())+6)%7 + 1))
+		case 'V':
+			_, week := t.ISOWeek()
+			fmt.Fprintf(&builder, "%02d", week)
+		case 'G':
+			year, _ := t.ISOWeek()
+			builder.WriteString(strconv.Itoa(year))
+		case 'H':
+			fmt.Fprintf(&builder, "%02d", t.Hour())
+		case 'I':
+			hour := t.Hour() % 12
+			if hour == 0 {
+				hour = 12
+			}
+			fmt.Fprintf(&builder, "%02d", hour)
+		case 'p':
+			if t.Hour() < 12 {
+				builder.WriteString("AM")
+			} else {
+				builder.WriteString("PM")
+			}
+		case 'M':
+			fmt.Fprintf(&builder, "%02d", t.Minute())
+		case 'S':
+			fmt.Fprintf(&builder, "%02d", t.Second())
+		case 'L':
+			fmt.Fprintf(&builder, "%03d", t.Nanosecond()/int(time.Millisecond))
+		case 'f':
+			fmt.Fprintf(&builder, "%06d", t.Nanosecond()/int(time.Microsecond))
+		case 'N':
+			fmt.Fprintf(&builder, "%09d", t.Nanosecond())
+		case 's':
+			builder.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'z':
+			builder.WriteString(t.Format("-0700"))
+		case 'Z':
+			builder.WriteString(t.Format("MST"))
+		case 'F':
+			builder.WriteString(t.Format("2006-01-02"))
+		case 'T':
+			builder.WriteString(t.Format("15:04:05"))
+		case 'R':
+			builder.WriteString(t.Format("15:04"))
+		case 'D':
+			builder.WriteString(t.Format("01/02/06"))
+		case 'n':
+			builder.WriteByte('\n')
+		case 't':
+			builder.WriteByte('\t')
+		case '%':
+			builder.WriteByte('%')
+		default:
+			return "", fmt.Errorf("unknown format_time directive '%%%c'", layout[i])
+		}
+	}
+	return builder.String(), nil
+}
+
+// newLocationCache returns a function loading time zone locations, which keeps the loaded ones in a cache.
+func newLocationCache() func(name string) (*time.Location, error) {
+	locationCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 128, // number of keys to track frequency of.
+		MaxCost:     64,  // maximum number of cached locations.
+		BufferItems: 64,  // number of keys per Get buffer.
+	})
+	if err != nil {
+		panic(fmt.Errorf("couldn't initialize time zone location cache: %w", err))
+	}
+
+	return func(name string) (*time.Location, error) {
+		if cached, ok := locationCache.Get(name); ok {
+			return cached.(*time.Location), nil
+		}
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't load time zone '%s': %w", name, err)
+		}
+
+		locationCache.Set(name, location, 1)
+		return location, nil
+	}
+}
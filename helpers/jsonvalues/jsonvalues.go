@@ -0,0 +1,182 @@
+// Package jsonvalues converts JSON values to OctoSQL types and values.
+package jsonvalues
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/valyala/fastjson"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// GetOctoSQLType returns the type of the JSON value.
+func GetOctoSQLType(value *fastjson.Value) octosql.Type {
+	return GetOctoSQLTypeWithDecimals(value, false)
+}
+
+// GetOctoSQLTypeWithDecimals returns the type of the JSON value.
+// Numbers are typed as decimals with the precision and scale they're written with if decimalNumbers is set, floats otherwise.
+func GetOctoSQLTypeWithDecimals(value *fastjson.Value, decimalNumbers bool) octosql.Type {
+	switch value.Type() {
+	case fastjson.TypeNull:
+		return octosql.Null
+	case fastjson.TypeString:
+		v, _ := value.StringBytes()
+		if _, err := time.Parse(time.RFC3339Nano, string(v)); err == nil {
+			return octosql.Time
+		} else {
+			return octosql.String
+		}
+	case fastjson.TypeNumber:
+		if decimalNumbers {
+			if d, err := decimal.NewFromString(value.String()); err == nil {
+				return octosql.NewDecimal(d).Type()
+			}
+		}
+		return octosql.Float
+	case fastjson.TypeTrue, fastjson.TypeFalse:
+		return octosql.Boolean
+	case fastjson.TypeObject:
+		obj, _ := value.Object()
+		fields := make([]octosql.StructField, 0, obj.Len())
+		obj.Visit(func(key []byte, v *fastjson.Value) {
+			fields = append(fields, octosql.StructField{
+				Name: string(key),
+				Type: GetOctoSQLTypeWithDecimals(v, decimalNumbers),
+			})
+		})
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i].Name < fields[j].Name
+		})
+		return octosql.Type{
+			TypeID: octosql.TypeIDStruct,
+			Struct: struct{ Fields []octosql.StructField }{Fields: fields},
+		}
+	case fastjson.TypeArray:
+		arr, _ := value.Array()
+		var elementType *octosql.Type
+		for i := range arr {
+			if elementType != nil {
+				t := octosql.TypeSum(*elementType, GetOctoSQLTypeWithDecimals(arr[i], decimalNumbers))
+				elementType = &t
+			} else {
+				t := GetOctoSQLTypeWithDecimals(arr[i], decimalNumbers)
+				elementType = &t
+			}
+		}
+		return octosql.Type{
+			TypeID: octosql.TypeIDList,
+			List: struct {
+				Element *octosql.Type
+			}{
+				Element: elementType,
+			},
+		}
+	}
+
+	panic(fmt.Sprintf("unexhaustive json input value match: %s %+v", value.Type().String(), value))
+}
+
+// GetOctoSQLValue converts the JSON value to a value of the given type.
+// It returns false if the value doesn't match the type.
+func GetOctoSQLValue(t octosql.Type, value *fastjson.Value) (out octosql.Value, ok bool) {
+	if value == nil {
+		return octosql.NewNull(), octosql.Null.Is(t) == octosql.TypeRelationIs
+	}
+
+	switch t.TypeID {
+	case octosql.TypeIDNull:
+		if value.Type() == fastjson.TypeNull {
+			return octosql.NewNull(), true
+		}
+	case octosql.TypeIDInt:
+		if value.Type() == fastjson.TypeNumber {
+			if v, err := value.Int(); err == nil {
+				return octosql.NewInt(v), true
+			}
+		}
+	case octosql.TypeIDFloat:
+		if value.Type() == fastjson.TypeNumber {
+			v, _ := value.Float64()
+			return octosql.NewFloat(v), true
+		}
+	case octosql.TypeIDBoolean:
+		if value.Type() == fastjson.TypeTrue {
+			return octosql.NewBoolean(true), true
+		} else if value.Type() == fastjson.TypeFalse {
+			return octosql.NewBoolean(false), true
+		}
+	case octosql.TypeIDString:
+		if value.Type() == fastjson.TypeString {
+			v, _ := value.StringBytes()
+			return octosql.NewString(string(v)), true
+		}
+	case octosql.TypeIDTime:
+		if value.Type() == fastjson.TypeString {
+			v, _ := value.StringBytes()
+			if parsed, err := time.Parse(time.RFC3339Nano, string(v)); err == nil {
+				return octosql.NewTime(parsed), true
+			}
+		}
+	case octosql.TypeIDDuration:
+		if value.Type() == fastjson.TypeString {
+			v, _ := value.StringBytes()
+			if parsed, err := time.ParseDuration(string(v)); err == nil {
+				return octosql.NewDuration(parsed), true
+			}
+		}
+	case octosql.TypeIDDecimal:
+		if value.Type() == fastjson.TypeNumber {
+			// The number is parsed from its text, so that no digits get lost on the way.
+			if v, err := decimal.NewFromString(value.String()); err == nil {
+				if v, err := octosql.FitDecimal(v, t); err == nil {
+					return octosql.NewDecimal(v), true
+				}
+			}
+		}
+	case octosql.TypeIDList:
+		if value.Type() == fastjson.TypeArray {
+			arr, _ := value.Array()
+			if t.List.Element == nil {
+				// Only empty lists have no element type.
+				return octosql.NewList(nil), len(arr) == 0
+			}
+			values := make([]octosql.Value, len(arr))
+
+			outOk := true
+			for i := range arr {
+				curValue, curOk := GetOctoSQLValue(*t.List.Element, arr[i])
+				values[i] = curValue
+				outOk = outOk && curOk
+			}
+			return octosql.NewList(values), outOk
+		}
+	case octosql.TypeIDStruct:
+		if value.Type() == fastjson.TypeObject {
+			obj, _ := value.Object()
+			values := make([]octosql.Value, len(t.Struct.Fields))
+
+			outOk := true
+			for i, field := range t.Struct.Fields {
+				curValue, curOk := GetOctoSQLValue(field.Type, obj.Get(field.Name))
+				values[i] = curValue
+				outOk = outOk && curOk
+			}
+			return octosql.NewStruct(values), outOk
+		}
+	case octosql.TypeIDUnion:
+		for _, alternative := range t.Union.Alternatives {
+			v, ok := GetOctoSQLValue(alternative, value)
+			if ok {
+				return v, true
+			}
+		}
+	case octosql.TypeIDAny:
+		return GetOctoSQLValue(GetOctoSQLType(value), value)
+	}
+
+	return octosql.ZeroValue, false
+}
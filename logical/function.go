@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
 type FunctionExpression struct {
+	Positioned
 	Name      string
 	Arguments []Expression
 }
@@ -22,10 +25,41 @@ func NewFunctionExpression(name string, args []Expression) *FunctionExpression {
 }
 
 func (fe *FunctionExpression) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	defer fe.annotatePanic()
+
 	arguments := make([]physical.Expression, len(fe.Arguments))
 	for i := range fe.Arguments {
 		arguments[i] = fe.Arguments[i].Typecheck(ctx, env, logicalEnv)
 	}
+	// Query parameters compared with a value get converted to its type, so that i.e. r.i > :min, r.i BETWEEN :a AND :b or r.i IN (:a, :b) work.
+	if comparedType, ok := comparedArgumentType(fe.Arguments, arguments); ok && comparisonFunctions[fe.Name] {
+		for i := range fe.Arguments {
+			switch argument := fe.Arguments[i].(type) {
+			case *Parameter:
+				arguments[i] = argument.TypecheckAs(ctx, env, logicalEnv, comparedType)
+			case *Tuple:
+				arguments[i] = argument.typecheckWithParametersAs(ctx, env, logicalEnv, comparedType)
+			}
+		}
+	}
+
+	details := env.Functions[fe.Name]
+	out, found := typecheckFunctionCall(fe.Name, details, arguments)
+	if !found {
+		// The Int alternative of numeric unions gets converted first, so that i.e. an Int | Float argument matches the Float descriptors, instead of being asserted to be an Int.
+		promoted := make([]physical.Expression, len(arguments))
+		anyPromoted := false
+		for i := range arguments {
+			var ok bool
+			promoted[i], ok = promoteIntAlternative(env.Functions, arguments[i])
+			anyPromoted = anyPromoted || ok
+		}
+		if anyPromoted {
+			arguments = promoted
+			out, found = typecheckFunctionCall(fe.Name, details, arguments)
+		}
+	}
+
 	argumentTypes := make([]octosql.Type, len(arguments))
 	nonNullableArgumentTypes := make([]octosql.Type, len(arguments))
 	for i := range arguments {
@@ -33,50 +67,6 @@ func (fe *FunctionExpression) Typecheck(ctx context.Context, env physical.Enviro
 		nonNullableArgumentTypes[i] = octosql.NonNullable(arguments[i].Type)
 	}
 
-	var out physical.Expression
-	var found bool
-
-	details := env.Functions[fe.Name]
-descriptorLoop:
-	for _, descriptor := range details.Descriptors {
-		argTypes := argumentTypes
-		if descriptor.Strict {
-			argTypes = nonNullableArgumentTypes
-		}
-		if descriptor.TypeFn != nil {
-			if outputType, ok := descriptor.TypeFn(argTypes); ok {
-				found = true
-				out = physical.Expression{
-					Type:           outputType,
-					ExpressionType: physical.ExpressionTypeFunctionCall,
-					FunctionCall: &physical.FunctionCall{
-						Name:               fe.Name,
-						Arguments:          arguments,
-						FunctionDescriptor: descriptor,
-					},
-				}
-			}
-		} else {
-			if len(argTypes) != len(descriptor.ArgumentTypes) {
-				continue
-			}
-			for i := range argTypes {
-				if argTypes[i].Is(descriptor.ArgumentTypes[i]) < octosql.TypeRelationIs {
-					continue descriptorLoop
-				}
-			}
-			found = true
-			out = physical.Expression{
-				Type:           descriptor.OutputType,
-				ExpressionType: physical.ExpressionTypeFunctionCall,
-				FunctionCall: &physical.FunctionCall{
-					Name:               fe.Name,
-					Arguments:          arguments,
-					FunctionDescriptor: descriptor,
-				},
-			}
-		}
-	}
 	if !found {
 	descriptorLoop2:
 		for _, descriptor := range details.Descriptors {
@@ -124,6 +114,15 @@ descriptorLoop:
 		}
 	}
 
+	// Int arguments get converted to Floats or Decimals if that makes the arguments match, so that i.e. 1 + 2.5 works without casts.
+	for i := 0; !found && i < len(intPromotions); i++ {
+		out, found = typecheckWithIntPromotion(fe.Name, details, arguments, intPromotions[i])
+	}
+
+	if !found {
+		out, found = typecheckWithParameterConversion(ctx, env, logicalEnv, fe.Name, details, fe.Arguments, arguments)
+	}
+
 	if !found {
 		argTypeNames := make([]string, len(arguments))
 		for i := range argTypeNames {
@@ -142,3 +141,375 @@ descriptorLoop:
 
 	return out
 }
+
+// typecheckFunctionCall looks for the descriptor which matches the argument types exactly.
+// The last matching descriptor takes precedence.
+func typecheckFunctionCall(name string, details physical.FunctionDetails, arguments []physical.Expression) (physical.Expression, bool) {
+	argumentTypes := make([]octosql.Type, len(arguments))
+	nonNullableArgumentTypes := make([]octosql.Type, len(arguments))
+	for i := range arguments {
+		argumentTypes[i] = arguments[i].Type
+		nonNullableArgumentTypes[i] = octosql.NonNullable(arguments[i].Type)
+	}
+
+	var out physical.Expression
+	var found bool
+
+descriptorLoop:
+	for _, descriptor := range details.Descriptors {
+		argTypes := argumentTypes
+		if descriptor.Strict {
+			argTypes = nonNullableArgumentTypes
+		}
+		if descriptor.ConstantTypeFn != nil {
+			if outputType, ok := descriptor.ConstantTypeFn(argTypes, constantArguments(arguments)); ok {
+				found = true
+				out = physical.Expression{
+					Type:           outputType,
+					ExpressionType: physical.ExpressionTypeFunctionCall,
+					FunctionCall: &physical.FunctionCall{
+						Name:               name,
+						Arguments:          arguments,
+						FunctionDescriptor: descriptor,
+					},
+				}
+			}
+		} else if descriptor.TypeFn != nil {
+			if outputType, ok := descriptor.TypeFn(argTypes); ok {
+				found = true
+				out = physical.Expression{
+					Type:           outputType,
+					ExpressionType: physical.ExpressionTypeFunctionCall,
+					FunctionCall: &physical.FunctionCall{
+						Name:               name,
+						Arguments:          arguments,
+						FunctionDescriptor: descriptor,
+					},
+				}
+			}
+		} else {
+			if len(argTypes) != len(descriptor.ArgumentTypes) {
+				continue
+			}
+			for i := range argTypes {
+				if argTypes[i].Is(descriptor.ArgumentTypes[i]) < octosql.TypeRelationIs {
+					continue descriptorLoop
+				}
+			}
+			found = true
+			out = physical.Expression{
+				Type:           descriptor.OutputType,
+				ExpressionType: physical.ExpressionTypeFunctionCall,
+				FunctionCall: &physical.FunctionCall{
+					Name:               name,
+					Arguments:          arguments,
+					FunctionDescriptor: descriptor,
+				},
+			}
+		}
+	}
+
+	return out, found
+}
+
+// constantArguments returns the values of the arguments which are constants, nil for the other ones.
+func constantArguments(arguments []physical.Expression) []*octosql.Value {
+	out := make([]*octosql.Value, len(arguments))
+	for i := range arguments {
+		if arguments[i].ExpressionType == physical.ExpressionTypeConstant {
+			out[i] = &arguments[i].Constant.Value
+		}
+	}
+	return out
+}
+
+// intPromotion is a conversion of Int arguments to another numeric type, done by the function with the given name.
+type intPromotion struct {
+	name       string
+	descriptor physical.FunctionDescriptor
+}
+
+// comparisonFunctions are the functions whose query parameter arguments, also in tuples, get the type of the compared argument.
+var comparisonFunctions = map[string]bool{
+	"=":                    true,
+	"!=":                   true,
+	"<":                    true,
+	"<=":                   true,
+	">":                    true,
+	">=":                   true,
+	"is distinct from":     true,
+	"is not distinct from": true,
+	"between":              true,
+	"not between":          true,
+	"in":                   true,
+	"not in":               true,
+}
+
+// comparedArgumentType returns the type of the first argument which is neither a query parameter nor a tuple.
+func comparedArgumentType(logicalArguments []Expression, arguments []physical.Expression) (octosql.Type, bool) {
+	for i := range logicalArguments {
+		switch logicalArguments[i].(type) {
+		case *Parameter, *Tuple:
+			continue
+		}
+		return arguments[i].Type, true
+	}
+	return octosql.Type{}, false
+}
+
+// typecheckWithParameterConversion looks for the first descriptor which matches the arguments after converting the query parameter arguments
+// to the argument types of the descriptor. Descriptors with a type function get the parameters converted to the type of one of the other arguments.
+// Descriptors whose argument types the parameter values can't be converted to are skipped.
+func typecheckWithParameterConversion(ctx context.Context, env physical.Environment, logicalEnv Environment, name string, details physical.FunctionDetails, logicalArguments []Expression, arguments []physical.Expression) (physical.Expression, bool) {
+	anyParameter := false
+	for i := range logicalArguments {
+		if _, ok := logicalArguments[i].(*Parameter); ok {
+			anyParameter = true
+		}
+	}
+	if !anyParameter {
+		return physical.Expression{}, false
+	}
+
+descriptorLoop:
+	for _, descriptor := range details.Descriptors {
+		if descriptor.TypeFn != nil {
+			if out, ok := typecheckTypeFnWithParameterConversion(ctx, env, logicalEnv, name, descriptor, logicalArguments, arguments); ok {
+				return out, true
+			}
+			continue
+		}
+		if descriptor.ConstantTypeFn != nil || len(arguments) != len(descriptor.ArgumentTypes) {
+			continue
+		}
+		outArguments := make([]physical.Expression, len(arguments))
+		for i := range arguments {
+			if parameter, ok := logicalArguments[i].(*Parameter); ok {
+				if !canConvertParameter(logicalEnv, parameter, descriptor.ArgumentTypes[i]) {
+					continue descriptorLoop
+				}
+				outArguments[i] = parameter.TypecheckAs(ctx, env, logicalEnv, descriptor.ArgumentTypes[i])
+				continue
+			}
+			argumentType := arguments[i].Type
+			if descriptor.Strict {
+				argumentType = octosql.NonNullable(argumentType)
+			}
+			if argumentType.Is(descriptor.ArgumentTypes[i]) < octosql.TypeRelationIs {
+				continue descriptorLoop
+			}
+			outArguments[i] = arguments[i]
+		}
+
+		return physical.Expression{
+			Type:           descriptor.OutputType,
+			ExpressionType: physical.ExpressionTypeFunctionCall,
+			FunctionCall: &physical.FunctionCall{
+				Name:               name,
+				Arguments:          outArguments,
+				FunctionDescriptor: descriptor,
+			},
+		}, true
+	}
+	return physical.Expression{}, false
+}
+
+// typecheckTypeFnWithParameterConversion tries converting all query parameter arguments to the type of each of the other arguments in turn,
+// until the type function of the descriptor accepts the arguments.
+func typecheckTypeFnWithParameterConversion(ctx context.Context, env physical.Environment, logicalEnv Environment, name string, descriptor physical.FunctionDescriptor, logicalArguments []Expression, arguments []physical.Expression) (physical.Expression, bool) {
+candidateLoop:
+	for candidate := range arguments {
+		if _, ok := logicalArguments[candidate].(*Parameter); ok {
+			continue
+		}
+		targetType := octosql.NonNullable(arguments[candidate].Type)
+		outArguments := make([]physical.Expression, len(arguments))
+		argTypes := make([]octosql.Type, len(arguments))
+		for i := range arguments {
+			if parameter, ok := logicalArguments[i].(*Parameter); ok {
+				if !canConvertParameter(logicalEnv, parameter, targetType) {
+					continue candidateLoop
+				}
+				outArguments[i] = parameter.TypecheckAs(ctx, env, logicalEnv, targetType)
+			} else {
+				outArguments[i] = arguments[i]
+			}
+			argTypes[i] = outArguments[i].Type
+			if descriptor.Strict {
+				argTypes[i] = octosql.NonNullable(argTypes[i])
+			}
+		}
+		outputType, ok := descriptor.TypeFn(argTypes)
+		if !ok {
+			continue
+		}
+		return physical.Expression{
+			Type:           outputType,
+			ExpressionType: physical.ExpressionTypeFunctionCall,
+			FunctionCall: &physical.FunctionCall{
+				Name:               name,
+				Arguments:          outArguments,
+				FunctionDescriptor: descriptor,
+			},
+		}, true
+	}
+	return physical.Expression{}, false
+}
+
+// canConvertParameter checks if the value of the query parameter can be converted to the type, without falling back to a String.
+func canConvertParameter(logicalEnv Environment, parameter *Parameter, t octosql.Type) bool {
+	parse, ok := parameterParsers[octosql.NonNullable(t).TypeID]
+	if !ok {
+		return false
+	}
+	text, ok := logicalEnv.QueryParameters[parameter.name]
+	if !ok {
+		return false
+	}
+	_, err := parse(text)
+	return err == nil
+}
+
+// intPromotions are tried in order, so Floats are preferred over Decimals.
+var intPromotions = []intPromotion{
+	{
+		name: "float",
+		descriptor: physical.FunctionDescriptor{
+			ArgumentTypes: []octosql.Type{octosql.Int},
+			OutputType:    octosql.Float,
+			Strict:        true,
+			Function: func(values []octosql.Value) (octosql.Value, error) {
+				return octosql.NewFloat(float64(values[0].Int)), nil
+			},
+		},
+	},
+	{
+		name: "decimal",
+		descriptor: physical.FunctionDescriptor{
+			ArgumentTypes: []octosql.Type{octosql.Int},
+			OutputType:    octosql.NewDecimalType(octosql.IntDecimalPrecision, 0),
+			Strict:        true,
+			Function: func(values []octosql.Value) (octosql.Value, error) {
+				return octosql.NewDecimal(decimal.NewFromInt(int64(values[0].Int))), nil
+			},
+		},
+	},
+}
+
+// promoteInt wraps the argument in the conversion, if it's a possibly nullable Int.
+func promoteInt(argument physical.Expression, promotion intPromotion) (physical.Expression, bool) {
+	if octosql.NonNullable(argument.Type).Is(octosql.Int) != octosql.TypeRelationIs {
+		return argument, false
+	}
+	outputType := promotion.descriptor.OutputType
+	if octosql.Null.Is(argument.Type) == octosql.TypeRelationIs {
+		outputType = octosql.TypeSum(outputType, octosql.Null)
+	}
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeFunctionCall,
+		FunctionCall: &physical.FunctionCall{
+			Name:               promotion.name,
+			Arguments:          []physical.Expression{argument},
+			FunctionDescriptor: promotion.descriptor,
+		},
+	}, true
+}
+
+// promoteIntTo wraps the argument, if it's a possibly nullable Int, in the conversion to the numeric type of the target, if there is one.
+func promoteIntTo(argument physical.Expression, target octosql.Type) (physical.Expression, bool) {
+	targetTypeID := octosql.NonNullable(target).TypeID
+	for _, promotion := range intPromotions {
+		if promotion.descriptor.OutputType.TypeID == targetTypeID {
+			return promoteInt(argument, promotion)
+		}
+	}
+	return argument, false
+}
+
+// promoteIntAlternative converts the Int alternative of a numeric union argument, like Int | Float, to the other numeric type in the union.
+// The conversion functions have descriptors for such unions, which pass values of the other alternative through unchanged.
+func promoteIntAlternative(functions map[string]physical.FunctionDetails, argument physical.Expression) (physical.Expression, bool) {
+	if octosql.NonNullable(argument.Type).TypeID != octosql.TypeIDUnion {
+		return argument, false
+	}
+	for _, promotion := range intPromotions {
+		out, ok := typecheckFunctionCall(promotion.name, functions[promotion.name], []physical.Expression{argument})
+		if !ok || out.FunctionCall.FunctionDescriptor.TypeFn == nil {
+			continue
+		}
+		if octosql.Null.Is(argument.Type) == octosql.TypeRelationIs {
+			out.Type = octosql.TypeSum(out.Type, octosql.Null)
+		}
+		return out, true
+	}
+	return argument, false
+}
+
+// typecheckWithIntPromotion looks for the first descriptor which matches the arguments after converting some Int arguments using the promotion.
+// Descriptors with argument types only get the arguments which don't match otherwise converted, the others get all Int arguments converted.
+func typecheckWithIntPromotion(name string, details physical.FunctionDetails, arguments []physical.Expression, promotion intPromotion) (physical.Expression, bool) {
+	promoted := make([]physical.Expression, len(arguments))
+	anyPromoted := false
+	for i := range arguments {
+		var ok bool
+		promoted[i], ok = promoteInt(arguments[i], promotion)
+		anyPromoted = anyPromoted || ok
+	}
+	if !anyPromoted {
+		return physical.Expression{}, false
+	}
+
+	argumentType := func(descriptor physical.FunctionDescriptor, argument physical.Expression) octosql.Type {
+		if descriptor.Strict {
+			return octosql.NonNullable(argument.Type)
+		}
+		return argument.Type
+	}
+
+descriptorLoop:
+	for _, descriptor := range details.Descriptors {
+		var outputType octosql.Type
+		var outArguments []physical.Expression
+		switch {
+		case descriptor.ConstantTypeFn != nil:
+			continue
+		case descriptor.TypeFn != nil:
+			argTypes := make([]octosql.Type, len(promoted))
+			for i := range promoted {
+				argTypes[i] = argumentType(descriptor, promoted[i])
+			}
+			var ok bool
+			if outputType, ok = descriptor.TypeFn(argTypes); !ok {
+				continue
+			}
+			outArguments = promoted
+		default:
+			if len(arguments) != len(descriptor.ArgumentTypes) {
+				continue
+			}
+			outArguments = make([]physical.Expression, len(arguments))
+			for i := range arguments {
+				if argumentType(descriptor, arguments[i]).Is(descriptor.ArgumentTypes[i]) == octosql.TypeRelationIs {
+					outArguments[i] = arguments[i]
+				} else if argumentType(descriptor, promoted[i]).Is(descriptor.ArgumentTypes[i]) == octosql.TypeRelationIs {
+					outArguments[i] = promoted[i]
+				} else {
+					continue descriptorLoop
+				}
+			}
+			outputType = descriptor.OutputType
+		}
+
+		return physical.Expression{
+			Type:           outputType,
+			ExpressionType: physical.ExpressionTypeFunctionCall,
+			FunctionCall: &physical.FunctionCall{
+				Name:               name,
+				Arguments:          outArguments,
+				FunctionDescriptor: descriptor,
+			},
+		}, true
+	}
+	return physical.Expression{}, false
+}
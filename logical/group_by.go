@@ -38,7 +38,19 @@ func NewDelayTrigger(delay Expression) *DelayTrigger {
 }
 
 func (w *DelayTrigger) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment, keyTimeIndex int) physical.Trigger {
-	panic("implement me")
+	delay := TypecheckExpression(ctx, env, logicalEnv, octosql.Duration, w.Delay)
+	if delay.ExpressionType != physical.ExpressionTypeConstant {
+		panic(fmt.Errorf("delay trigger parameter must be constant"))
+	}
+	if delay.Constant.Value.Duration <= 0 {
+		panic(fmt.Errorf("delay trigger parameter must be positive, is: %s", delay.Constant.Value.Duration))
+	}
+	return physical.Trigger{
+		TriggerType: physical.TriggerTypeDelay,
+		DelayTrigger: &physical.DelayTrigger{
+			Delay: delay.Constant.Value.Duration,
+		},
+	}
 }
 
 type WatermarkTrigger struct {
@@ -79,6 +91,10 @@ type GroupBy struct {
 	key      []Expression
 	keyNames []string
 
+	// groupingSets contains indices into key, it's nil if there is just one grouping set containing the whole key.
+	groupingSets    [][]int
+	groupingSetName string
+
 	expressions    []Expression
 	aggregates     []string
 	aggregateNames []string
@@ -86,8 +102,8 @@ type GroupBy struct {
 	triggers []Trigger
 }
 
-func NewGroupBy(source Node, key []Expression, keyNames []string, expressions []Expression, aggregates []string, aggregateNames []string, triggers []Trigger) *GroupBy {
-	return &GroupBy{source: source, key: key, keyNames: keyNames, expressions: expressions, aggregates: aggregates, aggregateNames: aggregateNames, triggers: triggers}
+func NewGroupBy(source Node, key []Expression, keyNames []string, groupingSets [][]int, groupingSetName string, expressions []Expression, aggregates []string, aggregateNames []string, triggers []Trigger) *GroupBy {
+	return &GroupBy{source: source, key: key, keyNames: keyNames, groupingSets: groupingSets, groupingSetName: groupingSetName, expressions: expressions, aggregates: aggregates, aggregateNames: aggregateNames, triggers: triggers}
 }
 
 func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
@@ -106,69 +122,26 @@ func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, lo
 		}
 	}
 
+	// Key expressions missing from any grouping set are NULL in the groups of that grouping set.
+	keyTypes := make([]octosql.Type, len(key))
+	for i := range key {
+		keyTypes[i] = key[i].Type
+		if !node.inAllGroupingSets(i) {
+			keyTypes[i] = octosql.TypeSum(key[i].Type, octosql.Null)
+		}
+	}
+	if keyEventTimeIndex != -1 && !node.inAllGroupingSets(keyEventTimeIndex) {
+		keyEventTimeIndex = -1
+	}
+
 	expressions := make([]physical.Expression, len(node.expressions))
 	for i := range node.expressions {
 		expressions[i] = node.expressions[i].Typecheck(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping))
 	}
 
 	aggregates := make([]physical.Aggregate, len(node.aggregates))
-aggregateLoop:
 	for i, aggname := range node.aggregates {
-		details := env.Aggregates[aggname]
-		for _, descriptor := range details.Descriptors {
-			if descriptor.TypeFn != nil {
-				if outputType, ok := descriptor.TypeFn(expressions[i].Type); ok {
-					if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-						outputType = octosql.TypeSum(outputType, octosql.Null)
-					}
-
-					aggregates[i] = physical.Aggregate{
-						Name:                node.aggregates[i],
-						OutputType:          outputType,
-						AggregateDescriptor: descriptor,
-					}
-					continue aggregateLoop
-				}
-			} else if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
-		}
-		for _, descriptor := range details.Descriptors {
-			if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
-				assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expressions[i].Type)
-				expressions[i] = physical.Expression{
-					ExpressionType: physical.ExpressionTypeTypeAssertion,
-					Type:           assertedExprType,
-					TypeAssertion: &physical.TypeAssertion{
-						Expression: expressions[i],
-						TargetType: descriptor.ArgumentType,
-					},
-				}
-
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
-		}
-		panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expressions[i].Type))
+		aggregates[i], expressions[i] = typecheckAggregate(env, aggname, expressions[i])
 	}
 
 	triggers := make([]physical.Trigger, len(node.triggers))
@@ -192,23 +165,31 @@ aggregateLoop:
 		}
 	}
 
-	schemaFields := make([]physical.SchemaField, len(key)+len(aggregates))
+	schemaFields := make([]physical.SchemaField, 0, len(key)+1+len(aggregates))
 	outMapping := make(map[string]string)
 	for i := range key {
 		unique := logicalEnv.GetUnique(node.keyNames[i])
 		outMapping[node.keyNames[i]] = unique
-		schemaFields[i] = physical.SchemaField{
+		schemaFields = append(schemaFields, physical.SchemaField{
 			Name: unique,
-			Type: key[i].Type,
-		}
+			Type: keyTypes[i],
+		})
+	}
+	if node.groupingSets != nil {
+		unique := logicalEnv.GetUnique(node.groupingSetName)
+		outMapping[node.groupingSetName] = unique
+		schemaFields = append(schemaFields, physical.SchemaField{
+			Name: unique,
+			Type: octosql.Int,
+		})
 	}
 	for i := range aggregates {
 		unique := logicalEnv.GetUnique(node.aggregateNames[i])
 		outMapping[node.aggregateNames[i]] = unique
-		schemaFields[len(key)+i] = physical.SchemaField{
+		schemaFields = append(schemaFields, physical.SchemaField{
 			Name: unique,
 			Type: aggregates[i].OutputType,
-		}
+		})
 	}
 
 	return physical.Node{
@@ -221,6 +202,79 @@ aggregateLoop:
 			Key:                  key,
 			KeyEventTimeIndex:    keyEventTimeIndex,
 			Trigger:              trigger,
+			GroupingSets:         node.groupingSets,
 		},
 	}, outMapping
 }
+
+func (node *GroupBy) inAllGroupingSets(keyIndex int) bool {
+	for _, set := range node.groupingSets {
+		found := false
+		for _, index := range set {
+			if index == keyIndex {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// typecheckAggregate picks the overload of the aggregate matching the argument type.
+// The argument may get wrapped in a type assertion.
+func typecheckAggregate(env physical.Environment, name string, arg physical.Expression) (physical.Aggregate, physical.Expression) {
+	details := env.Aggregates[name]
+	for _, descriptor := range details.Descriptors {
+		if descriptor.TypeFn != nil {
+			if outputType, ok := descriptor.TypeFn(arg.Type); ok {
+				if octosql.Null.Is(arg.Type) == octosql.TypeRelationIs {
+					outputType = octosql.TypeSum(outputType, octosql.Null)
+				}
+
+				return physical.Aggregate{
+					Name:                name,
+					OutputType:          outputType,
+					AggregateDescriptor: descriptor,
+				}, arg
+			}
+		} else if arg.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(arg.Type) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                name,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, arg
+		}
+	}
+	for _, descriptor := range details.Descriptors {
+		if arg.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
+			assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), arg.Type)
+			arg = physical.Expression{
+				ExpressionType: physical.ExpressionTypeTypeAssertion,
+				Type:           assertedExprType,
+				TypeAssertion: &physical.TypeAssertion{
+					Expression: arg,
+					TargetType: descriptor.ArgumentType,
+				},
+			}
+
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                name,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, arg
+		}
+	}
+	panic(fmt.Sprintf("unknown aggregate: %s(%s)", name, arg.Type))
+}
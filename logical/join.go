@@ -3,6 +3,8 @@ package logical
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/optimizer"
@@ -46,6 +48,7 @@ func (node *StreamJoin) Typecheck(ctx context.Context, env physical.Environment,
 }
 
 type OuterJoin struct {
+	Positioned
 	left, right     Node
 	predicate       Expression
 	isLeft, isRight bool
@@ -75,6 +78,7 @@ func (node *OuterJoin) Typecheck(ctx context.Context, env physical.Environment,
 	}
 
 	predicate := node.predicate.Typecheck(ctx, env.WithRecordSchema(right.Schema).WithRecordSchema(left.Schema), logicalEnv.WithRecordUniqueVariableNames(outMapping))
+	defer node.annotatePanic()
 
 	filterPredicates := predicate.SplitByAnd()
 	var leftKey, rightKey []physical.Expression
@@ -175,3 +179,339 @@ func (node *LookupJoin) Typecheck(ctx context.Context, env physical.Environment,
 		},
 	}, rightMapping
 }
+
+// UsingJoin is a join whose predicate is an equality of identically named columns of both sides,
+// either listed explicitly with USING, or all shared ones, if natural is set.
+// Each of those columns appears once in the output, before all other columns.
+type UsingJoin struct {
+	Positioned
+	left, right     Node
+	columns         []string
+	natural         bool
+	isLeft, isRight bool
+}
+
+func NewUsingJoin(left, right Node, columns []string, natural, isLeft, isRight bool) *UsingJoin {
+	return &UsingJoin{
+		left:    left,
+		right:   right,
+		columns: columns,
+		natural: natural,
+		isLeft:  isLeft,
+		isRight: isRight,
+	}
+}
+
+// usingJoinFieldPrefix prefixes the names under which the join columns of both sides are referenced in the join predicate.
+const usingJoinFieldPrefix = "$using"
+
+func (node *UsingJoin) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	left, leftMapping := node.left.Typecheck(ctx, env, logicalEnv)
+	right, rightMapping := node.right.Typecheck(ctx, env, logicalEnv)
+	defer node.annotatePanic()
+
+	columns := node.columns
+	if node.natural {
+		columns = sharedColumnNames(left, leftMapping, right, rightMapping)
+	}
+
+	// The join columns get additional names, so that the predicate can reference them even if both sides use the same names.
+	leftJoinMapping := make(map[string]string)
+	rightJoinMapping := make(map[string]string)
+	for k, v := range leftMapping {
+		leftJoinMapping[k] = v
+	}
+	for k, v := range rightMapping {
+		rightJoinMapping[k] = v
+	}
+	leftColumns := make([]string, len(columns))
+	rightColumns := make([]string, len(columns))
+	var predicate Expression
+	for i, column := range columns {
+		leftColumns[i] = usingJoinColumn(leftMapping, column, "left")
+		rightColumns[i] = usingJoinColumn(rightMapping, column, "right")
+		leftJoinMapping[fmt.Sprintf("%s_left_%d", usingJoinFieldPrefix, i)] = leftColumns[i]
+		rightJoinMapping[fmt.Sprintf("%s_right_%d", usingJoinFieldPrefix, i)] = rightColumns[i]
+
+		equality := NewFunctionExpression("=", []Expression{
+			NewVariable(fmt.Sprintf("%s_left_%d", usingJoinFieldPrefix, i)),
+			NewVariable(fmt.Sprintf("%s_right_%d", usingJoinFieldPrefix, i)),
+		})
+		if predicate == nil {
+			predicate = equality
+		} else {
+			predicate = NewAnd(predicate, equality)
+		}
+	}
+
+	leftNode := &typecheckedNode{node: left, mapping: leftJoinMapping}
+	rightNode := &typecheckedNode{node: right, mapping: rightJoinMapping}
+	var join Node
+	if node.isLeft || node.isRight {
+		if predicate == nil {
+			panic(fmt.Errorf("outer join must have at least one join column"))
+		}
+		join = NewOuterJoin(leftNode, rightNode, predicate, node.isLeft, node.isRight)
+	} else {
+		join = NewStreamJoin(leftNode, rightNode)
+		if predicate != nil {
+			join = NewFilter(predicate, join)
+		}
+	}
+	joined, joinedMapping := join.Typecheck(ctx, env, logicalEnv)
+
+	// The join columns are taken from the side which is always present, or coalesced for full outer joins.
+	var expressions []physical.Expression
+	var names [][]string
+	isJoinColumn := make(map[string]bool)
+	for i, column := range columns {
+		leftVariable := NewVariable(fmt.Sprintf("%s_left_%d", usingJoinFieldPrefix, i))
+		rightVariable := NewVariable(fmt.Sprintf("%s_right_%d", usingJoinFieldPrefix, i))
+		var expr Expression = leftVariable
+		if node.isLeft && node.isRight {
+			expr = NewCoalesce([]Expression{leftVariable, rightVariable})
+		} else if node.isRight {
+			expr = rightVariable
+		}
+		expressions = append(expressions, expr.Typecheck(ctx, env.WithRecordSchema(joined.Schema), logicalEnv.WithRecordUniqueVariableNames(joinedMapping)))
+		names = append(names, []string{column})
+		isJoinColumn[leftColumns[i]] = true
+		isJoinColumn[rightColumns[i]] = true
+	}
+	// The join columns of each side stay accessible with the qualifier of that side, so that they're null for records without a match on that side.
+	// They're hidden from stars, which only include the merged column.
+	var sideExpressions []physical.Expression
+	var sideNames [][]string
+	for _, field := range joined.Schema.Fields {
+		if !isJoinColumn[field.Name] {
+			continue
+		}
+		var fieldNames []string
+		for _, name := range append(namesOf(leftMapping, field.Name), namesOf(rightMapping, field.Name)...) {
+			if strings.Contains(name, ".") {
+				fieldNames = append(fieldNames, name)
+			}
+		}
+		if len(fieldNames) == 0 {
+			continue
+		}
+		sideExpressions = append(sideExpressions, physical.Expression{
+			Type:           field.Type,
+			ExpressionType: physical.ExpressionTypeVariable,
+			Variable: &physical.Variable{
+				Name:     field.Name,
+				IsLevel0: true,
+			},
+		})
+		sideNames = append(sideNames, fieldNames)
+	}
+	for _, field := range joined.Schema.Fields {
+		if isJoinColumn[field.Name] {
+			continue
+		}
+		fieldNames := append(namesOf(leftMapping, field.Name), namesOf(rightMapping, field.Name)...)
+		if len(fieldNames) == 0 {
+			continue
+		}
+		expressions = append(expressions, physical.Expression{
+			Type:           field.Type,
+			ExpressionType: physical.ExpressionTypeVariable,
+			Variable: &physical.Variable{
+				Name:     field.Name,
+				IsLevel0: true,
+			},
+		})
+		names = append(names, fieldNames)
+	}
+
+	sideFieldsStart := len(expressions)
+	expressions = append(expressions, sideExpressions...)
+	names = append(names, sideNames...)
+
+	outFields := make([]physical.SchemaField, len(expressions))
+	outMapping := make(map[string]string)
+	for i := range expressions {
+		unique := logicalEnv.GetUnique(names[i][0])
+		for _, name := range names[i] {
+			if _, ok := outMapping[name]; !ok {
+				// Left mapping takes precedence.
+				outMapping[name] = unique
+			}
+		}
+		if i >= sideFieldsStart {
+			outMapping[usingJoinSideFieldName(unique)] = unique
+		}
+		outFields[i] = physical.SchemaField{
+			Name: unique,
+			Type: expressions[i].Type,
+		}
+	}
+
+	outTimeFieldIndex := -1
+	if joined.Schema.TimeField != -1 {
+		for i := range expressions {
+			if expressions[i].ExpressionType == physical.ExpressionTypeVariable &&
+				expressions[i].Variable.Name == joined.Schema.Fields[joined.Schema.TimeField].Name {
+				outTimeFieldIndex = i
+				break
+			}
+		}
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, outTimeFieldIndex, physical.WithNoRetractions(joined.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeMap,
+		Map: &physical.Map{
+			Source:      joined,
+			Expressions: expressions,
+		},
+	}, outMapping
+}
+
+// usingJoinSideFieldName returns the hidden name marking a join column of one side of a USING join.
+func usingJoinSideFieldName(unique string) string {
+	return fmt.Sprintf("%s_side_%s", usingJoinFieldPrefix, unique)
+}
+
+// usingJoinSideFields returns the unique names of the join columns of the sides of USING joins, which stars don't include.
+func usingJoinSideFields(mapping map[string]string) map[string]bool {
+	out := make(map[string]bool)
+	for name, unique := range mapping {
+		if strings.HasPrefix(name, usingJoinFieldPrefix) {
+			out[unique] = true
+		}
+	}
+	return out
+}
+
+// hideUsingJoinSideFields removes the join columns of the sides of USING joins from the node.
+// All other fields keep their unique names and are still accessible under all their names.
+func hideUsingJoinSideFields(node physical.Node, mapping map[string]string) (physical.Node, map[string]string) {
+	hidden := usingJoinSideFields(mapping)
+	if len(hidden) == 0 {
+		return node, mapping
+	}
+
+	var expressions []physical.Expression
+	var fields []physical.SchemaField
+	timeField := -1
+	for i, field := range node.Schema.Fields {
+		if hidden[field.Name] {
+			continue
+		}
+		if i == node.Schema.TimeField {
+			timeField = len(fields)
+		}
+		expressions = append(expressions, physical.Expression{
+			Type:           field.Type,
+			ExpressionType: physical.ExpressionTypeVariable,
+			Variable: &physical.Variable{
+				Name:     field.Name,
+				IsLevel0: true,
+			},
+		})
+		fields = append(fields, field)
+	}
+	outMapping := make(map[string]string)
+	for name, unique := range mapping {
+		if !hidden[unique] {
+			outMapping[name] = unique
+		}
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, timeField, physical.WithNoRetractions(node.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeMap,
+		Map: &physical.Map{
+			Source:      node,
+			Expressions: expressions,
+		},
+	}, outMapping
+}
+
+// namesOf returns all names mapped to the unique name, sorted, so that the shortest one is first.
+func namesOf(mapping map[string]string, unique string) []string {
+	var names []string
+	for name, fieldUnique := range mapping {
+		if fieldUnique == unique {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) < len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// usingJoinColumn returns the unique name of the field of one side of a join matching the join column.
+func usingJoinColumn(mapping map[string]string, column string, side string) string {
+	// An exact match takes precedence, like the merged column of a previous USING join.
+	if unique, ok := mapping[column]; ok {
+		return unique
+	}
+	var unique string
+	for name, fieldUnique := range mapping {
+		if !physical.VariableNameMatchesField(column, name) {
+			continue
+		}
+		if unique != "" && unique != fieldUnique {
+			panic(fmt.Errorf("join column '%s' is ambiguous in the %s side of the join", column, side))
+		}
+		unique = fieldUnique
+	}
+	if unique == "" {
+		panic(fmt.Errorf("join column '%s' not found in the %s side of the join", column, side))
+	}
+	return unique
+}
+
+// sharedColumnNames returns the unqualified names of the fields present on both sides of a natural join, in the order of the left side.
+func sharedColumnNames(left physical.Node, leftMapping map[string]string, right physical.Node, rightMapping map[string]string) []string {
+	rightNames := make(map[string]bool)
+	rightReverseMapping := ReverseMapping(rightMapping)
+	rightSideFields := usingJoinSideFields(rightMapping)
+	for _, field := range right.Schema.Fields {
+		if rightSideFields[field.Name] {
+			continue
+		}
+		rightNames[unqualifiedName(rightReverseMapping[field.Name])] = true
+	}
+
+	var columns []string
+	leftReverseMapping := ReverseMapping(leftMapping)
+	leftSideFields := usingJoinSideFields(leftMapping)
+	for _, field := range left.Schema.Fields {
+		if leftSideFields[field.Name] {
+			continue
+		}
+		name := unqualifiedName(leftReverseMapping[field.Name])
+		if name != "" && !strings.HasPrefix(name, WindowFieldPrefix) && rightNames[name] {
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+func unqualifiedName(name string) string {
+	if dotIndex := strings.Index(name, "."); dotIndex != -1 {
+		return name[dotIndex+1:]
+	}
+	return name
+}
+
+// typecheckedNode wraps an already typechecked node, so that it can be used as the source of other logical nodes.
+type typecheckedNode struct {
+	node    physical.Node
+	mapping map[string]string
+}
+
+func (node *typecheckedNode) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	mapping := make(map[string]string)
+	for k, v := range node.mapping {
+		mapping[k] = v
+	}
+	return node.node, mapping
+}
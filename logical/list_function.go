@@ -0,0 +1,181 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// listFunctionLambdaParameters contains the number of lambda parameters for each of the list functions.
+var listFunctionLambdaParameters = map[string]int{
+	"list_transform": 1,
+	"list_filter":    1,
+	"list_reduce":    2,
+	"list_any":       1,
+	"list_all":       1,
+}
+
+// maxLambdaTypecheckPasses limits how many times the lambda of list_reduce gets retypechecked with a widened accumulator type.
+const maxLambdaTypecheckPasses = 10
+
+func IsListFunction(name string) bool {
+	_, ok := listFunctionLambdaParameters[name]
+	return ok
+}
+
+// Lambda is an anonymous function, passed as an argument to a list function.
+type Lambda struct {
+	parameters []string
+	body       Expression
+}
+
+func NewLambda(parameters []string, body Expression) *Lambda {
+	return &Lambda{parameters: parameters, body: body}
+}
+
+// ListFunction is a higher-order function, which evaluates a lambda for the elements of the list in its first argument.
+// The lambda is passed as the second argument, the arguments field contains all the other ones.
+type ListFunction struct {
+	Positioned
+	name      string
+	arguments []Expression
+	lambda    *Lambda
+}
+
+func NewListFunction(name string, arguments []Expression, lambda *Lambda) *ListFunction {
+	return &ListFunction{name: name, arguments: arguments, lambda: lambda}
+}
+
+func (lf *ListFunction) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	defer lf.annotatePanic()
+
+	expectedArguments := 1
+	if lf.name == "list_reduce" {
+		expectedArguments = 2
+	}
+	if len(lf.arguments) != expectedArguments {
+		panic(fmt.Errorf("%s expects %d arguments besides the lambda, got %d", lf.name, expectedArguments, len(lf.arguments)))
+	}
+	if len(lf.lambda.parameters) != listFunctionLambdaParameters[lf.name] {
+		panic(fmt.Errorf("%s expects a lambda with %d parameters, got %d", lf.name, listFunctionLambdaParameters[lf.name], len(lf.lambda.parameters)))
+	}
+
+	arguments := make([]physical.Expression, len(lf.arguments))
+	for i := range lf.arguments {
+		arguments[i] = lf.arguments[i].Typecheck(ctx, env, logicalEnv)
+	}
+	listType := octosql.NonNullable(arguments[0].Type)
+	if listType.TypeID == octosql.TypeIDNull {
+		// A NULL list is allowed, the element type is unknown then.
+		listType = octosql.Type{TypeID: octosql.TypeIDList}
+	}
+	if listType.TypeID != octosql.TypeIDList {
+		panic(fmt.Errorf("%s expects a list as its first argument, got %s", lf.name, arguments[0].Type))
+	}
+	elementType := octosql.Null
+	if listType.List.Element != nil {
+		elementType = *listType.List.Element
+	}
+
+	parameters := make([]physical.SchemaField, len(lf.lambda.parameters))
+	mapping := make(map[string]string)
+	for i, name := range lf.lambda.parameters {
+		unique := logicalEnv.GetUnique(name)
+		mapping[name] = unique
+		parameters[i] = physical.SchemaField{Name: unique}
+	}
+	lambdaLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+
+	var body physical.Expression
+	var outputType octosql.Type
+	switch lf.name {
+	case "list_transform":
+		parameters[0].Type = elementType
+		body = lf.typecheckLambdaBody(ctx, env, lambdaLogicalEnv, parameters, nil)
+		outputType = octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &body.Type}}
+	case "list_filter":
+		parameters[0].Type = elementType
+		expected := octosql.TypeSum(octosql.Boolean, octosql.Null)
+		body = lf.typecheckLambdaBody(ctx, env, lambdaLogicalEnv, parameters, &expected)
+		outputType = listType
+	case "list_any", "list_all":
+		parameters[0].Type = elementType
+		expected := octosql.TypeSum(octosql.Boolean, octosql.Null)
+		body = lf.typecheckLambdaBody(ctx, env, lambdaLogicalEnv, parameters, &expected)
+		outputType = octosql.Boolean
+	case "list_reduce":
+		// The accumulator starts with the type of the initial value and gets widened until it fits the values returned by the lambda.
+		parameters[0].Type = arguments[1].Type
+		parameters[1].Type = elementType
+		for pass := 0; ; pass++ {
+			if pass == maxLambdaTypecheckPasses {
+				panic(fmt.Errorf("couldn't determine the accumulator type of %s", lf.name))
+			}
+			body = lf.typecheckLambdaBody(ctx, env, lambdaLogicalEnv, parameters, nil)
+			if body.Type.Is(parameters[0].Type) == octosql.TypeRelationIs {
+				break
+			}
+			// An Int initial value is converted to the numeric type returned by the lambda, as the accumulator would otherwise hold both.
+			if pass == 0 {
+				if promoted, ok := promoteIntTo(arguments[1], body.Type); ok {
+					arguments[1] = promoted
+					parameters[0].Type = promoted.Type
+					continue
+				}
+			}
+			parameters[0].Type = octosql.TypeSum(parameters[0].Type, body.Type)
+		}
+		outputType = parameters[0].Type
+	}
+	if octosql.Null.Is(arguments[0].Type) == octosql.TypeRelationIs {
+		outputType = octosql.TypeSum(outputType, octosql.Null)
+	}
+
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeListFunction,
+		ListFunction: &physical.ListFunction{
+			Name:       lf.name,
+			Arguments:  arguments,
+			Parameters: parameters,
+			Body:       body,
+		},
+	}
+}
+
+func (lf *ListFunction) typecheckLambdaBody(ctx context.Context, env physical.Environment, lambdaLogicalEnv Environment, parameters []physical.SchemaField, expected *octosql.Type) physical.Expression {
+	lambdaEnv := env.WithRecordSchema(physical.NewSchema(parameters, -1))
+
+	var body physical.Expression
+	if expected != nil {
+		body = TypecheckExpression(ctx, lambdaEnv, lambdaLogicalEnv, *expected, lf.lambda.body)
+	} else {
+		body = lf.lambda.body.Typecheck(ctx, lambdaEnv, lambdaLogicalEnv)
+	}
+
+	// Variables of the current record are level 0 for the node evaluating this expression, not the lambda parameters.
+	isParameter := make(map[string]bool)
+	for _, parameter := range parameters {
+		isParameter[parameter.Name] = true
+	}
+	isRecordField := make(map[string]bool)
+	if env.VariableContext != nil {
+		for _, field := range env.VariableContext.Fields {
+			isRecordField[field.Name] = true
+		}
+	}
+	return (&physical.Transformers{
+		ExpressionTransformer: func(expr physical.Expression) physical.Expression {
+			if expr.ExpressionType == physical.ExpressionTypeVariable {
+				if isParameter[expr.Variable.Name] {
+					expr.Variable.IsLevel0 = false
+				} else if isRecordField[expr.Variable.Name] {
+					expr.Variable.IsLevel0 = true
+				}
+			}
+			return expr
+		},
+	}).TransformExpr(body)
+}
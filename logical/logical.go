@@ -3,9 +3,12 @@ package logical
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
@@ -14,6 +17,7 @@ import (
 type Environment struct {
 	CommonTableExpressions map[string]CommonTableExpression
 	TableValuedFunctions   map[string]TableValuedFunctionDescription
+	QueryParameters        map[string]string
 	UniqueVariableNames    *VariableMapping
 	UniqueNameGenerator    map[string]int
 }
@@ -33,6 +37,7 @@ func (env *Environment) WithRecordUniqueVariableNames(record map[string]string)
 	return Environment{
 		CommonTableExpressions: env.CommonTableExpressions,
 		TableValuedFunctions:   env.TableValuedFunctions,
+		QueryParameters:        env.QueryParameters,
 		UniqueVariableNames:    env.UniqueVariableNames.WithRecordMapping(record),
 		UniqueNameGenerator:    env.UniqueNameGenerator,
 	}
@@ -63,6 +68,10 @@ func (mapping *VariableMapping) GetUniqueName(name string) (string, bool) {
 }
 
 func GetUniqueNameMatchingVariable(mapping map[string]string, name string) (string, bool) {
+	// An exact match takes precedence, like the merged column of a USING join over the qualified columns of its sides.
+	if unique, ok := mapping[name]; ok {
+		return unique, true
+	}
 	for original, unique := range mapping {
 		if physical.VariableNameMatchesField(name, original) {
 			return unique, true
@@ -71,9 +80,14 @@ func GetUniqueNameMatchingVariable(mapping map[string]string, name string) (stri
 	return "", false
 }
 
+// ReverseMapping maps unique names back to variable names.
+// If multiple variable names map to the same unique name, like the column of a USING join, the shortest one is used.
 func ReverseMapping(mapping map[string]string) map[string]string {
 	out := make(map[string]string)
 	for k, v := range mapping {
+		if existing, ok := out[v]; ok && (len(existing) < len(k) || len(existing) == len(k) && existing < k) {
+			continue
+		}
 		out[v] = k
 	}
 	return out
@@ -85,6 +99,7 @@ type Node interface {
 }
 
 type DataSource struct {
+	Positioned
 	name, alias string
 	options     map[string]string
 }
@@ -94,8 +109,10 @@ func NewDataSource(name, alias string, options map[string]string) *DataSource {
 }
 
 func (ds *DataSource) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	defer ds.annotatePanic()
+
 	if cte, ok := logicalEnv.CommonTableExpressions[ds.name]; ok {
-		return cte.Node, cte.UniqueVariableMapping
+		return cte.Node, requalifyMapping(cte.UniqueVariableMapping, ds.alias)
 	}
 
 	if ds.name == "dual" {
@@ -172,6 +189,7 @@ func (se *StarExpression) Typecheck(ctx context.Context, env physical.Environmen
 }
 
 type Variable struct {
+	Positioned
 	name string
 }
 
@@ -180,6 +198,8 @@ func NewVariable(name string) *Variable {
 }
 
 func (v *Variable) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	defer v.annotatePanic()
+
 	uniqueName, ok := logicalEnv.UniqueVariableNames.GetUniqueName(v.name)
 	if !ok {
 		panic(fmt.Errorf("unknown variable: '%s'", v.name))
@@ -201,7 +221,6 @@ func (v *Variable) Typecheck(ctx context.Context, env physical.Environment, logi
 		}
 		isLevel0 = false
 	}
-	// TODO: Expression typecheck errors should contain context. (position in input SQL)
 	panic(fmt.Errorf("unknown variable: '%s'", uniqueName))
 }
 
@@ -230,6 +249,80 @@ func (c *Constant) Typecheck(ctx context.Context, env physical.Environment, logi
 	}
 }
 
+// Parameter is a query parameter placeholder, like :name or $1.
+// It's replaced by a constant with the value of the parameter during typechecking.
+// The value is a String, unless the context of the parameter expects another type, see TypecheckAs.
+type Parameter struct {
+	Positioned
+	name string
+}
+
+func NewParameter(name string) *Parameter {
+	return &Parameter{name: name}
+}
+
+func (p *Parameter) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	return p.TypecheckAs(ctx, env, logicalEnv, octosql.String)
+}
+
+// TypecheckAs converts the value of the parameter to the expected type, if it's one of the types in parameterParsers.
+// Otherwise, the value is a String.
+func (p *Parameter) TypecheckAs(ctx context.Context, env physical.Environment, logicalEnv Environment, expected octosql.Type) physical.Expression {
+	defer p.annotatePanic()
+
+	text, ok := logicalEnv.QueryParameters[p.name]
+	if !ok {
+		panic(fmt.Errorf("no value provided for query parameter '%s'", p.name))
+	}
+	typeID := octosql.NonNullable(expected).TypeID
+	parse, ok := parameterParsers[typeID]
+	if !ok {
+		typeID, parse = octosql.TypeIDString, parameterParsers[octosql.TypeIDString]
+	}
+	value, err := parse(text)
+	if err != nil {
+		panic(fmt.Errorf("couldn't convert value '%s' of query parameter '%s' to %s: %w", text, p.name, octosql.Type{TypeID: typeID}, err))
+	}
+	return physical.Expression{
+		Type:           value.Type(),
+		ExpressionType: physical.ExpressionTypeConstant,
+		Constant: &physical.Constant{
+			Value: value,
+		},
+	}
+}
+
+// parameterParsers contains the types query parameter values can be converted to.
+var parameterParsers = map[octosql.TypeID]func(text string) (octosql.Value, error){
+	octosql.TypeIDString: func(text string) (octosql.Value, error) {
+		return octosql.NewString(text), nil
+	},
+	octosql.TypeIDInt: func(text string) (octosql.Value, error) {
+		integer, err := strconv.ParseInt(text, 10, 64)
+		return octosql.NewInt(int(integer)), err
+	},
+	octosql.TypeIDFloat: func(text string) (octosql.Value, error) {
+		float, err := strconv.ParseFloat(text, 64)
+		return octosql.NewFloat(float), err
+	},
+	octosql.TypeIDDecimal: func(text string) (octosql.Value, error) {
+		d, err := decimal.NewFromString(text)
+		return octosql.NewDecimal(d), err
+	},
+	octosql.TypeIDBoolean: func(text string) (octosql.Value, error) {
+		b, err := strconv.ParseBool(text)
+		return octosql.NewBoolean(b), err
+	},
+	octosql.TypeIDTime: func(text string) (octosql.Value, error) {
+		t, err := time.Parse(time.RFC3339Nano, text)
+		return octosql.NewTime(t), err
+	},
+	octosql.TypeIDDuration: func(text string) (octosql.Value, error) {
+		d, err := time.ParseDuration(text)
+		return octosql.NewDuration(d), err
+	},
+}
+
 type Tuple struct {
 	expressions []Expression
 }
@@ -239,10 +332,19 @@ func NewTuple(expressions []Expression) *Tuple {
 }
 
 func (t *Tuple) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	return t.typecheckWithParametersAs(ctx, env, logicalEnv, octosql.String)
+}
+
+// typecheckWithParametersAs converts the query parameter elements of the tuple to the expected type, like in r.i IN (:a, :b).
+func (t *Tuple) typecheckWithParametersAs(ctx context.Context, env physical.Environment, logicalEnv Environment, expected octosql.Type) physical.Expression {
 	args := make([]physical.Expression, len(t.expressions))
 	argTypes := make([]octosql.Type, len(t.expressions))
 	for i := range t.expressions {
-		args[i] = t.expressions[i].Typecheck(ctx, env, logicalEnv)
+		if parameter, ok := t.expressions[i].(*Parameter); ok {
+			args[i] = parameter.TypecheckAs(ctx, env, logicalEnv, expected)
+		} else {
+			args[i] = t.expressions[i].Typecheck(ctx, env, logicalEnv)
+		}
 		argTypes[i] = args[i].Type
 	}
 	return physical.Expression{
@@ -254,6 +356,72 @@ func (t *Tuple) Typecheck(ctx context.Context, env physical.Environment, logical
 	}
 }
 
+type List struct {
+	elements []Expression
+}
+
+func NewList(elements []Expression) *List {
+	return &List{elements: elements}
+}
+
+func (l *List) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	elements := make([]physical.Expression, len(l.elements))
+	for i := range l.elements {
+		elements[i] = l.elements[i].Typecheck(ctx, env, logicalEnv)
+	}
+
+	// The element type of an empty list is unknown.
+	var elementType *octosql.Type
+	if len(elements) > 0 {
+		t := elements[0].Type
+		for _, element := range elements[1:] {
+			t = octosql.TypeSum(t, element.Type)
+		}
+		elementType = &t
+	}
+
+	return physical.Expression{
+		Type:           octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: elementType}},
+		ExpressionType: physical.ExpressionTypeList,
+		List: &physical.List{
+			Elements: elements,
+		},
+	}
+}
+
+type Object struct {
+	names  []string
+	values []Expression
+}
+
+func NewObject(names []string, values []Expression) *Object {
+	return &Object{names: names, values: values}
+}
+
+func (o *Object) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	values := make([]physical.Expression, len(o.values))
+	fields := make([]octosql.StructField, len(o.values))
+	seen := make(map[string]bool)
+	for i := range o.values {
+		if seen[o.names[i]] {
+			panic(fmt.Errorf("duplicate object field '%s'", o.names[i]))
+		}
+		seen[o.names[i]] = true
+
+		values[i] = o.values[i].Typecheck(ctx, env, logicalEnv)
+		fields[i] = octosql.StructField{Name: o.names[i], Type: values[i].Type}
+	}
+
+	return physical.Expression{
+		Type:           octosql.Type{TypeID: octosql.TypeIDStruct, Struct: struct{ Fields []octosql.StructField }{Fields: fields}},
+		ExpressionType: physical.ExpressionTypeObject,
+		Object: &physical.Object{
+			Names:  o.names,
+			Values: values,
+		},
+	}
+}
+
 type And struct {
 	left, right Expression
 }
@@ -345,6 +513,26 @@ func (ne *QueryExpression) Typecheck(ctx context.Context, env physical.Environme
 	}
 }
 
+type Exists struct {
+	node Node
+}
+
+func NewExists(node Node) *Exists {
+	return &Exists{node: node}
+}
+
+func (ne *Exists) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	source, _ := ne.node.Typecheck(ctx, env, logicalEnv)
+
+	return physical.Expression{
+		Type:           octosql.Boolean,
+		ExpressionType: physical.ExpressionTypeExists,
+		Exists: &physical.Exists{
+			Source: source,
+		},
+	}
+}
+
 type Coalesce struct {
 	args []Expression
 }
@@ -377,7 +565,45 @@ func (c *Coalesce) Typecheck(ctx context.Context, env physical.Environment, logi
 	}
 }
 
+type Case struct {
+	conditions []Expression
+	values     []Expression
+	elseValue  Expression
+}
+
+func NewCase(conditions []Expression, values []Expression, elseValue Expression) *Case {
+	return &Case{conditions: conditions, values: values, elseValue: elseValue}
+}
+
+func (c *Case) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	conditions := make([]physical.Expression, len(c.conditions))
+	for i := range c.conditions {
+		conditions[i] = TypecheckExpression(ctx, env, logicalEnv, octosql.TypeSum(octosql.Boolean, octosql.Null), c.conditions[i])
+	}
+	values := make([]physical.Expression, len(c.values))
+	for i := range c.values {
+		values[i] = c.values[i].Typecheck(ctx, env, logicalEnv)
+	}
+	elseValue := c.elseValue.Typecheck(ctx, env, logicalEnv)
+
+	outputType := elseValue.Type
+	for _, value := range values {
+		outputType = octosql.TypeSum(outputType, value.Type)
+	}
+
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeCase,
+		Case: &physical.Case{
+			Conditions: conditions,
+			Values:     values,
+			Else:       elseValue,
+		},
+	}
+}
+
 type TypeCast struct {
+	Positioned
 	arg          Expression
 	targetTypeID octosql.TypeID
 }
@@ -387,7 +613,17 @@ func NewTypeCast(arg Expression, targetTypeID octosql.TypeID) *TypeCast {
 }
 
 func (c *TypeCast) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	// Casting a query parameter converts its value, i.e. :p::int.
+	if parameter, ok := c.arg.(*Parameter); ok {
+		if _, ok := parameterParsers[c.targetTypeID]; !ok {
+			defer c.annotatePanic()
+			panic(fmt.Errorf("query parameters can't be cast to %s", octosql.Type{TypeID: c.targetTypeID}))
+		}
+		return parameter.TypecheckAs(ctx, env, logicalEnv, octosql.Type{TypeID: c.targetTypeID})
+	}
+
 	expr := c.arg.Typecheck(ctx, env, logicalEnv)
+	defer c.annotatePanic()
 
 	if expr.Type.TypeID != octosql.TypeIDUnion {
 		panic(fmt.Errorf("typecast of non-union type expression '%s'", expr.Type.String()))
@@ -417,6 +653,7 @@ func (c *TypeCast) Typecheck(ctx context.Context, env physical.Environment, logi
 }
 
 type ObjectFieldAccess struct {
+	Positioned
 	object Expression
 	field  string
 }
@@ -427,6 +664,7 @@ func NewObjectFieldAccess(object Expression, field string) *ObjectFieldAccess {
 
 func (c *ObjectFieldAccess) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
 	expr := TypecheckPossiblyNullableStruct(ctx, env, logicalEnv, c.object)
+	defer c.annotatePanic()
 
 	nonNullableExprType := octosql.NonNullable(expr.Type)
 	fieldIndex := -1
@@ -456,7 +694,12 @@ func (c *ObjectFieldAccess) Typecheck(ctx context.Context, env physical.Environm
 }
 
 func TypecheckExpression(ctx context.Context, env physical.Environment, logicalEnv Environment, expected octosql.Type, expression Expression) physical.Expression {
-	expr := expression.Typecheck(ctx, env, logicalEnv)
+	var expr physical.Expression
+	if parameter, ok := expression.(*Parameter); ok {
+		expr = parameter.TypecheckAs(ctx, env, logicalEnv, expected)
+	} else {
+		expr = expression.Typecheck(ctx, env, logicalEnv)
+	}
 	rel := expr.Type.Is(expected)
 	if rel == octosql.TypeRelationIsnt {
 		panic(fmt.Errorf("expected %s, got %s", expected, expr.Type))
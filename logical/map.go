@@ -14,17 +14,28 @@ type Map struct {
 	aliases           []string
 	starQualifier     []string
 	isStar            []bool
+	starModifiers     []StarModifiers
 	objectExplosions  []Expression
 	isObjectExplosion []bool
 	source            Node
 }
 
-func NewMap(expressions []Expression, aliases []string, starQualifiers []string, isStar []bool, objectExplosions []Expression, isObjectExplosion []bool, child Node) *Map {
+// StarModifiers are the EXCEPT and REPLACE modifiers of a star expression.
+type StarModifiers struct {
+	// Except lists the fields which should be skipped.
+	Except []string
+	// ReplaceExpressions are used instead of the fields named by the respective ReplaceNames.
+	ReplaceExpressions []Expression
+	ReplaceNames       []string
+}
+
+func NewMap(expressions []Expression, aliases []string, starQualifiers []string, isStar []bool, starModifiers []StarModifiers, objectExplosions []Expression, isObjectExplosion []bool, child Node) *Map {
 	return &Map{
 		expressions:       expressions,
 		aliases:           aliases,
 		starQualifier:     starQualifiers,
 		isStar:            isStar,
+		starModifiers:     starModifiers,
 		objectExplosions:  objectExplosions,
 		isObjectExplosion: isObjectExplosion,
 		source:            child,
@@ -33,6 +44,10 @@ func NewMap(expressions []Expression, aliases []string, starQualifiers []string,
 
 func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
 	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+	if len(node.expressions) == 1 && node.isStar[0] && node.starQualifier[0] == "" && node.starModifiers[0].Except == nil && node.starModifiers[0].ReplaceNames == nil {
+		// 'SELECT * FROM xyz' keeps the source as is.
+		return hideUsingJoinSideFields(source, mapping)
+	}
 	reverseMapping := ReverseMapping(mapping)
 
 	var expressions []physical.Expression
@@ -40,12 +55,36 @@ func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logica
 	var unnests []int
 	for i := range node.expressions {
 		if node.isStar[i] {
+			modifiers := node.starModifiers[i]
+			exceptUsed := make([]bool, len(modifiers.Except))
+			replaceUsed := make([]bool, len(modifiers.ReplaceNames))
+			usingJoinSideFields := usingJoinSideFields(mapping)
+		starFieldLoop:
 			for _, field := range source.Schema.Fields {
+				name := reverseMapping[field.Name]
+				if strings.HasPrefix(name, WindowFieldPrefix) || usingJoinSideFields[field.Name] {
+					continue
+				}
 				if qualifier := node.starQualifier[i]; qualifier != "" {
-					if !strings.HasPrefix(reverseMapping[field.Name], qualifier+".") {
+					if !strings.HasPrefix(name, qualifier+".") {
 						continue
 					}
 				}
+				for j := range modifiers.Except {
+					if physical.VariableNameMatchesField(modifiers.Except[j], name) {
+						exceptUsed[j] = true
+						continue starFieldLoop
+					}
+				}
+				for j := range modifiers.ReplaceNames {
+					if physical.VariableNameMatchesField(modifiers.ReplaceNames[j], name) {
+						replaceUsed[j] = true
+						expressions = append(expressions, modifiers.ReplaceExpressions[j].Typecheck(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping)))
+						aliases = append(aliases, &name)
+						unnests = append(unnests, 0)
+						continue starFieldLoop
+					}
+				}
 				expressions = append(expressions, physical.Expression{
 					Type:           field.Type,
 					ExpressionType: physical.ExpressionTypeVariable,
@@ -57,6 +96,16 @@ func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logica
 				aliases = append(aliases, nil)
 				unnests = append(unnests, 0)
 			}
+			for j := range modifiers.Except {
+				if !exceptUsed[j] {
+					panic(fmt.Errorf("field '%s' listed in star EXCEPT doesn't exist", modifiers.Except[j]))
+				}
+			}
+			for j := range modifiers.ReplaceNames {
+				if !replaceUsed[j] {
+					panic(fmt.Errorf("field '%s' listed in star REPLACE doesn't exist", modifiers.ReplaceNames[j]))
+				}
+			}
 		} else if node.isObjectExplosion[i] {
 			objectExpr := node.objectExplosions[i].Typecheck(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping))
 			if objectExpr.Type.TypeID != octosql.TypeIDStruct {
@@ -0,0 +1,225 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// Pivot turns the values of a column into separate fields.
+// It groups by all fields not used by the aggregates or the pivoted column,
+// and computes each aggregate only over the records matching each of the values.
+type Pivot struct {
+	source         Node
+	aggregates     []string
+	aggregateExprs []Expression
+	column         Expression
+	values         []Expression
+	// fieldNames contains the names of the output fields, for each value the names for each aggregate.
+	fieldNames []string
+}
+
+func NewPivot(source Node, aggregates []string, aggregateExprs []Expression, column Expression, values []Expression, fieldNames []string) *Pivot {
+	return &Pivot{
+		source:         source,
+		aggregates:     aggregates,
+		aggregateExprs: aggregateExprs,
+		column:         column,
+		values:         values,
+		fieldNames:     fieldNames,
+	}
+}
+
+const pivotFieldPrefix = "$pivot"
+
+func (node *Pivot) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+
+	sourceEnv := env.WithRecordSchema(source.Schema)
+	sourceLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+	used := make(map[string]bool)
+	for _, name := range node.column.Typecheck(ctx, sourceEnv, sourceLogicalEnv).VariablesUsed() {
+		used[name] = true
+	}
+	for i := range node.aggregateExprs {
+		for _, name := range node.aggregateExprs[i].Typecheck(ctx, sourceEnv, sourceLogicalEnv).VariablesUsed() {
+			used[name] = true
+		}
+	}
+
+	// The key fields get additional names, so that they can be referenced unambiguously.
+	sourceMapping := make(map[string]string)
+	for k, v := range mapping {
+		sourceMapping[k] = v
+	}
+	reverseMapping := ReverseMapping(mapping)
+	var key []Expression
+	var keyNames []string
+	for _, field := range source.Schema.Fields {
+		name := reverseMapping[field.Name]
+		if used[field.Name] || strings.HasPrefix(name, WindowFieldPrefix) {
+			continue
+		}
+		keyFieldName := fmt.Sprintf("%s_key_%d", pivotFieldPrefix, len(key))
+		sourceMapping[keyFieldName] = field.Name
+		key = append(key, NewVariable(keyFieldName))
+		keyNames = append(keyNames, name)
+	}
+
+	var aggregates []string
+	var expressions []Expression
+	for i := range node.values {
+		for j := range node.aggregates {
+			aggregates = append(aggregates, node.aggregates[j])
+			expressions = append(expressions, NewCase(
+				[]Expression{NewFunctionExpression("=", []Expression{node.column, node.values[i]})},
+				[]Expression{node.aggregateExprs[j]},
+				NewConstant(octosql.NewNull()),
+			))
+		}
+	}
+
+	return NewGroupBy(
+		&typecheckedNode{node: source, mapping: sourceMapping},
+		key,
+		keyNames,
+		nil,
+		"",
+		expressions,
+		aggregates,
+		node.fieldNames,
+		nil,
+	).Typecheck(ctx, env, logicalEnv)
+}
+
+// Unpivot turns fields into records with the name and value of each of the fields.
+// Records with a NULL value are skipped.
+type Unpivot struct {
+	source      Node
+	valueColumn string
+	nameColumn  string
+	columns     []string
+}
+
+func NewUnpivot(source Node, valueColumn, nameColumn string, columns []string) *Unpivot {
+	return &Unpivot{
+		source:      source,
+		valueColumn: valueColumn,
+		nameColumn:  nameColumn,
+		columns:     columns,
+	}
+}
+
+const unpivotFieldPrefix = "$unpivot"
+
+func (node *Unpivot) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+
+	// All fields get additional names, so that they can be referenced unambiguously.
+	sourceMapping := make(map[string]string)
+	for k, v := range mapping {
+		sourceMapping[k] = v
+	}
+	isColumn := make(map[string]bool)
+	indices := make([]octosql.Value, len(node.columns))
+	var columnExprs []Expression
+	for i, column := range node.columns {
+		unique, ok := GetUniqueNameMatchingVariable(mapping, column)
+		if !ok {
+			panic(fmt.Errorf("unknown unpivot column: '%s'", column))
+		}
+		isColumn[unique] = true
+		indices[i] = octosql.NewInt(i)
+
+		columnFieldName := fmt.Sprintf("%s_column_%d", unpivotFieldPrefix, i)
+		sourceMapping[columnFieldName] = unique
+		columnExprs = append(columnExprs, NewVariable(columnFieldName))
+	}
+
+	reverseMapping := ReverseMapping(mapping)
+	var passedExprs []Expression
+	var passedNames []string
+	for _, field := range source.Schema.Fields {
+		name := reverseMapping[field.Name]
+		if isColumn[field.Name] || strings.HasPrefix(name, WindowFieldPrefix) {
+			continue
+		}
+		passedFieldName := fmt.Sprintf("%s_field_%d", unpivotFieldPrefix, len(passedExprs))
+		sourceMapping[passedFieldName] = field.Name
+		passedExprs = append(passedExprs, NewVariable(passedFieldName))
+		passedNames = append(passedNames, name)
+	}
+
+	// Each record is first duplicated for each of the columns by unnesting a list of column indices.
+	// A single column doesn't need any duplication.
+	indexFieldName := fmt.Sprintf("%s_index", unpivotFieldPrefix)
+	var unnestedExprs []Expression
+	var unnestedAliases []string
+	for i := range passedExprs {
+		unnestedExprs = append(unnestedExprs, passedExprs[i])
+		unnestedAliases = append(unnestedAliases, fmt.Sprintf("%s_field_%d", unpivotFieldPrefix, i))
+	}
+	for i := range columnExprs {
+		unnestedExprs = append(unnestedExprs, columnExprs[i])
+		unnestedAliases = append(unnestedAliases, fmt.Sprintf("%s_column_%d", unpivotFieldPrefix, i))
+	}
+	if len(node.columns) > 1 {
+		unnestedExprs = append(unnestedExprs, NewFunctionExpression("unnest", []Expression{NewConstant(octosql.NewList(indices))}))
+		unnestedAliases = append(unnestedAliases, indexFieldName)
+	}
+	var unnested Node = NewMap(
+		unnestedExprs,
+		unnestedAliases,
+		make([]string, len(unnestedExprs)),
+		make([]bool, len(unnestedExprs)),
+		make([]StarModifiers, len(unnestedExprs)),
+		make([]Expression, len(unnestedExprs)),
+		make([]bool, len(unnestedExprs)),
+		&typecheckedNode{node: source, mapping: sourceMapping},
+	)
+
+	// Then, the name and value of the column matching the index are picked.
+	var nameConditions, nameValues, valueValues []Expression
+	for i := 0; i < len(node.columns)-1; i++ {
+		nameConditions = append(nameConditions, NewFunctionExpression("=", []Expression{NewVariable(indexFieldName), NewConstant(octosql.NewInt(i))}))
+		nameValues = append(nameValues, NewConstant(octosql.NewString(node.columns[i])))
+		valueValues = append(valueValues, NewVariable(fmt.Sprintf("%s_column_%d", unpivotFieldPrefix, i)))
+	}
+	lastColumn := len(node.columns) - 1
+
+	var outputExprs []Expression
+	var outputAliases []string
+	for i := range passedExprs {
+		outputExprs = append(outputExprs, NewVariable(fmt.Sprintf("%s_field_%d", unpivotFieldPrefix, i)))
+		outputAliases = append(outputAliases, passedNames[i])
+	}
+	if len(node.columns) > 1 {
+		outputExprs = append(outputExprs,
+			NewCase(nameConditions, nameValues, NewConstant(octosql.NewString(node.columns[lastColumn]))),
+			NewCase(nameConditions, valueValues, NewVariable(fmt.Sprintf("%s_column_%d", unpivotFieldPrefix, lastColumn))),
+		)
+	} else {
+		outputExprs = append(outputExprs,
+			NewConstant(octosql.NewString(node.columns[lastColumn])),
+			NewVariable(fmt.Sprintf("%s_column_%d", unpivotFieldPrefix, lastColumn)),
+		)
+	}
+	outputAliases = append(outputAliases, node.nameColumn, node.valueColumn)
+
+	return NewFilter(
+		NewFunctionExpression("is not null", []Expression{NewVariable(node.valueColumn)}),
+		NewMap(
+			outputExprs,
+			outputAliases,
+			make([]string, len(outputExprs)),
+			make([]bool, len(outputExprs)),
+			make([]StarModifiers, len(outputExprs)),
+			make([]Expression, len(outputExprs)),
+			make([]bool, len(outputExprs)),
+			unnested,
+		),
+	).Typecheck(ctx, env, logicalEnv)
+}
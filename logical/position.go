@@ -0,0 +1,47 @@
+package logical
+
+import (
+	"fmt"
+)
+
+// PositionedError is a typecheck error which can be traced back to a position in the input SQL.
+type PositionedError struct {
+	Err error
+	// Position is the 1-based byte offset of the element which caused the error in the input SQL.
+	Position int
+}
+
+func (err *PositionedError) Error() string {
+	return err.Err.Error()
+}
+
+func (err *PositionedError) Unwrap() error {
+	return err.Err
+}
+
+// Positioned is embedded into nodes and expressions which know their position in the input SQL.
+type Positioned struct {
+	position int
+}
+
+// SetPosition sets the 1-based byte offset of the element in the input SQL, 0 means unknown.
+func (p *Positioned) SetPosition(position int) {
+	p.position = position
+}
+
+// annotatePanic must be deferred in Typecheck.
+// It attaches the position to errors typechecking panics with, unless they already have a more precise one.
+func (p *Positioned) annotatePanic() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if _, ok := r.(*PositionedError); ok || p.position == 0 {
+		panic(r)
+	}
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("%v", r)
+	}
+	panic(&PositionedError{Err: err, Position: p.position})
+}
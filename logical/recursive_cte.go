@@ -0,0 +1,147 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// DefaultMaxRecursiveIterations is used if the max_recursive_iterations physical config option isn't set.
+const DefaultMaxRecursiveIterations = 100
+
+// maxRecursiveTypecheckPasses limits how many times the recursive part gets retypechecked with widened field types.
+const maxRecursiveTypecheckPasses = 10
+
+// RecursiveCTE is a common table expression whose recursive part references the common table expression itself.
+type RecursiveCTE struct {
+	name      string
+	anchor    Node
+	recursive Node
+	distinct  bool
+}
+
+func NewRecursiveCTE(name string, anchor, recursive Node, distinct bool) *RecursiveCTE {
+	return &RecursiveCTE{
+		name:      name,
+		anchor:    anchor,
+		recursive: recursive,
+		distinct:  distinct,
+	}
+}
+
+func (node *RecursiveCTE) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	anchor, anchorMapping := node.anchor.Typecheck(ctx, env, logicalEnv)
+	if isStream(anchor) {
+		panic(fmt.Errorf("recursive common table expression %s can't be evaluated over an unbounded stream, but its anchor reads from a tailed datasource or a stream with a time field", node.name))
+	}
+
+	// The output fields are named after the fields of the anchor.
+	anchorReverseMapping := ReverseMapping(anchorMapping)
+	outMapping := make(map[string]string)
+	fields := make([]physical.SchemaField, len(anchor.Schema.Fields))
+	for i := range anchor.Schema.Fields {
+		name, ok := anchorReverseMapping[anchor.Schema.Fields[i].Name]
+		if !ok {
+			name = fmt.Sprintf("col_%d", i)
+		}
+		unique := logicalEnv.GetUnique(name)
+		outMapping[name] = unique
+
+		fields[i] = physical.SchemaField{
+			Name: unique,
+			Type: anchor.Schema.Fields[i].Type,
+		}
+	}
+
+	uniqueName := logicalEnv.GetUnique(node.name)
+
+	// The recursive part gets typechecked with the field types of the anchor.
+	// If it produces values of other types, the field types get widened and it gets typechecked again.
+	var recursive physical.Node
+	for pass := 0; ; pass++ {
+		if pass == maxRecursiveTypecheckPasses {
+			panic(fmt.Errorf("couldn't determine field types of recursive common table expression %s", node.name))
+		}
+
+		newCTEs := make(map[string]CommonTableExpression)
+		for k, v := range logicalEnv.CommonTableExpressions {
+			newCTEs[k] = v
+		}
+		referenceFields := make([]physical.SchemaField, len(fields))
+		copy(referenceFields, fields)
+		newCTEs[node.name] = CommonTableExpression{
+			Node: physical.Node{
+				Schema:   physical.NewSchema(referenceFields, -1, physical.WithNoRetractions(true)),
+				NodeType: physical.NodeTypeRecursiveReference,
+				RecursiveReference: &physical.RecursiveReference{
+					Name: uniqueName,
+				},
+			},
+			UniqueVariableMapping: outMapping,
+		}
+
+		recursive, _ = node.recursive.Typecheck(ctx, env, Environment{
+			CommonTableExpressions: newCTEs,
+			TableValuedFunctions:   logicalEnv.TableValuedFunctions,
+			QueryParameters:        logicalEnv.QueryParameters,
+			UniqueVariableNames:    logicalEnv.UniqueVariableNames,
+			UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
+		})
+		if len(recursive.Schema.Fields) != len(fields) {
+			panic(fmt.Errorf("recursive common table expression %s parts must have the same number of fields, anchor has %d, recursive part has %d", node.name, len(fields), len(recursive.Schema.Fields)))
+		}
+		if isStream(recursive) {
+			panic(fmt.Errorf("recursive common table expression %s can't be evaluated over an unbounded stream, but its recursive part reads from a tailed datasource or a stream with a time field", node.name))
+		}
+
+		widened := false
+		for i := range fields {
+			if recursive.Schema.Fields[i].Type.Is(fields[i].Type) != octosql.TypeRelationIs {
+				fields[i].Type = octosql.TypeSum(fields[i].Type, recursive.Schema.Fields[i].Type)
+				widened = true
+			}
+		}
+		if !widened {
+			break
+		}
+	}
+
+	maxIterations := DefaultMaxRecursiveIterations
+	if value, ok := env.PhysicalConfig["max_recursive_iterations"]; ok {
+		maxIterations = value.(int)
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, -1, physical.WithNoRetractions(true)),
+		NodeType: physical.NodeTypeRecursiveCTE,
+		RecursiveCTE: &physical.RecursiveCTE{
+			Name:          uniqueName,
+			Anchor:        anchor,
+			Recursive:     recursive,
+			Distinct:      node.distinct,
+			MaxIterations: maxIterations,
+		},
+	}, outMapping
+}
+
+// isStream returns true if any node of the plan has a time field or reads from an unbounded datasource.
+// The recursive common table expression is evaluated as a fixpoint, which requires its inputs to end.
+func isStream(node physical.Node) bool {
+	found := false
+	(&physical.Transformers{
+		NodeTransformer: func(node physical.Node) physical.Node {
+			if node.Schema.TimeField != -1 {
+				found = true
+			}
+			if node.NodeType == physical.NodeTypeDatasource {
+				if unbounded, ok := node.Datasource.DatasourceImplementation.(physical.UnboundedDatasourceImplementation); ok && unbounded.Unbounded() {
+					found = true
+				}
+			}
+			return node
+		},
+	}).TransformNode(node)
+	return found
+}
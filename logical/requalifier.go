@@ -23,16 +23,19 @@ var qualifiedNameRegexp = regexp.MustCompile(`^[^.]+\..+$`)
 func (node *Requalifier) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
 	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
 
+	return source, requalifyMapping(mapping, node.qualifier)
+}
+
+func requalifyMapping(mapping map[string]string, qualifier string) map[string]string {
 	outMapping := make(map[string]string)
 	for name, unique := range mapping {
 		if qualifiedNameRegexp.MatchString(name) {
 			dotIndex := strings.Index(name, ".")
-			name = fmt.Sprintf("%s.%s", node.qualifier, name[dotIndex+1:])
+			name = fmt.Sprintf("%s.%s", qualifier, name[dotIndex+1:])
 		} else {
-			name = fmt.Sprintf("%s.%s", node.qualifier, name)
+			name = fmt.Sprintf("%s.%s", qualifier, name)
 		}
 		outMapping[name] = unique
 	}
-
-	return source, outMapping
+	return outMapping
 }
@@ -0,0 +1,34 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/physical"
+)
+
+// SameFieldCheck checks that pairs of variable names refer to the same field of its source,
+// like a variable in HAVING and the differently qualified group by key variable it's been matched to.
+type SameFieldCheck struct {
+	variables [][2]string
+	source    Node
+}
+
+func NewSameFieldCheck(variables [][2]string, child Node) *SameFieldCheck {
+	return &SameFieldCheck{variables: variables, source: child}
+}
+
+func (node *SameFieldCheck) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+
+	uniqueVariableNames := logicalEnv.WithRecordUniqueVariableNames(mapping).UniqueVariableNames
+	for _, names := range node.variables {
+		unique1, ok1 := uniqueVariableNames.GetUniqueName(names[0])
+		unique2, ok2 := uniqueVariableNames.GetUniqueName(names[1])
+		if !ok1 || !ok2 || unique1 != unique2 {
+			panic(fmt.Errorf("'%s' doesn't refer to the same field as the group by key '%s', it must be part of the group by key or an aggregate", names[0], names[1]))
+		}
+	}
+
+	return source, mapping
+}
@@ -0,0 +1,68 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// SetOperation is an INTERSECT or, if isExcept is set, an EXCEPT of two nodes.
+type SetOperation struct {
+	first, second Node
+	isExcept      bool
+	distinct      bool
+}
+
+func NewSetOperation(first, second Node, isExcept, distinct bool) *SetOperation {
+	return &SetOperation{first: first, second: second, isExcept: isExcept, distinct: distinct}
+}
+
+func (node *SetOperation) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	first, firstMapping := node.first.Typecheck(ctx, env, logicalEnv)
+	second, _ := node.second.Typecheck(ctx, env, logicalEnv)
+
+	operation := "intersect"
+	if node.isExcept {
+		operation = "except"
+	}
+	if len(first.Schema.Fields) != len(second.Schema.Fields) {
+		panic(fmt.Errorf("%s sides must have the same number of fields, first has %d, second has %d", operation, len(first.Schema.Fields), len(second.Schema.Fields)))
+	}
+
+	first, second = promoteIntFields(first, second.Schema), promoteIntFields(second, first.Schema)
+
+	// The output fields are named after the fields of the first side.
+	firstReverseMapping := ReverseMapping(firstMapping)
+
+	outMapping := make(map[string]string)
+	fields := make([]physical.SchemaField, len(first.Schema.Fields))
+	for i := range first.Schema.Fields {
+		name, ok := firstReverseMapping[first.Schema.Fields[i].Name]
+		if !ok {
+			name = fmt.Sprintf("col_%d", i)
+		}
+		unique := logicalEnv.GetUnique(name)
+		outMapping[name] = unique
+
+		fields[i] = physical.SchemaField{
+			Name: unique,
+			Type: octosql.TypeSum(first.Schema.Fields[i].Type, second.Schema.Fields[i].Type),
+		}
+	}
+
+	// Records added to the second side of an except remove records from the output.
+	noRetractions := first.Schema.NoRetractions && second.Schema.NoRetractions && !node.isExcept
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, -1, physical.WithNoRetractions(noRetractions)),
+		NodeType: physical.NodeTypeSetOperation,
+		SetOperation: &physical.SetOperation{
+			First:    first,
+			Second:   second,
+			IsExcept: node.isExcept,
+			Distinct: node.distinct,
+		},
+	}, outMapping
+}
@@ -111,6 +111,7 @@ func (arg *TableValuedFunctionArgumentValueDescriptor) Typecheck(ctx context.Con
 }
 
 type TableValuedFunction struct {
+	Positioned
 	name      string
 	arguments map[string]TableValuedFunctionArgumentValue
 }
@@ -120,6 +121,8 @@ func NewTableValuedFunction(name string, arguments map[string]TableValuedFunctio
 }
 
 func (node *TableValuedFunction) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	defer node.annotatePanic()
+
 	description := logicalEnv.TableValuedFunctions[node.name]
 
 	ok := false
@@ -2,7 +2,9 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -15,5 +17,83 @@ func NewUnionAll(first, second Node) *UnionAll {
 }
 
 func (node *UnionAll) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
-	panic("implement me")
+	first, firstMapping := node.first.Typecheck(ctx, env, logicalEnv)
+	second, _ := node.second.Typecheck(ctx, env, logicalEnv)
+
+	if len(first.Schema.Fields) != len(second.Schema.Fields) {
+		panic(fmt.Errorf("union branches must have the same number of fields, first has %d, second has %d", len(first.Schema.Fields), len(second.Schema.Fields)))
+	}
+
+	first, second = promoteIntFields(first, second.Schema), promoteIntFields(second, first.Schema)
+
+	// The output fields are named after the fields of the first branch.
+	firstReverseMapping := ReverseMapping(firstMapping)
+
+	outMapping := make(map[string]string)
+	fields := make([]physical.SchemaField, len(first.Schema.Fields))
+	for i := range first.Schema.Fields {
+		name, ok := firstReverseMapping[first.Schema.Fields[i].Name]
+		if !ok {
+			name = fmt.Sprintf("col_%d", i)
+		}
+		unique := logicalEnv.GetUnique(name)
+		outMapping[name] = unique
+
+		fields[i] = physical.SchemaField{
+			Name: unique,
+			Type: octosql.TypeSum(first.Schema.Fields[i].Type, second.Schema.Fields[i].Type),
+		}
+	}
+
+	timeField := -1
+	if first.Schema.TimeField != -1 && first.Schema.TimeField == second.Schema.TimeField {
+		timeField = first.Schema.TimeField
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, timeField, physical.WithNoRetractions(first.Schema.NoRetractions && second.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeUnionAll,
+		UnionAll: &physical.UnionAll{
+			First:  first,
+			Second: second,
+		},
+	}, outMapping
+}
+
+// promoteIntFields converts the Int fields of the node which are Floats or Decimals in the other branch,
+// so that the values of both branches have the same type and compare numerically.
+func promoteIntFields(node physical.Node, other physical.Schema) physical.Node {
+	expressions := make([]physical.Expression, len(node.Schema.Fields))
+	fields := make([]physical.SchemaField, len(node.Schema.Fields))
+	anyPromoted := false
+	for i, field := range node.Schema.Fields {
+		expressions[i] = physical.Expression{
+			Type:           field.Type,
+			ExpressionType: physical.ExpressionTypeVariable,
+			Variable: &physical.Variable{
+				Name:     field.Name,
+				IsLevel0: true,
+			},
+		}
+		if promoted, ok := promoteIntTo(expressions[i], other.Fields[i].Type); ok {
+			expressions[i] = promoted
+			anyPromoted = true
+		}
+		fields[i] = physical.SchemaField{
+			Name: field.Name,
+			Type: expressions[i].Type,
+		}
+	}
+	if !anyPromoted {
+		return node
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, node.Schema.TimeField, physical.WithNoRetractions(node.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeMap,
+		Map: &physical.Map{
+			Source:      node,
+			Expressions: expressions,
+		},
+	}
 }
@@ -1,5 +1,7 @@
 package logical
 
+import "strings"
+
 // func EqualNodes(node1, node2 Node) error {
 // 	switch node1 := node1.(type) {
 // 	case *With:
@@ -257,14 +259,40 @@ package logical
 //
 
 func EqualExpressions(expr1, expr2 Expression) bool {
+	return equalExpressions(expr1, expr2, func(v1, v2 *Variable) bool {
+		return v1.name == v2.name
+	})
+}
+
+// EqualExpressionsIgnoringQualifiers is like EqualExpressions, but a variable without a qualifier is also equal to a qualified one with the same field name, like i and r.i.
+// The pairs of names of the variables matched that way are returned, as they're only equal if they resolve to the same field.
+func EqualExpressionsIgnoringQualifiers(expr1, expr2 Expression) (bool, [][2]string) {
+	var qualifierInsensitive [][2]string
+	equal := equalExpressions(expr1, expr2, func(v1, v2 *Variable) bool {
+		if v1.name == v2.name {
+			return true
+		}
+		if strings.Contains(v1.name, ".") && strings.Contains(v2.name, ".") || v1.FieldName() != v2.FieldName() {
+			return false
+		}
+		qualifierInsensitive = append(qualifierInsensitive, [2]string{v1.name, v2.name})
+		return true
+	})
+	if !equal {
+		return false, nil
+	}
+	return true, qualifierInsensitive
+}
+
+func equalExpressions(expr1, expr2 Expression, equalVariables func(v1, v2 *Variable) bool) bool {
 	switch expr1 := expr1.(type) {
 	case *And:
 		if expr2, ok := expr2.(*And); ok {
-			return EqualExpressions(expr1.left, expr2.left) && EqualExpressions(expr1.right, expr2.right)
+			return equalExpressions(expr1.left, expr2.left, equalVariables) && equalExpressions(expr1.right, expr2.right, equalVariables)
 		}
 	case *Or:
 		if expr2, ok := expr2.(*Or); ok {
-			return EqualExpressions(expr1.left, expr2.left) && EqualExpressions(expr1.right, expr2.right)
+			return equalExpressions(expr1.left, expr2.left, equalVariables) && equalExpressions(expr1.right, expr2.right, equalVariables)
 		}
 	case *StarExpression:
 		if expr2, ok := expr2.(*StarExpression); ok {
@@ -277,7 +305,7 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 
 	case *Variable:
 		if expr2, ok := expr2.(*Variable); ok {
-			return expr1.name == expr2.name
+			return equalVariables(expr1, expr2)
 		}
 
 	case *Tuple:
@@ -286,13 +314,60 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 				return false
 			}
 			for i := range expr1.expressions {
-				if !EqualExpressions(expr1.expressions[i], expr2.expressions[i]) {
+				if !equalExpressions(expr1.expressions[i], expr2.expressions[i], equalVariables) {
+					return false
+				}
+			}
+			return true
+		}
+
+	case *List:
+		if expr2, ok := expr2.(*List); ok {
+			if len(expr1.elements) != len(expr2.elements) {
+				return false
+			}
+			for i := range expr1.elements {
+				if !equalExpressions(expr1.elements[i], expr2.elements[i], equalVariables) {
+					return false
+				}
+			}
+			return true
+		}
+
+	case *Object:
+		if expr2, ok := expr2.(*Object); ok {
+			if len(expr1.values) != len(expr2.values) {
+				return false
+			}
+			for i := range expr1.values {
+				if expr1.names[i] != expr2.names[i] || !equalExpressions(expr1.values[i], expr2.values[i], equalVariables) {
 					return false
 				}
 			}
 			return true
 		}
 
+	case *ListFunction:
+		if expr2, ok := expr2.(*ListFunction); ok {
+			if expr1.name != expr2.name || len(expr1.arguments) != len(expr2.arguments) {
+				return false
+			}
+			for i := range expr1.arguments {
+				if !equalExpressions(expr1.arguments[i], expr2.arguments[i], equalVariables) {
+					return false
+				}
+			}
+			if len(expr1.lambda.parameters) != len(expr2.lambda.parameters) {
+				return false
+			}
+			for i := range expr1.lambda.parameters {
+				if expr1.lambda.parameters[i] != expr2.lambda.parameters[i] {
+					return false
+				}
+			}
+			return equalExpressions(expr1.lambda.body, expr2.lambda.body, equalVariables)
+		}
+
 	case *FunctionExpression:
 		if expr2, ok := expr2.(*FunctionExpression); ok {
 			if expr1.Name != expr2.Name {
@@ -302,7 +377,7 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 				return false
 			}
 			for i := range expr1.Arguments {
-				if !EqualExpressions(expr1.Arguments[i], expr2.Arguments[i]) {
+				if !equalExpressions(expr1.Arguments[i], expr2.Arguments[i], equalVariables) {
 					return false
 				}
 			}
@@ -314,7 +389,7 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 			if expr1.targetTypeID != expr2.targetTypeID {
 				return false
 			}
-			return EqualExpressions(expr1.arg, expr2.arg)
+			return equalExpressions(expr1.arg, expr2.arg, equalVariables)
 		}
 
 	case *Coalesce:
@@ -323,19 +398,33 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 				return false
 			}
 			for i := range expr1.args {
-				if !EqualExpressions(expr1.args[i], expr2.args[i]) {
+				if !equalExpressions(expr1.args[i], expr2.args[i], equalVariables) {
 					return false
 				}
 			}
 			return true
 		}
 
+	case *Case:
+		if expr2, ok := expr2.(*Case); ok {
+			if len(expr1.conditions) != len(expr2.conditions) {
+				return false
+			}
+			for i := range expr1.conditions {
+				if !equalExpressions(expr1.conditions[i], expr2.conditions[i], equalVariables) ||
+					!equalExpressions(expr1.values[i], expr2.values[i], equalVariables) {
+					return false
+				}
+			}
+			return equalExpressions(expr1.elseValue, expr2.elseValue, equalVariables)
+		}
+
 	case *ObjectFieldAccess:
 		if expr2, ok := expr2.(*ObjectFieldAccess); ok {
 			if expr1.field != expr2.field {
 				return false
 			}
-			return EqualExpressions(expr1.object, expr2.object)
+			return equalExpressions(expr1.object, expr2.object, equalVariables)
 		}
 
 	}
@@ -0,0 +1,173 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// WindowFieldPrefix prefixes the names of window function output fields.
+// Those fields are referenced by the enclosing select list, so they aren't included in star expressions.
+const WindowFieldPrefix = "$window"
+
+type WindowFunction struct {
+	Name      string
+	Arguments []Expression
+	Frame     *physical.WindowFrame
+}
+
+type Window struct {
+	source            Node
+	partitionBy       []Expression
+	orderByKeyExprs   []Expression
+	orderByDirections []OrderDirection
+	functions         []WindowFunction
+	names             []string
+}
+
+func NewWindow(source Node, partitionBy []Expression, orderByKeyExprs []Expression, orderByDirections []OrderDirection, functions []WindowFunction, names []string) *Window {
+	return &Window{
+		source:            source,
+		partitionBy:       partitionBy,
+		orderByKeyExprs:   orderByKeyExprs,
+		orderByDirections: orderByDirections,
+		functions:         functions,
+		names:             names,
+	}
+}
+
+func (node *Window) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+	recordEnv := env.WithRecordSchema(source.Schema)
+	recordLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+
+	partitionBy := make([]physical.Expression, len(node.partitionBy))
+	for i := range node.partitionBy {
+		partitionBy[i] = node.partitionBy[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+	orderByKeyExprs := make([]physical.Expression, len(node.orderByKeyExprs))
+	for i := range node.orderByKeyExprs {
+		orderByKeyExprs[i] = node.orderByKeyExprs[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+
+	functions := make([]physical.WindowFunction, len(node.functions))
+	outputTypes := make([]octosql.Type, len(node.functions))
+	for i, function := range node.functions {
+		functions[i], outputTypes[i] = typecheckWindowFunction(ctx, recordEnv, recordLogicalEnv, function)
+	}
+
+	outFields := make([]physical.SchemaField, len(source.Schema.Fields), len(source.Schema.Fields)+len(functions))
+	copy(outFields, source.Schema.Fields)
+	outMapping := make(map[string]string, len(mapping)+len(node.names))
+	for k, v := range mapping {
+		outMapping[k] = v
+	}
+	for i := range node.names {
+		unique := logicalEnv.GetUnique(node.names[i])
+		outMapping[node.names[i]] = unique
+		outFields = append(outFields, physical.SchemaField{
+			Name: unique,
+			Type: outputTypes[i],
+		})
+	}
+
+	return physical.Node{
+		// Records arriving later may change the window function values of records already sent, which get retracted then.
+		Schema:   physical.NewSchema(outFields, source.Schema.TimeField),
+		NodeType: physical.NodeTypeWindow,
+		Window: &physical.Window{
+			Source:                      source,
+			PartitionBy:                 partitionBy,
+			OrderBy:                     orderByKeyExprs,
+			OrderByDirectionMultipliers: DirectionsToMultipliers(node.orderByDirections),
+			Functions:                   functions,
+		},
+	}, outMapping
+}
+
+func typecheckWindowFunction(ctx context.Context, env physical.Environment, logicalEnv Environment, function WindowFunction) (physical.WindowFunction, octosql.Type) {
+	args := make([]physical.Expression, len(function.Arguments))
+	for i := range function.Arguments {
+		args[i] = function.Arguments[i].Typecheck(ctx, env, logicalEnv)
+	}
+
+	switch function.Name {
+	case "row_number", "rank", "dense_rank":
+		if len(args) != 0 {
+			panic(fmt.Errorf("%s doesn't take any arguments", function.Name))
+		}
+		return physical.WindowFunction{
+			Name: function.Name,
+		}, octosql.Int
+
+	case "lag", "lead":
+		if len(args) < 1 || len(args) > 3 {
+			panic(fmt.Errorf("%s takes between 1 and 3 arguments, got %d", function.Name, len(args)))
+		}
+		offset := physical.Expression{
+			Type:           octosql.Int,
+			ExpressionType: physical.ExpressionTypeConstant,
+			Constant:       &physical.Constant{Value: octosql.NewInt(1)},
+		}
+		if len(args) > 1 {
+			offset = TypecheckExpression(ctx, env, logicalEnv, octosql.Int, function.Arguments[1])
+			if offset.ExpressionType != physical.ExpressionTypeConstant || offset.Constant.Value.Int < 0 {
+				panic(fmt.Errorf("%s offset must be a non-negative integer constant", function.Name))
+			}
+		}
+		defaultValue := physical.Expression{
+			Type:           octosql.Null,
+			ExpressionType: physical.ExpressionTypeConstant,
+			Constant:       &physical.Constant{Value: octosql.NewNull()},
+		}
+		if len(args) > 2 {
+			defaultValue = args[2]
+		}
+		return physical.WindowFunction{
+			Name:      function.Name,
+			Arguments: []physical.Expression{args[0], offset, defaultValue},
+		}, octosql.TypeSum(args[0].Type, defaultValue.Type)
+
+	case "first_value", "last_value":
+		if len(args) != 1 {
+			panic(fmt.Errorf("%s takes exactly 1 argument, got %d", function.Name, len(args)))
+		}
+		outputType := args[0].Type
+		if frameMayBeEmpty(function.Frame) {
+			outputType = octosql.TypeSum(outputType, octosql.Null)
+		}
+		return physical.WindowFunction{
+			Name:      function.Name,
+			Arguments: args,
+			Frame:     function.Frame,
+		}, outputType
+	}
+
+	if _, ok := env.Aggregates[function.Name]; !ok {
+		panic(fmt.Errorf("unknown window function: %s", function.Name))
+	}
+	if len(args) != 1 {
+		panic(fmt.Errorf("%s takes exactly 1 argument, got %d", function.Name, len(args)))
+	}
+	aggregate, arg := typecheckAggregate(env, function.Name, args[0])
+	outputType := aggregate.OutputType
+	if frameMayBeEmpty(function.Frame) {
+		outputType = octosql.TypeSum(outputType, octosql.Null)
+	}
+	return physical.WindowFunction{
+		Name:      function.Name,
+		Arguments: []physical.Expression{arg},
+		Aggregate: &aggregate,
+		Frame:     function.Frame,
+	}, outputType
+}
+
+// frameMayBeEmpty returns true if the frame may not contain any rows, which is the case for frames not containing the current row.
+func frameMayBeEmpty(frame *physical.WindowFrame) bool {
+	if frame == nil {
+		return false
+	}
+	return (frame.Start != nil && *frame.Start > 0) || (frame.End != nil && *frame.End < 0)
+}
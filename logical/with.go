@@ -30,6 +30,7 @@ func (node *With) Typecheck(ctx context.Context, env physical.Environment, logic
 		cte, mapping := node.cteNodes[i].Typecheck(ctx, env, Environment{
 			CommonTableExpressions: newCTEs,
 			TableValuedFunctions:   logicalEnv.TableValuedFunctions,
+			QueryParameters:        logicalEnv.QueryParameters,
 			UniqueVariableNames:    logicalEnv.UniqueVariableNames,
 			UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
 		})
@@ -42,6 +43,7 @@ func (node *With) Typecheck(ctx context.Context, env physical.Environment, logic
 	return node.source.Typecheck(ctx, env, Environment{
 		CommonTableExpressions: newCTEs,
 		TableValuedFunctions:   logicalEnv.TableValuedFunctions,
+		QueryParameters:        logicalEnv.QueryParameters,
 		UniqueVariableNames:    logicalEnv.UniqueVariableNames,
 		UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
 	})
@@ -0,0 +1,110 @@
+package octosql
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// DecimalMaxPrecision is the maximum number of digits of a decimal type.
+const DecimalMaxPrecision = 38
+
+// DecimalDivisionMinScale is the minimum number of digits after the decimal point quotients of decimals are rounded to.
+const DecimalDivisionMinScale = 6
+
+// IntDecimalPrecision is the number of digits needed to hold any Int as a decimal.
+const IntDecimalPrecision = 19
+
+// NewDecimalType returns the decimal type with the given number of digits in total and after the decimal point.
+// The precision is capped at DecimalMaxPrecision.
+func NewDecimalType(precision, scale int) Type {
+	if precision > DecimalMaxPrecision {
+		precision = DecimalMaxPrecision
+	}
+	if scale > precision {
+		scale = precision
+	}
+	return Type{
+		TypeID: TypeIDDecimal,
+		Decimal: struct {
+			Precision int
+			Scale     int
+		}{
+			Precision: precision,
+			Scale:     scale,
+		},
+	}
+}
+
+// decimalTypeSum returns the decimal type which can hold the values of both decimal types.
+func decimalTypeSum(t1, t2 Type) Type {
+	if t1.Decimal.Precision == 0 || t2.Decimal.Precision == 0 {
+		return Decimal
+	}
+	scale := t1.Decimal.Scale
+	if t2.Decimal.Scale > scale {
+		scale = t2.Decimal.Scale
+	}
+	integerDigits := t1.Decimal.Precision - t1.Decimal.Scale
+	if t2.Decimal.Precision-t2.Decimal.Scale > integerDigits {
+		integerDigits = t2.Decimal.Precision - t2.Decimal.Scale
+	}
+	return NewDecimalType(integerDigits+scale, scale)
+}
+
+// mayBeDecimal checks if the type is a decimal type or a union with a decimal alternative.
+func mayBeDecimal(t Type) bool {
+	if t.TypeID == TypeIDUnion {
+		for _, alternative := range t.Union.Alternatives {
+			if alternative.TypeID == TypeIDDecimal {
+				return true
+			}
+		}
+	}
+	return t.TypeID == TypeIDDecimal
+}
+
+// DecimalScale returns the number of digits after the decimal point the value is stored with.
+// Trailing zeros are counted, so 1.50 has a scale of 2.
+func DecimalScale(d decimal.Decimal) int {
+	if d.Exponent() >= 0 {
+		return 0
+	}
+	return int(-d.Exponent())
+}
+
+// DecimalPrecision returns the number of digits of the value, at its scale.
+func DecimalPrecision(d decimal.Decimal) int {
+	digits := len(new(big.Int).Abs(d.Coefficient()).String())
+	if d.Exponent() > 0 {
+		return digits + int(d.Exponent())
+	}
+	if scale := DecimalScale(d); scale > digits {
+		return scale
+	}
+	return digits
+}
+
+// RescaleDecimal rounds the value half away from zero to the given scale, or pads it with zeros.
+func RescaleDecimal(d decimal.Decimal, scale int) decimal.Decimal {
+	return d.Round(int32(scale))
+}
+
+// FitDecimal rescales the value to the scale of the decimal type and checks that it fits its precision.
+// Types with an unknown precision accept any value as is.
+func FitDecimal(d decimal.Decimal, t Type) (decimal.Decimal, error) {
+	if t.Decimal.Precision == 0 {
+		return d, nil
+	}
+	d = RescaleDecimal(d, t.Decimal.Scale)
+	if DecimalPrecision(d) > t.Decimal.Precision {
+		return decimal.Decimal{}, fmt.Errorf("value %s doesn't fit in %s", FormatDecimal(d), t)
+	}
+	return d, nil
+}
+
+// FormatDecimal formats the value with all the digits after the decimal point it's stored with.
+func FormatDecimal(d decimal.Decimal) string {
+	return d.StringFixed(int32(DecimalScale(d)))
+}
@@ -0,0 +1,73 @@
+package octosql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestFitDecimal(t *testing.T) {
+	tests := []struct {
+		value   string
+		t       Type
+		want    string
+		wantErr bool
+	}{
+		{
+			value: "12.5",
+			t:     NewDecimalType(5, 2),
+			want:  "12.50",
+		},
+		{
+			value: "-0.125",
+			t:     NewDecimalType(3, 2),
+			want:  "-0.13",
+		},
+		{
+			value: "1e3",
+			t:     NewDecimalType(6, 2),
+			want:  "1000.00",
+		},
+		{
+			value: "0.001",
+			t:     Decimal,
+			want:  "0.001",
+		},
+		{
+			value:   "999.995",
+			t:       NewDecimalType(5, 2),
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			got, err := FitDecimal(decimal.RequireFromString(tt.value), tt.t)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FitDecimal(%s, %s) error = %v, wantErr %v", tt.value, tt.t, err, tt.wantErr)
+			}
+			if !tt.wantErr && FormatDecimal(got) != tt.want {
+				t.Errorf("FitDecimal(%s, %s) = %s, want %s", tt.value, tt.t, FormatDecimal(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalValueType(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Type
+	}{
+		{value: "12.50", want: NewDecimalType(4, 2)},
+		{value: "-0.05", want: NewDecimalType(2, 2)},
+		{value: "42", want: NewDecimalType(2, 0)},
+		{value: "1e3", want: NewDecimalType(4, 0)},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if got := NewDecimal(decimal.RequireFromString(tt.value)).Type(); !got.Equals(tt.want) || got.Decimal != tt.want.Decimal {
+				t.Errorf("NewDecimal(%s).Type() = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
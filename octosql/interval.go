@@ -0,0 +1,119 @@
+package octosql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CalendarInterval is a calendar-aware period of time.
+// Months and days don't have a fixed length, so they're kept separately from the exact duration part.
+type CalendarInterval struct {
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// AddTo adds the interval to the given time, in the location of that time.
+// When adding months, the day of month is clamped to the last day of the resulting month, so Jan 31 + 1 month is Feb 28 (or 29).
+func (i CalendarInterval) AddTo(t time.Time) time.Time {
+	if i.Months != 0 {
+		year, month, day := t.Date()
+		hour, min, sec := t.Clock()
+
+		monthIndex := year*12 + int(month-1) + i.Months
+		year, month = floorDiv(monthIndex, 12), time.Month(floorMod(monthIndex, 12)+1)
+		if lastDay := DaysIn(year, month); day > lastDay {
+			day = lastDay
+		}
+		t = time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+	}
+	if i.Days != 0 {
+		t = t.AddDate(0, 0, i.Days)
+	}
+	return t.Add(i.Duration)
+}
+
+func (i CalendarInterval) Negate() CalendarInterval {
+	return CalendarInterval{
+		Months:   -i.Months,
+		Days:     -i.Days,
+		Duration: -i.Duration,
+	}
+}
+
+func (i CalendarInterval) Add(other CalendarInterval) CalendarInterval {
+	return CalendarInterval{
+		Months:   i.Months + other.Months,
+		Days:     i.Days + other.Days,
+		Duration: i.Duration + other.Duration,
+	}
+}
+
+func (i CalendarInterval) Multiply(n int) CalendarInterval {
+	return CalendarInterval{
+		Months:   i.Months * n,
+		Days:     i.Days * n,
+		Duration: i.Duration * time.Duration(n),
+	}
+}
+
+func (i CalendarInterval) Compare(other CalendarInterval) int {
+	switch {
+	case i.Months != other.Months:
+		return compareInts(i.Months, other.Months)
+	case i.Days != other.Days:
+		return compareInts(i.Days, other.Days)
+	default:
+		return compareInts(int(i.Duration), int(other.Duration))
+	}
+}
+
+func (i CalendarInterval) String() string {
+	var parts []string
+	if years := i.Months / 12; years != 0 {
+		parts = append(parts, pluralize(years, "year"))
+	}
+	if months := i.Months % 12; months != 0 {
+		parts = append(parts, pluralize(months, "month"))
+	}
+	if i.Days != 0 {
+		parts = append(parts, pluralize(i.Days, "day"))
+	}
+	if i.Duration != 0 || len(parts) == 0 {
+		parts = append(parts, i.Duration.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// DaysIn returns the number of days in the given month.
+func DaysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 || n == -1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func compareInts(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func floorDiv(a, b int) int {
+	if a%b != 0 && (a < 0) != (b < 0) {
+		return a/b - 1
+	}
+	return a / b
+}
+
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
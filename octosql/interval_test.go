@@ -0,0 +1,54 @@
+package octosql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCalendarIntervalAddTo(t *testing.T) {
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	if err != nil {
+		t.Skipf("couldn't load time zone: %s", err)
+	}
+
+	tests := []struct {
+		t        time.Time
+		interval CalendarInterval
+		want     time.Time
+	}{
+		{
+			t:        time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC),
+			interval: CalendarInterval{Months: 1},
+			want:     time.Date(2024, time.February, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			t:        time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC),
+			interval: CalendarInterval{Months: 1},
+			want:     time.Date(2024, time.February, 29, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			t:        time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC),
+			interval: CalendarInterval{Months: -13},
+			want:     time.Date(2023, time.February, 28, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			t:        time.Date(2024, time.November, 30, 10, 0, 0, 0, time.UTC),
+			interval: CalendarInterval{Months: 2, Days: 1, Duration: time.Hour},
+			want:     time.Date(2025, time.January, 31, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			// Days keep the wall clock time across daylight saving time changes.
+			t:        time.Date(2024, time.March, 30, 12, 0, 0, 0, warsaw),
+			interval: CalendarInterval{Days: 1},
+			want:     time.Date(2024, time.March, 31, 12, 0, 0, 0, warsaw),
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if got := tt.interval.AddTo(tt.t); !got.Equal(tt.want) {
+				t.Errorf("%s.AddTo(%s) = %s, want %s", tt.interval, tt.t, got, tt.want)
+			}
+		})
+	}
+}
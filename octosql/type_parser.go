@@ -0,0 +1,244 @@
+package octosql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseType parses a type written the same way as Type.String() prints it, e.g. {name: String; tags: [String]; age: Int | NULL}.
+// Type names are case-insensitive, struct fields may also be separated by commas.
+func ParseType(text string) (Type, error) {
+	p := &typeParser{text: text}
+	t, err := p.parseType()
+	if err != nil {
+		return Type{}, fmt.Errorf("couldn't parse type '%s': %w", text, err)
+	}
+	p.skipWhitespace()
+	if p.pos != len(p.text) {
+		return Type{}, fmt.Errorf("couldn't parse type '%s': unexpected '%s' at position %d", text, p.text[p.pos:], p.pos)
+	}
+	return t, nil
+}
+
+type typeParser struct {
+	text string
+	pos  int
+}
+
+func (p *typeParser) skipWhitespace() {
+	for p.pos < len(p.text) && unicode.IsSpace(rune(p.text[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume skips whitespace and consumes the given character, if it's next.
+func (p *typeParser) consume(c byte) bool {
+	p.skipWhitespace()
+	if p.pos < len(p.text) && p.text[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *typeParser) expect(c byte) error {
+	if !p.consume(c) {
+		if p.pos == len(p.text) {
+			return fmt.Errorf("expected '%c', got end of input", c)
+		}
+		return fmt.Errorf("expected '%c' at position %d", c, p.pos)
+	}
+	return nil
+}
+
+func (p *typeParser) parseIdentifier() (string, error) {
+	p.skipWhitespace()
+	if p.pos < len(p.text) && (p.text[p.pos] == '"' || p.text[p.pos] == '`') {
+		quote := p.text[p.pos]
+		end := strings.IndexByte(p.text[p.pos+1:], quote)
+		if end == -1 {
+			return "", fmt.Errorf("unterminated quoted identifier at position %d", p.pos)
+		}
+		identifier := p.text[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return identifier, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.text) && (p.text[p.pos] == '_' || unicode.IsLetter(rune(p.text[p.pos])) || unicode.IsDigit(rune(p.text[p.pos]))) {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos == len(p.text) {
+			return "", fmt.Errorf("expected identifier, got end of input")
+		}
+		return "", fmt.Errorf("expected identifier at position %d", p.pos)
+	}
+	return p.text[start:p.pos], nil
+}
+
+func (p *typeParser) parseType() (Type, error) {
+	t, err := p.parseAlternative()
+	if err != nil {
+		return Type{}, err
+	}
+	for p.consume('|') {
+		alternative, err := p.parseAlternative()
+		if err != nil {
+			return Type{}, err
+		}
+		t = TypeSum(t, alternative)
+	}
+	return t, nil
+}
+
+func (p *typeParser) parseAlternative() (Type, error) {
+	switch {
+	case p.consume('['):
+		if p.consume(']') {
+			return Type{TypeID: TypeIDList}, nil
+		}
+		element, err := p.parseType()
+		if err != nil {
+			return Type{}, err
+		}
+		if err := p.expect(']'); err != nil {
+			return Type{}, err
+		}
+		return Type{
+			TypeID: TypeIDList,
+			List:   struct{ Element *Type }{Element: &element},
+		}, nil
+
+	case p.consume('{'):
+		var fields []StructField
+		if p.consume('}') {
+			return Type{TypeID: TypeIDStruct}, nil
+		}
+		for {
+			name, err := p.parseIdentifier()
+			if err != nil {
+				return Type{}, err
+			}
+			if err := p.expect(':'); err != nil {
+				return Type{}, err
+			}
+			fieldType, err := p.parseType()
+			if err != nil {
+				return Type{}, err
+			}
+			for i := range fields {
+				if fields[i].Name == name {
+					return Type{}, fmt.Errorf("duplicate field '%s'", name)
+				}
+			}
+			fields = append(fields, StructField{Name: name, Type: fieldType})
+
+			if p.consume(';') || p.consume(',') {
+				continue
+			}
+			if err := p.expect('}'); err != nil {
+				return Type{}, err
+			}
+			return Type{
+				TypeID: TypeIDStruct,
+				Struct: struct{ Fields []StructField }{Fields: fields},
+			}, nil
+		}
+
+	case p.consume('('):
+		var elements []Type
+		for {
+			element, err := p.parseType()
+			if err != nil {
+				return Type{}, err
+			}
+			elements = append(elements, element)
+			if p.consume(',') {
+				continue
+			}
+			if err := p.expect(')'); err != nil {
+				return Type{}, err
+			}
+			return Type{
+				TypeID: TypeIDTuple,
+				Tuple:  struct{ Elements []Type }{Elements: elements},
+			}, nil
+		}
+	}
+
+	start := p.pos
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return Type{}, err
+	}
+	switch strings.ToLower(name) {
+	case "null":
+		return Null, nil
+	case "int":
+		return Int, nil
+	case "float":
+		return Float, nil
+	case "boolean":
+		return Boolean, nil
+	case "string":
+		return String, nil
+	case "time":
+		return Time, nil
+	case "duration":
+		return Duration, nil
+	case "interval":
+		return Interval, nil
+	case "decimal":
+		return p.parseDecimalParameters()
+	case "any":
+		return Any, nil
+	default:
+		return Type{}, fmt.Errorf("unknown type '%s' at position %d", name, start)
+	}
+}
+
+// parseDecimalParameters parses the optional precision and scale following the decimal type name, like in Decimal(10, 2).
+// The scale defaults to 0 if only the precision is given.
+func (p *typeParser) parseDecimalParameters() (Type, error) {
+	if !p.consume('(') {
+		return Decimal, nil
+	}
+	precision, err := p.parseNumber()
+	if err != nil {
+		return Type{}, err
+	}
+	scale := 0
+	if p.consume(',') {
+		if scale, err = p.parseNumber(); err != nil {
+			return Type{}, err
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return Type{}, err
+	}
+	if precision < 1 || precision > DecimalMaxPrecision {
+		return Type{}, fmt.Errorf("decimal precision must be between 1 and %d, got %d", DecimalMaxPrecision, precision)
+	}
+	if scale > precision {
+		return Type{}, fmt.Errorf("decimal scale must not be greater than its precision, got %d", scale)
+	}
+	return NewDecimalType(precision, scale), nil
+}
+
+func (p *typeParser) parseNumber() (int, error) {
+	p.skipWhitespace()
+	start := p.pos
+	for p.pos < len(p.text) && p.text[p.pos] >= '0' && p.text[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos == len(p.text) {
+			return 0, fmt.Errorf("expected number, got end of input")
+		}
+		return 0, fmt.Errorf("expected number at position %d", p.pos)
+	}
+	return strconv.Atoi(p.text[start:p.pos])
+}
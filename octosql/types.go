@@ -21,6 +21,8 @@ const (
 	TypeIDTuple
 	TypeIDUnion
 	TypeIDAny // TODO: Remove this type?
+	TypeIDInterval
+	TypeIDDecimal
 )
 
 func (t TypeID) String() string {
@@ -49,6 +51,10 @@ func (t TypeID) String() string {
 		return "Union"
 	case TypeIDAny:
 		return "Any"
+	case TypeIDInterval:
+		return "Interval"
+	case TypeIDDecimal:
+		return "Decimal"
 	}
 	return "Invalid"
 }
@@ -62,7 +68,13 @@ type Type struct {
 	Str      struct{}
 	Time     struct{}
 	Duration struct{}
-	List     struct {
+	Interval struct{}
+	// Decimal has a precision of 0 if the number of digits is unknown.
+	Decimal struct {
+		Precision int
+		Scale     int
+	}
+	List struct {
 		Element *Type
 	}
 	Struct struct {
@@ -221,6 +233,13 @@ func (t Type) String() string {
 		return strings.Join(typeStrings, " | ")
 	case TypeIDAny:
 		return "Any"
+	case TypeIDInterval:
+		return "Interval"
+	case TypeIDDecimal:
+		if t.Decimal.Precision == 0 {
+			return "Decimal"
+		}
+		return fmt.Sprintf("Decimal(%d, %d)", t.Decimal.Precision, t.Decimal.Scale)
 	}
 	panic("impossible, type switch bug")
 }
@@ -233,15 +252,22 @@ var (
 	String   = Type{TypeID: TypeIDString}
 	Time     = Type{TypeID: TypeIDTime}
 	Duration = Type{TypeID: TypeIDDuration}
+	Interval = Type{TypeID: TypeIDInterval}
+	Decimal  = Type{TypeID: TypeIDDecimal}
 	Any      = Type{TypeID: TypeIDAny}
 )
 
 func TypeSum(t1, t2 Type) Type {
 	// TODO: For field access, field.* would be nice, to get all fields out of a structure.
-	if t1.Is(t2) == TypeRelationIs {
+	if t1.TypeID == TypeIDDecimal && t2.TypeID == TypeIDDecimal {
+		return decimalTypeSum(t1, t2)
+	}
+	// Decimal types have to be widened to fit both of them, instead of returning one of them as is.
+	bothDecimal := mayBeDecimal(t1) && mayBeDecimal(t2)
+	if !bothDecimal && t1.Is(t2) == TypeRelationIs {
 		return t2
 	}
-	if t2.Is(t1) == TypeRelationIs {
+	if !bothDecimal && t2.Is(t1) == TypeRelationIs {
 		return t1
 	}
 	if t1.TypeID == TypeIDStruct && t2.TypeID == TypeIDStruct {
@@ -50,3 +50,125 @@ func TestTypeIntersection(t *testing.T) {
 		})
 	}
 }
+
+func TestParseType(t *testing.T) {
+	some := func(t Type) *Type {
+		return &t
+	}
+
+	tests := []struct {
+		text    string
+		want    Type
+		wantErr bool
+	}{
+		{
+			text: "Int",
+			want: Int,
+		},
+		{
+			text: "string | NULL",
+			want: TypeSum(String, Null),
+		},
+		{
+			text: "[Float]",
+			want: Type{TypeID: TypeIDList, List: struct{ Element *Type }{Element: &Float}},
+		},
+		{
+			text: "{name: String; age: Int | NULL, `first name`: String}",
+			want: Type{
+				TypeID: TypeIDStruct,
+				Struct: struct{ Fields []StructField }{Fields: []StructField{
+					{Name: "name", Type: String},
+					{Name: "age", Type: TypeSum(Int, Null)},
+					{Name: "first name", Type: String},
+				}},
+			},
+		},
+		{
+			text: "(Int, {a: Time})",
+			want: Type{
+				TypeID: TypeIDTuple,
+				Tuple: struct{ Elements []Type }{Elements: []Type{
+					Int,
+					{TypeID: TypeIDStruct, Struct: struct{ Fields []StructField }{Fields: []StructField{{Name: "a", Type: Time}}}},
+				}},
+			},
+		},
+		{
+			text: "[decimal(10, 2) | NULL]",
+			want: Type{TypeID: TypeIDList, List: struct{ Element *Type }{Element: some(TypeSum(NewDecimalType(10, 2), Null))}},
+		},
+		{
+			text: "Decimal",
+			want: Decimal,
+		},
+		{
+			text:    "{a: Int",
+			wantErr: true,
+		},
+		{
+			text:    "Decimal(4, 5)",
+			wantErr: true,
+		},
+		{
+			text:    "Integer",
+			wantErr: true,
+		},
+		{
+			text:    "{a: Int; a: String}",
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			got, err := ParseType(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseType(%s) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseType(%s) = %s, want %s", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalTypeSum(t *testing.T) {
+	tests := []struct {
+		t1   Type
+		t2   Type
+		want Type
+	}{
+		{
+			t1:   NewDecimalType(5, 2),
+			t2:   NewDecimalType(5, 2),
+			want: NewDecimalType(5, 2),
+		},
+		{
+			t1:   NewDecimalType(10, 2),
+			t2:   NewDecimalType(5, 4),
+			want: NewDecimalType(12, 4),
+		},
+		{
+			t1:   NewDecimalType(38, 0),
+			t2:   NewDecimalType(10, 10),
+			want: NewDecimalType(38, 10),
+		},
+		{
+			t1:   NewDecimalType(10, 2),
+			t2:   Decimal,
+			want: Decimal,
+		},
+		{
+			t1:   TypeSum(NewDecimalType(3, 1), Null),
+			t2:   NewDecimalType(3, 2),
+			want: TypeSum(NewDecimalType(4, 2), Null),
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if got := TypeSum(tt.t1, tt.t2); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TypeSum(%s, %s) = %s, want %s", tt.t1, tt.t2, got, tt.want)
+			}
+		})
+	}
+}
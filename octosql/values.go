@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 var ZeroValue = Value{}
@@ -17,6 +19,8 @@ type Value struct {
 	Str      string
 	Time     time.Time
 	Duration time.Duration
+	Interval CalendarInterval
+	Decimal  decimal.Decimal
 	List     []Value
 	Struct   []Value
 	Tuple    []Value
@@ -70,6 +74,20 @@ func NewDuration(value time.Duration) Value {
 	}
 }
 
+func NewInterval(value CalendarInterval) Value {
+	return Value{
+		TypeID:   TypeIDInterval,
+		Interval: value,
+	}
+}
+
+func NewDecimal(value decimal.Decimal) Value {
+	return Value{
+		TypeID:  TypeIDDecimal,
+		Decimal: value,
+	}
+}
+
 func NewList(value []Value) Value {
 	return Value{
 		TypeID: TypeIDList,
@@ -169,6 +187,12 @@ func (value Value) Compare(other Value) int {
 			return 0
 		}
 
+	case TypeIDInterval:
+		return value.Interval.Compare(other.Interval)
+
+	case TypeIDDecimal:
+		return value.Decimal.Cmp(other.Decimal)
+
 	case TypeIDList:
 		maxLen := len(value.List)
 		if len(other.List) > maxLen {
@@ -281,6 +305,9 @@ func (value Value) Type() Type {
 			TypeID: TypeIDTuple,
 			Tuple:  struct{ Elements []Type }{Elements: elements},
 		}
+
+	case TypeIDDecimal:
+		return NewDecimalType(DecimalPrecision(value.Decimal), DecimalScale(value.Decimal))
 	}
 
 	return Type{
@@ -317,6 +344,12 @@ func (value Value) append(builder *strings.Builder) {
 	case TypeIDDuration:
 		builder.WriteString(fmt.Sprint(value.Duration))
 
+	case TypeIDInterval:
+		builder.WriteString(value.Interval.String())
+
+	case TypeIDDecimal:
+		builder.WriteString(FormatDecimal(value.Decimal))
+
 	case TypeIDList:
 		builder.WriteString("[")
 		for i, v := range value.List {
@@ -375,6 +408,11 @@ func (value Value) ToRawGoValue(t Type) interface{} {
 		return value.Time
 	case TypeIDDuration:
 		return value.Duration
+	case TypeIDInterval:
+		return value.Interval
+	case TypeIDDecimal:
+		// Go has no native decimal type, so the exact textual form is used.
+		return FormatDecimal(value.Decimal)
 	case TypeIDList:
 		// TODO: Fix union handling.
 		if t.List.Element == nil {
@@ -0,0 +1,237 @@
+package optimizer
+
+import (
+	"github.com/cube2222/octosql/octosql"
+	. "github.com/cube2222/octosql/physical"
+)
+
+// RewriteExistsIntoSemiJoin rewrites filter predicates of the form EXISTS (subquery) and NOT EXISTS (subquery)
+// into semi and anti joins, as long as the subquery is correlated with the outer query only by equalities.
+// Otherwise, the subquery would get rerun for each outer record.
+func RewriteExistsIntoSemiJoin(node Node) (Node, bool) {
+	changed := false
+	t := Transformers{
+		NodeTransformer: func(node Node) Node {
+			// Anti joins produce retractions, which the ancestors of a rewritten filter have to know about.
+			node = propagateRetractions(node)
+			if node.NodeType != NodeTypeFilter {
+				return node
+			}
+			outerSchema := node.Filter.Source.Schema
+
+			filterPredicates := node.Filter.Predicate.SplitByAnd()
+			var stayedAbove []Expression
+			source := node.Filter.Source
+
+			for i := range filterPredicates {
+				exists := filterPredicates[i]
+				isAnti := false
+				if exists.ExpressionType == ExpressionTypeFunctionCall && exists.FunctionCall.Name == "not" {
+					exists = exists.FunctionCall.Arguments[0]
+					isAnti = true
+				}
+				if exists.ExpressionType != ExpressionTypeExists {
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
+
+				right, leftKey, rightKey, ok := decorrelateExistsSubquery(outerSchema, exists.Exists.Source)
+				if !ok {
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
+
+				source = Node{
+					Schema: NewSchema(
+						outerSchema.Fields,
+						outerSchema.TimeField,
+						WithNoRetractions(!isAnti && outerSchema.NoRetractions && right.Schema.NoRetractions),
+					),
+					NodeType: NodeTypeSemiJoin,
+					SemiJoin: &SemiJoin{
+						Left:     source,
+						Right:    right,
+						LeftKey:  leftKey,
+						RightKey: rightKey,
+						IsAnti:   isAnti,
+					},
+				}
+			}
+
+			if len(stayedAbove) == len(filterPredicates) {
+				return node
+			}
+			changed = true
+
+			if len(stayedAbove) == 0 {
+				return source
+			}
+
+			return Node{
+				Schema:   NewSchema(node.Schema.Fields, node.Schema.TimeField, WithNoRetractions(node.Schema.NoRetractions && source.Schema.NoRetractions)),
+				NodeType: NodeTypeFilter,
+				Filter: &Filter{
+					Predicate: Expression{
+						Type:           octosql.Boolean,
+						ExpressionType: ExpressionTypeAnd,
+						And: &And{
+							Arguments: stayedAbove,
+						},
+					},
+					Source: source,
+				},
+			}
+		},
+	}
+	output := t.TransformNode(node)
+
+	if changed {
+		return output, true
+	} else {
+		return node, false
+	}
+}
+
+// propagateRetractions marks the node as producing retractions if it passes on the records of a source which does.
+func propagateRetractions(node Node) Node {
+	if !node.Schema.NoRetractions {
+		return node
+	}
+	var sources []Node
+	switch node.NodeType {
+	case NodeTypeDistinct:
+		sources = []Node{node.Distinct.Source}
+	case NodeTypeFilter:
+		sources = []Node{node.Filter.Source}
+	case NodeTypeMap:
+		sources = []Node{node.Map.Source}
+	case NodeTypeRequalifier:
+		sources = []Node{node.Requalifier.Source}
+	case NodeTypeUnnest:
+		sources = []Node{node.Unnest.Source}
+	case NodeTypeStreamJoin:
+		sources = []Node{node.StreamJoin.Left, node.StreamJoin.Right}
+	case NodeTypeLookupJoin:
+		sources = []Node{node.LookupJoin.Source, node.LookupJoin.Joined}
+	case NodeTypeOuterJoin:
+		sources = []Node{node.OuterJoin.Left, node.OuterJoin.Right}
+	case NodeTypeSemiJoin:
+		sources = []Node{node.SemiJoin.Left, node.SemiJoin.Right}
+	case NodeTypeUnionAll:
+		sources = []Node{node.UnionAll.First, node.UnionAll.Second}
+	case NodeTypeSetOperation:
+		sources = []Node{node.SetOperation.First, node.SetOperation.Second}
+	}
+	for _, source := range sources {
+		if !source.Schema.NoRetractions {
+			node.Schema.NoRetractions = false
+		}
+	}
+	return node
+}
+
+// decorrelateExistsSubquery splits the filter of the subquery into equalities correlated with the outer query,
+// which become the join key, and the rest, which stays in the subquery.
+func decorrelateExistsSubquery(outerSchema Schema, subquery Node) (right Node, leftKey, rightKey []Expression, ok bool) {
+	// Projections don't influence whether any record exists.
+	for subquery.NodeType == NodeTypeMap || subquery.NodeType == NodeTypeRequalifier {
+		if subquery.NodeType == NodeTypeMap {
+			subquery = subquery.Map.Source
+		} else {
+			subquery = subquery.Requalifier.Source
+		}
+	}
+
+	right = subquery
+	var filterPredicates []Expression
+	if subquery.NodeType == NodeTypeFilter {
+		right = subquery.Filter.Source
+		filterPredicates = subquery.Filter.Predicate.SplitByAnd()
+	}
+	innerSchema := right.Schema
+
+	var stayedInside []Expression
+	for i := range filterPredicates {
+		if !UsesVariablesFromSchema(outerSchema, filterPredicates[i].VariablesUsed()) {
+			stayedInside = append(stayedInside, filterPredicates[i])
+			continue
+		}
+		if filterPredicates[i].ExpressionType != ExpressionTypeFunctionCall || filterPredicates[i].FunctionCall.Name != "=" {
+			return Node{}, nil, nil, false
+		}
+		firstPart := filterPredicates[i].FunctionCall.Arguments[0]
+		secondPart := filterPredicates[i].FunctionCall.Arguments[1]
+		firstPartVariables := firstPart.VariablesUsed()
+		secondPartVariables := secondPart.VariablesUsed()
+
+		if usesOnlyVariablesFromSchema(outerSchema, firstPartVariables) && usesOnlyVariablesFromSchema(innerSchema, secondPartVariables) {
+			leftKey = append(leftKey, transformVariablesFromSchemaIntoLevel0(outerSchema, firstPart))
+			rightKey = append(rightKey, secondPart)
+		} else if usesOnlyVariablesFromSchema(innerSchema, firstPartVariables) && usesOnlyVariablesFromSchema(outerSchema, secondPartVariables) {
+			rightKey = append(rightKey, firstPart)
+			leftKey = append(leftKey, transformVariablesFromSchemaIntoLevel0(outerSchema, secondPart))
+		} else {
+			return Node{}, nil, nil, false
+		}
+	}
+
+	if len(stayedInside) > 0 {
+		right = Node{
+			Schema:   right.Schema,
+			NodeType: NodeTypeFilter,
+			Filter: &Filter{
+				Predicate: Expression{
+					Type:           octosql.Boolean,
+					ExpressionType: ExpressionTypeAnd,
+					And: &And{
+						Arguments: stayedInside,
+					},
+				},
+				Source: right,
+			},
+		}
+	}
+
+	// The rest of the subquery must not be correlated with the outer query.
+	correlated := false
+	(&Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && UsesVariablesFromSchema(outerSchema, []string{expr.Variable.Name}) {
+				correlated = true
+			}
+			return expr
+		},
+	}).TransformNode(right)
+	if correlated {
+		return Node{}, nil, nil, false
+	}
+
+	return right, leftKey, rightKey, true
+}
+
+func usesOnlyVariablesFromSchema(schema Schema, variables []string) bool {
+	for _, name := range variables {
+		if !UsesVariablesFromSchema(schema, []string{name}) {
+			return false
+		}
+	}
+	return true
+}
+
+func transformVariablesFromSchemaIntoLevel0(schema Schema, expr Expression) Expression {
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType != ExpressionTypeVariable {
+				return expr
+			}
+			for _, field := range schema.Fields {
+				if field.Name == expr.Variable.Name {
+					expr.Variable.IsLevel0 = true
+					return expr
+				}
+			}
+			return expr
+		},
+	}
+	return t.TransformExpr(expr)
+}
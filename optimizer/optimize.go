@@ -5,6 +5,7 @@ import (
 )
 
 var defaultOptimizationRules = []func(Node) (output Node, changed bool){
+	RewriteExistsIntoSemiJoin,
 	PushDownFilterUnderRequalifier,
 	PushDownFilterPredicatesToDatasource,
 	PushDownFilterPredicatesIntoLookupJoinBranch,
@@ -32,6 +32,10 @@ func PushDownFilterPredicatesIntoStreamJoinKey(node Node) (Node, bool) {
 				}
 				firstPart := filterPredicates[i].FunctionCall.Arguments[0]
 				secondPart := filterPredicates[i].FunctionCall.Arguments[1]
+				if !comparableAsKeys(firstPart.Type, secondPart.Type) {
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
 				firstPartVariables := firstPart.VariablesUsed()
 				firstPartUsesLeftVariables := UsesVariablesFromSchema(leftSchema, firstPartVariables)
 				firstPartUsesRightVariables := UsesVariablesFromSchema(rightSchema, firstPartVariables)
@@ -95,3 +99,23 @@ func PushDownFilterPredicatesIntoStreamJoinKey(node Node) (Node, bool) {
 		return node, false
 	}
 }
+
+// comparableAsKeys checks if join keys compare values of the types the same way as =.
+// Keys never match values of different types, while = compares Ints numerically with Floats and Decimals.
+func comparableAsKeys(left, right octosql.Type) bool {
+	numericTypes := make(map[octosql.TypeID]bool)
+	addNumericTypes(numericTypes, left)
+	addNumericTypes(numericTypes, right)
+	return len(numericTypes) <= 1
+}
+
+func addNumericTypes(numericTypes map[octosql.TypeID]bool, t octosql.Type) {
+	switch t.TypeID {
+	case octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDDecimal:
+		numericTypes[t.TypeID] = true
+	case octosql.TypeIDUnion:
+		for _, alternative := range t.Union.Alternatives {
+			addNumericTypes(numericTypes, alternative)
+		}
+	}
+}
@@ -72,15 +72,42 @@ func isUsed(field string, node Node) bool {
 			return expr
 		},
 		NodeTransformer: func(node Node) Node {
-			if node.NodeType != NodeTypeTableValuedFunction {
-				return node
-			}
-			for _, arg := range node.TableValuedFunction.Arguments {
-				if arg.TableValuedFunctionArgumentType != TableValuedFunctionArgumentTypeDescriptor {
-					continue
+			switch node.NodeType {
+			case NodeTypeTableValuedFunction:
+				for _, arg := range node.TableValuedFunction.Arguments {
+					if arg.TableValuedFunctionArgumentType != TableValuedFunctionArgumentTypeDescriptor {
+						continue
+					}
+					if arg.Descriptor.Descriptor == field {
+						used = true
+					}
 				}
-				if arg.Descriptor.Descriptor == field {
-					used = true
+			case NodeTypeRecursiveCTE:
+				// The anchor and the recursive part are matched positionally, so all their fields are used.
+				for _, part := range []Node{node.RecursiveCTE.Anchor, node.RecursiveCTE.Recursive} {
+					for i := range part.Schema.Fields {
+						if part.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
+				}
+			case NodeTypeUnionAll:
+				// Union branches are matched positionally, so all their fields are used.
+				for _, branch := range []Node{node.UnionAll.First, node.UnionAll.Second} {
+					for i := range branch.Schema.Fields {
+						if branch.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
+				}
+			case NodeTypeSetOperation:
+				// Records of both sources are compared as a whole, so all their fields are used.
+				for _, source := range []Node{node.SetOperation.First, node.SetOperation.Second} {
+					for i := range source.Schema.Fields {
+						if source.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
 				}
 			}
 
@@ -46,6 +46,9 @@ func (t *JSONFormatter) Write(values []octosql.Value) error {
 }
 
 func ValueToJson(arena *fastjson.Arena, t octosql.Type, value octosql.Value) *fastjson.Value {
+	if t.TypeID == octosql.TypeIDAny {
+		t = value.Type()
+	}
 	if t.TypeID == octosql.TypeIDUnion {
 		for i := range t.Union.Alternatives {
 			if t.Union.Alternatives[i].TypeID == value.TypeID {
@@ -75,6 +78,10 @@ func ValueToJson(arena *fastjson.Arena, t octosql.Type, value octosql.Value) *fa
 		return arena.NewString(value.Time.Format(time.RFC3339))
 	case octosql.TypeIDDuration:
 		return arena.NewString(value.Duration.String())
+	case octosql.TypeIDInterval:
+		return arena.NewString(value.Interval.String())
+	case octosql.TypeIDDecimal:
+		return arena.NewNumberString(octosql.FormatDecimal(value.Decimal))
 	case octosql.TypeIDList:
 		arr := arena.NewArray()
 		for i := range value.List {
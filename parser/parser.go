@@ -10,41 +10,67 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/cube2222/octosql/aggregates"
+	"github.com/cube2222/octosql/functions"
 	"github.com/cube2222/octosql/logical"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/parser/sqlparser"
+	"github.com/cube2222/octosql/physical"
 )
 
-// func ParseUnion(statement *sqlparser.Union) (logical.Node, error) {
-// 	var err error
-// 	var root logical.Node
-//
-// 	if statement.OrderBy != nil {
-// 		return nil, errors.Errorf("order by is currently unsupported, got %+v", statement)
-// 	}
-//
-// 	firstNode, _, err := ParseNode(statement.Left)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse first select expression")
-// 	}
-//
-// 	secondNode, _, err := ParseNode(statement.Right)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse second select expression")
-// 	}
-// 	switch statement.Type {
-// 	case sqlparser.UnionAllStr:
-// 		root = logical.NewUnionAll(firstNode, secondNode)
-//
-// 	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
-// 		root = logical.NewUnionDistinct(firstNode, secondNode)
-//
-// 	default:
-// 		return nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
-// 	}
-//
-// 	return root, nil
-// }
+func ParseUnion(statement *sqlparser.Union, topmost bool) (logical.Node, *OutputOptions, error) {
+	var root logical.Node
+	outputOptions := &OutputOptions{}
+
+	firstNode, err := ParseNestedNode(statement.Left)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse first select expression")
+	}
+
+	secondNode, err := ParseNestedNode(statement.Right)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse second select expression")
+	}
+
+	switch statement.Type {
+	case sqlparser.UnionAllStr:
+		root = logical.NewUnionAll(firstNode, secondNode)
+
+	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
+		root = logical.NewUnionDistinct(firstNode, secondNode)
+
+	case sqlparser.IntersectAllStr:
+		root = logical.NewSetOperation(firstNode, secondNode, false, false)
+
+	case sqlparser.IntersectDistinctStr, sqlparser.IntersectStr:
+		root = logical.NewSetOperation(firstNode, secondNode, false, true)
+
+	case sqlparser.ExceptAllStr:
+		root = logical.NewSetOperation(firstNode, secondNode, true, false)
+
+	case sqlparser.ExceptDistinctStr, sqlparser.ExceptStr:
+		root = logical.NewSetOperation(firstNode, secondNode, true, true)
+
+	default:
+		return nil, nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
+	}
+
+	if statement.OrderBy != nil || statement.Limit != nil {
+		orderByExpressions, orderByDirections, limit, err := parseOrderByAndLimit(statement.OrderBy, statement.Limit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if topmost {
+			outputOptions.OrderByExpressions = orderByExpressions
+			outputOptions.OrderByDirections = orderByDirections
+			outputOptions.Limit = limit
+		} else {
+			root = logical.NewOrderSensitiveTransform(orderByExpressions, orderByDirections, limit, root)
+		}
+	}
+
+	return root, outputOptions, nil
+}
 
 type OutputOptions struct {
 	Limit              *logical.Expression
@@ -52,7 +78,10 @@ type OutputOptions struct {
 	OrderByDirections  []logical.OrderDirection
 }
 
-func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, error) {
+// ParseSelect parses the select statement.
+// The ORDER BY and LIMIT clauses of the topmost statement are returned as output options, as they're handled by the output.
+// In nested statements they're parsed into an order sensitive transform node.
+func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *OutputOptions, error) {
 	var err error
 	var root logical.Node
 	outputOptions := &OutputOptions{}
@@ -80,18 +109,23 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 		root = logical.NewFilter(filterFormula, root)
 	}
 
-	isGroupBy := false
+	orderedBeforeSelect := false
+	isGroupBy := statement.Having != nil
 	for i := range statement.SelectExprs {
 		if aliasedExpr, ok := statement.SelectExprs[i].(*sqlparser.AliasedExpr); ok {
-			if isAggregateExpression(aliasedExpr.Expr) {
+			if isAggregateExpression(aliasedExpr.Expr) || isGroupingFunction(aliasedExpr.Expr) {
 				isGroupBy = true
 			}
 		}
 	}
 	if isGroupBy {
-		key := make([]logical.Expression, len(statement.GroupBy))
-		for i := range statement.GroupBy {
-			key[i], err = ParseExpression(statement.GroupBy[i])
+		keyExprs, groupingSets, err := parseGroupBy(statement.GroupBy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse group by clause")
+		}
+		key := make([]logical.Expression, len(keyExprs))
+		for i := range keyExprs {
+			key[i], err = ParseExpression(keyExprs[i])
 			if err != nil {
 				return nil, nil, errors.Wrapf(err, "couldn't parse group key expression with index %v", i)
 			}
@@ -99,6 +133,7 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		isAggregate := make([]bool, len(statement.SelectExprs))
+		isGrouping := make([]bool, len(statement.SelectExprs))
 		aggregates := make([]string, len(statement.SelectExprs))
 		keyPart := make([]int, len(statement.SelectExprs))
 		aliases := make([]string, len(statement.SelectExprs))
@@ -106,6 +141,11 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 		for i := range statement.SelectExprs {
 			inExpr := statement.SelectExprs[i].(*sqlparser.AliasedExpr).Expr
 			aliases[i] = statement.SelectExprs[i].(*sqlparser.AliasedExpr).As.String()
+			if isGroupingFunction(inExpr) {
+				// The grouping function gets expanded once the name of the grouping set field is known.
+				isGrouping[i] = true
+				continue
+			}
 			agg, expr, err := ParseAggregate(inExpr)
 			if err == nil {
 				isAggregate[i] = true
@@ -137,6 +177,7 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 		}
 
 		outputExprs := make([]logical.Expression, len(isAggregate))
+		outputAliases := make([]string, len(isAggregate))
 		var nonKeyAggregates []string
 		var aggregateExprs []logical.Expression
 		var aggregateFieldNames []string
@@ -154,7 +195,9 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			return name
 		}
 		for i, ok := range isAggregate {
-			if ok {
+			if isGrouping[i] {
+				continue
+			} else if ok {
 				nonKeyAggregates = append(nonKeyAggregates, aggregates[i])
 				aggregateExprs = append(aggregateExprs, expressions[i])
 				var name string
@@ -180,13 +223,122 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 				outputExprs[i] = logical.NewVariable(name)
 			}
 		}
+		groupingSetName := getUniqueName("grouping_set")
+		// Variables in grouping and HAVING expressions matched to key variables regardless of qualifiers get checked to refer to the same field.
+		var sameFieldVariables [][2]string
+		for i := range isGrouping {
+			if !isGrouping[i] {
+				continue
+			}
+			inExpr := statement.SelectExprs[i].(*sqlparser.AliasedExpr).Expr
+			groupingExpr, groupingSameFieldVariables, err := expandGroupingFunction(inExpr.(*sqlparser.FuncExpr), keyExprs, groupingSets, groupingSetName)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse grouping expression with index %d", i)
+			}
+			sameFieldVariables = append(sameFieldVariables, groupingSameFieldVariables...)
+			outputExprs[i], err = ParseExpression(groupingExpr)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse grouping expression with index %d", i)
+			}
+			if aliases[i] != "" {
+				outputAliases[i] = getUniqueName(aliases[i])
+			} else {
+				outputAliases[i] = getUniqueName("grouping")
+			}
+		}
 
-		root = logical.NewGroupBy(root, key, keyFieldNames, aggregateExprs, nonKeyAggregates, aggregateFieldNames, triggers)
-		root = logical.NewMap(outputExprs, make([]string, len(outputExprs)), make([]string, len(outputExprs)), make([]bool, len(outputExprs)), make([]logical.Expression, len(outputExprs)), make([]bool, len(outputExprs)), root)
+		var havingPredicate logical.Expression
+		if statement.Having != nil {
+			// Aggregates and key expressions used in HAVING are replaced with references to the group by output fields.
+			// Aggregates not present in the SELECT list are added to the group by as additional fields.
+			havingExpr := statement.Having.Expr
+			type replacement struct {
+				from sqlparser.Expr
+				to   sqlparser.Expr
+			}
+			var replacements []replacement
+			if err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+				expr, ok := node.(sqlparser.Expr)
+				if !ok {
+					return true, nil
+				}
+				if _, ok := expr.(*sqlparser.Subquery); ok {
+					return false, nil
+				}
+				if funcExpr, ok := expr.(*sqlparser.FuncExpr); ok && isGroupingFunction(funcExpr) {
+					groupingExpr, groupingSameFieldVariables, err := expandGroupingFunction(funcExpr, keyExprs, groupingSets, groupingSetName)
+					if err != nil {
+						return false, errors.Wrap(err, "couldn't parse grouping expression")
+					}
+					sameFieldVariables = append(sameFieldVariables, groupingSameFieldVariables...)
+					replacements = append(replacements, replacement{from: expr, to: groupingExpr})
+					return false, nil
+				}
+				if parsed, err := ParseExpression(expr); err == nil {
+					for i := range key {
+						if equal, qualifierInsensitive := logical.EqualExpressionsIgnoringQualifiers(parsed, key[i]); equal {
+							sameFieldVariables = append(sameFieldVariables, qualifierInsensitive...)
+							replacements = append(replacements, replacement{from: expr, to: &sqlparser.ColName{Name: sqlparser.NewColIdent(keyFieldNames[i])}})
+							return false, nil
+						}
+					}
+				}
+				if funcExpr, ok := expr.(*sqlparser.FuncExpr); ok && isAggregateExpression(funcExpr) {
+					agg, aggExpr, err := ParseAggregate(funcExpr)
+					if err != nil {
+						return false, errors.Wrap(err, "couldn't parse aggregate")
+					}
+					for i := range nonKeyAggregates {
+						// Calls of non-deterministic functions, like sum(random()), are each computed separately.
+						if nonKeyAggregates[i] == agg && logical.EqualExpressions(aggregateExprs[i], aggExpr) && !hasNonDeterministicCall(funcExpr) {
+							replacements = append(replacements, replacement{from: expr, to: &sqlparser.ColName{Name: sqlparser.NewColIdent(aggregateFieldNames[i])}})
+							return false, nil
+						}
+					}
+					var name string
+					if namer, ok := aggExpr.(logical.FieldNamer); ok {
+						name = getUniqueName(fmt.Sprintf("%s_%s", agg, namer.FieldName()))
+					} else {
+						name = getUniqueName(agg)
+					}
+					nonKeyAggregates = append(nonKeyAggregates, agg)
+					aggregateExprs = append(aggregateExprs, aggExpr)
+					aggregateFieldNames = append(aggregateFieldNames, name)
+					replacements = append(replacements, replacement{from: expr, to: &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}})
+					return false, nil
+				}
+				return true, nil
+			}, havingExpr); err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse having expression")
+			}
+			for _, r := range replacements {
+				havingExpr = sqlparser.ReplaceExpr(havingExpr, r.from, r.to)
+			}
+
+			havingPredicate, err = ParseExpression(havingExpr)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse having expression")
+			}
+		}
+
+		if len(sameFieldVariables) > 0 {
+			root = logical.NewSameFieldCheck(sameFieldVariables, root)
+		}
+		root = logical.NewGroupBy(root, key, keyFieldNames, groupingSets, groupingSetName, aggregateExprs, nonKeyAggregates, aggregateFieldNames, triggers)
+		if havingPredicate != nil {
+			root = logical.NewFilter(havingPredicate, root)
+		}
+		root = logical.NewMap(outputExprs, outputAliases, make([]string, len(outputExprs)), make([]bool, len(outputExprs)), make([]logical.StarModifiers, len(outputExprs)), make([]logical.Expression, len(outputExprs)), make([]bool, len(outputExprs)), root)
 	} else {
+		root, err = parseWindowFunctions(statement, root)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse window functions")
+		}
+
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		starQualifiers := make([]string, len(statement.SelectExprs))
 		isStar := make([]bool, len(statement.SelectExprs))
+		starModifiers := make([]logical.StarModifiers, len(statement.SelectExprs))
 		objectExplosions := make([]logical.Expression, len(statement.SelectExprs))
 		isObjectExplosion := make([]bool, len(statement.SelectExprs))
 		aliases := make([]string, len(statement.SelectExprs))
@@ -195,6 +347,10 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			if starExpr, ok := statement.SelectExprs[i].(*sqlparser.StarExpr); ok {
 				starQualifiers[i] = starExpr.TableName.Name.String()
 				isStar[i] = true
+				starModifiers[i], err = ParseStarModifiers(starExpr)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "couldn't parse star expression with index %d", i)
+				}
 				continue
 			}
 
@@ -221,39 +377,219 @@ func ParseSelect(statement *sqlparser.Select) (logical.Node, *OutputOptions, err
 			}
 		}
 
-		if !(len(expressions) == 1 && isStar[0] && starQualifiers[0] == "") {
-			// Only create a map node if this is not 'SELECT * FROM xyz'
-			root = logical.NewMap(expressions, aliases, starQualifiers, isStar, objectExplosions, isObjectExplosion, root)
+		if !topmost && len(statement.Distinct) == 0 && (statement.OrderBy != nil || statement.Limit != nil) {
+			// Nested statements get ordered before the select list is evaluated,
+			// so that they can be ordered by fields which aren't selected.
+			orderByExpressions, orderByDirections, limit, err := parseOrderByAndLimit(substituteSelectAliases(statement.OrderBy, statement.SelectExprs), statement.Limit)
+			if err != nil {
+				return nil, nil, err
+			}
+			root = logical.NewOrderSensitiveTransform(orderByExpressions, orderByDirections, limit, root)
+			orderedBeforeSelect = true
 		}
+
+		root = logical.NewMap(expressions, aliases, starQualifiers, isStar, starModifiers, objectExplosions, isObjectExplosion, root)
 	}
 
 	if len(statement.Distinct) > 0 {
 		root = logical.NewDistinct(root)
 	}
 
-	if statement.OrderBy != nil {
-		orderByExpressions, orderByDirections, err := parseOrderByExpressions(statement.OrderBy)
+	if (statement.OrderBy != nil || statement.Limit != nil) && !orderedBeforeSelect {
+		orderByExpressions, orderByDirections, limit, err := parseOrderByAndLimit(statement.OrderBy, statement.Limit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if topmost {
+			outputOptions.OrderByExpressions = orderByExpressions
+			outputOptions.OrderByDirections = orderByDirections
+			outputOptions.Limit = limit
+		} else {
+			root = logical.NewOrderSensitiveTransform(orderByExpressions, orderByDirections, limit, root)
+		}
+	}
+
+	return root, outputOptions, nil
+}
+
+// parseWindowFunctions adds window nodes computing the window function calls in the select list on top of the source.
+// Window function calls sharing the same partitioning and ordering are computed by a single window node.
+// The calls are replaced in the select list with references to the window node output fields.
+func parseWindowFunctions(statement *sqlparser.Select, source logical.Node) (logical.Node, error) {
+	type window struct {
+		over      *sqlparser.OverClause
+		functions []logical.WindowFunction
+		names     []string
+	}
+	var windows []*window
+	windowFunctionCount := 0
+
+	for i := range statement.SelectExprs {
+		aliasedExpr, ok := statement.SelectExprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+
+		type replacement struct {
+			from sqlparser.Expr
+			name string
+		}
+		var replacements []replacement
+		if err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			if _, ok := node.(*sqlparser.Subquery); ok {
+				return false, nil
+			}
+			funcExpr, ok := node.(*sqlparser.FuncExpr)
+			if !ok || funcExpr.Over == nil {
+				return true, nil
+			}
+
+			function, err := parseWindowFunction(funcExpr)
+			if err != nil {
+				return false, errors.Wrapf(err, "couldn't parse window function %v", funcExpr.Name)
+			}
+
+			var curWindow *window
+			for _, w := range windows {
+				if sqlparser.String(w.over.PartitionBy) == sqlparser.String(funcExpr.Over.PartitionBy) &&
+					sqlparser.String(w.over.OrderBy) == sqlparser.String(funcExpr.Over.OrderBy) {
+					curWindow = w
+					break
+				}
+			}
+			if curWindow == nil {
+				curWindow = &window{over: funcExpr.Over}
+				windows = append(windows, curWindow)
+			}
+
+			name := fmt.Sprintf("%s_%d", logical.WindowFieldPrefix, windowFunctionCount)
+			windowFunctionCount++
+			curWindow.functions = append(curWindow.functions, function)
+			curWindow.names = append(curWindow.names, name)
+			replacements = append(replacements, replacement{from: funcExpr, name: name})
+			return false, nil
+		}, aliasedExpr.Expr); err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse select expression with index %d", i)
+		}
+
+		if len(replacements) == 1 && replacements[0].from == aliasedExpr.Expr && aliasedExpr.As.IsEmpty() {
+			aliasedExpr.As = sqlparser.NewColIdent(strings.ToLower(replacements[0].from.(*sqlparser.FuncExpr).Name.String()))
+		}
+		for _, r := range replacements {
+			aliasedExpr.Expr = sqlparser.ReplaceExpr(aliasedExpr.Expr, r.from, &sqlparser.ColName{Name: sqlparser.NewColIdent(r.name)})
+		}
+	}
+
+	for _, w := range windows {
+		partitionBy := make([]logical.Expression, len(w.over.PartitionBy))
+		for i := range w.over.PartitionBy {
+			expr, err := ParseExpression(w.over.PartitionBy[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse partition by expression with index %d", i)
+			}
+			partitionBy[i] = expr
+		}
+		orderByExpressions, orderByDirections, err := parseOrderByExpressions(w.over.OrderBy)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "couldn't parse keys of order by")
+			return nil, errors.Wrap(err, "couldn't parse window order by")
 		}
 
-		outputOptions.OrderByExpressions = orderByExpressions
-		outputOptions.OrderByDirections = orderByDirections
+		source = logical.NewWindow(source, partitionBy, orderByExpressions, orderByDirections, w.functions, w.names)
 	}
 
-	if statement.Limit != nil {
-		limitExpr, err := ParseExpression(statement.Limit.Rowcount)
+	return source, nil
+}
+
+func parseWindowFunction(expr *sqlparser.FuncExpr) (logical.WindowFunction, error) {
+	var frame *physical.WindowFrame
+	if expr.Over.Frame != nil {
+		start, err := parseFrameBound(expr.Over.Frame.Start)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "couldn't parse limit")
+			return logical.WindowFunction{}, errors.Wrap(err, "couldn't parse frame start")
+		}
+		end, err := parseFrameBound(expr.Over.Frame.End)
+		if err != nil {
+			return logical.WindowFunction{}, errors.Wrap(err, "couldn't parse frame end")
+		}
+		if expr.Over.Frame.Start.Type == sqlparser.UnboundedFollowingStr {
+			return logical.WindowFunction{}, errors.Errorf("frame can't start at unbounded following")
+		}
+		if expr.Over.Frame.End.Type == sqlparser.UnboundedPrecedingStr {
+			return logical.WindowFunction{}, errors.Errorf("frame can't end at unbounded preceding")
+		}
+		frame = &physical.WindowFrame{
+			Start: start,
+			End:   end,
 		}
-		outputOptions.Limit = &limitExpr
 	}
 
-	return root, outputOptions, nil
+	if isAggregateExpression(&sqlparser.FuncExpr{Name: expr.Name}) {
+		withoutOver := *expr
+		withoutOver.Over = nil
+		name, arg, err := ParseAggregate(&withoutOver)
+		if err != nil {
+			return logical.WindowFunction{}, errors.Wrap(err, "couldn't parse aggregate")
+		}
+		return logical.WindowFunction{
+			Name:      name,
+			Arguments: []logical.Expression{arg},
+			Frame:     frame,
+		}, nil
+	}
+
+	if expr.Distinct {
+		return logical.WindowFunction{}, errors.Errorf("DISTINCT is only supported for aggregates")
+	}
+	arguments := make([]logical.Expression, len(expr.Exprs))
+	for i := range expr.Exprs {
+		arg, ok := expr.Exprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return logical.WindowFunction{}, errors.Errorf("Unsupported argument %v of type %v", expr.Exprs[i], reflect.TypeOf(expr.Exprs[i]))
+		}
+		parsed, err := ParseFunctionArgument(arg)
+		if err != nil {
+			return logical.WindowFunction{}, errors.Wrapf(err, "couldn't parse argument with index %d", i)
+		}
+		arguments[i] = parsed
+	}
+
+	return logical.WindowFunction{
+		Name:      strings.ToLower(expr.Name.String()),
+		Arguments: arguments,
+		Frame:     frame,
+	}, nil
+}
+
+// parseFrameBound returns the frame bound as an offset relative to the current row, nil meaning unbounded.
+func parseFrameBound(bound *sqlparser.FrameBound) (*int, error) {
+	var offset int
+	switch bound.Type {
+	case sqlparser.UnboundedPrecedingStr, sqlparser.UnboundedFollowingStr:
+		return nil, nil
+	case sqlparser.CurrentRowStr:
+		offset = 0
+	case sqlparser.PrecedingStr, sqlparser.FollowingStr:
+		val, ok := bound.Offset.(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.IntVal {
+			return nil, errors.Errorf("frame offset must be an integer, got %v", sqlparser.String(bound.Offset))
+		}
+		n, err := strconv.Atoi(string(val.Val))
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse frame offset")
+		}
+		offset = n
+		if bound.Type == sqlparser.PrecedingStr {
+			offset = -n
+		}
+	default:
+		return nil, errors.Errorf("invalid frame bound: %s", bound.Type)
+	}
+	return &offset, nil
 }
 
-func ParseWith(statement *sqlparser.With) (logical.Node, *OutputOptions, error) {
-	source, outputOptions, err := ParseNode(statement.Select)
+func ParseWith(statement *sqlparser.With, topmost bool) (logical.Node, *OutputOptions, error) {
+	source, outputOptions, err := ParseNode(statement.Select, topmost)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "couldn't parse underlying select in WITH statement")
 	}
@@ -261,7 +597,13 @@ func ParseWith(statement *sqlparser.With) (logical.Node, *OutputOptions, error)
 	nodes := make([]logical.Node, len(statement.CommonTableExpressions))
 	names := make([]string, len(statement.CommonTableExpressions))
 	for i, cte := range statement.CommonTableExpressions {
-		node, err := ParseNestedNode(cte.Select)
+		var node logical.Node
+		var err error
+		if statement.Recursive && referencesTable(cte.Select, cte.Name.String()) {
+			node, err = ParseRecursiveCommonTableExpression(cte)
+		} else {
+			node, err = ParseNestedNode(cte.Select)
+		}
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "couldn't parse common table expression %s with index %d", cte.Name, i)
 		}
@@ -272,20 +614,83 @@ func ParseWith(statement *sqlparser.With) (logical.Node, *OutputOptions, error)
 	return logical.NewWith(names, nodes, source), outputOptions, nil
 }
 
-func ParseNode(statement sqlparser.SelectStatement) (logical.Node, *OutputOptions, error) {
+// ParseRecursiveCommonTableExpression parses a common table expression referencing itself.
+// It has to be a union of an anchor, which doesn't reference the common table expression, and a recursive part.
+func ParseRecursiveCommonTableExpression(cte *sqlparser.CommonTableExpression) (logical.Node, error) {
+	statement := cte.Select
+	for {
+		parenSelect, ok := statement.(*sqlparser.ParenSelect)
+		if !ok {
+			break
+		}
+		statement = parenSelect.Select
+	}
+	union, ok := statement.(*sqlparser.Union)
+	if !ok || union.OrderBy != nil || union.Limit != nil {
+		return nil, errors.Errorf("recursive common table expression must be a union of an anchor and a recursive part")
+	}
+	if referencesTable(union.Left, cte.Name.String()) {
+		return nil, errors.Errorf("anchor of recursive common table expression can't reference the common table expression")
+	}
+
+	// Each iteration passes only the records added by the previous one to the recursive part,
+	// which gives complete results only if the common table expression is referenced once, like in a linear recursion.
+	if positions := tableReferencePositions(union.Right, cte.Name.String()); len(positions) > 1 {
+		return nil, &sqlparser.PositionedError{Message: "recursive part of recursive common table expression can't reference the common table expression more than once", Position: positions[1]}
+	}
+
+	anchor, err := ParseNestedNode(union.Left)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse anchor")
+	}
+	recursive, err := ParseNestedNode(union.Right)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse recursive part")
+	}
+
+	return logical.NewRecursiveCTE(cte.Name.String(), anchor, recursive, union.Type != sqlparser.UnionAllStr), nil
+}
+
+func referencesTable(statement sqlparser.SelectStatement, name string) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, statement)
+	return found
+}
+
+// tableReferencePositions returns the positions of the table expressions in the statement which reference the table with the given name.
+func tableReferencePositions(statement sqlparser.SelectStatement, name string) []int {
+	var positions []int
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if tableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := tableExpr.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name {
+				positions = append(positions, tableExpr.Position)
+			}
+		}
+		return true, nil
+	}, statement)
+	return positions
+}
+
+// ParseNode parses the select statement. Output options are only returned for the topmost statement.
+func ParseNode(statement sqlparser.SelectStatement, topmost bool) (logical.Node, *OutputOptions, error) {
 	switch statement := statement.(type) {
 	case *sqlparser.Select:
-		return ParseSelect(statement)
+		return ParseSelect(statement, topmost)
 
-	// case *sqlparser.Union:
-	// 	plan, err := ParseUnion(statement)
-	// 	return plan, &logical.OutputOptions{}, err
+	case *sqlparser.Union:
+		return ParseUnion(statement, topmost)
 
 	case *sqlparser.ParenSelect:
-		return ParseNode(statement.Select)
+		return ParseNode(statement.Select, topmost)
 
 	case *sqlparser.With:
-		return ParseWith(statement)
+		return ParseWith(statement, topmost)
 
 	default:
 		return nil, nil, errors.Errorf("unsupported select %+v of type %v", statement, reflect.TypeOf(statement))
@@ -293,14 +698,8 @@ func ParseNode(statement sqlparser.SelectStatement) (logical.Node, *OutputOption
 }
 
 func ParseNestedNode(statement sqlparser.SelectStatement) (logical.Node, error) {
-	node, outputOptions, err := ParseNode(statement)
-	if err != nil {
-		return nil, err
-	}
-	if len(outputOptions.OrderByExpressions) > 0 || outputOptions.Limit != nil {
-		node = logical.NewOrderSensitiveTransform(outputOptions.OrderByExpressions, outputOptions.OrderByDirections, outputOptions.Limit, node)
-	}
-	return node, nil
+	node, _, err := ParseNode(statement, false)
+	return node, err
 }
 
 func ParseTableExpression(expr sqlparser.TableExpr) (logical.Node, error) {
@@ -313,6 +712,10 @@ func ParseTableExpression(expr sqlparser.TableExpr) (logical.Node, error) {
 		return ParseTableExpression(expr.Exprs[0])
 	case *sqlparser.TableValuedFunction:
 		return ParseTableValuedFunction(expr)
+	case *sqlparser.PivotTableExpr:
+		return ParsePivotTableExpression(expr)
+	case *sqlparser.UnpivotTableExpr:
+		return ParseUnpivotTableExpression(expr)
 	default:
 		return nil, errors.Errorf("invalid table expression %+v of type %v", expr, reflect.TypeOf(expr))
 	}
@@ -351,8 +754,9 @@ func ParseAliasedTableExpression(expr *sqlparser.AliasedTableExpr) (logical.Node
 				alias = alias[index+1:]
 			}
 		}
-		var out logical.Node = logical.NewDataSource(name, alias, options)
-		return out, nil
+		datasource := logical.NewDataSource(name, alias, options)
+		datasource.SetPosition(expr.Position)
+		return datasource, nil
 
 	case *sqlparser.Subquery:
 		subQuery, err := ParseNestedNode(subExpr.Select)
@@ -376,6 +780,32 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		return nil, errors.Wrap(err, "couldn't parse join right table expression")
 	}
 
+	var usingColumns []string
+	for i := range expr.Condition.Using {
+		usingColumns = append(usingColumns, expr.Condition.Using[i].String())
+	}
+	if expr.Condition.Using != nil || expr.Join == sqlparser.NaturalJoinStr || expr.Join == sqlparser.NaturalLeftJoinStr || expr.Join == sqlparser.NaturalRightJoinStr || expr.Join == sqlparser.NaturalOuterJoinStr {
+		if expr.Strategy == sqlparser.LookupJoinStrategy {
+			return nil, errors.Errorf("lookup join doesn't support USING and NATURAL joins")
+		}
+		natural := expr.Condition.Using == nil
+		var join *logical.UsingJoin
+		switch expr.Join {
+		case sqlparser.JoinStr, sqlparser.NaturalJoinStr:
+			join = logical.NewUsingJoin(leftTable, rightTable, usingColumns, natural, false, false)
+		case sqlparser.LeftJoinStr, sqlparser.NaturalLeftJoinStr:
+			join = logical.NewUsingJoin(leftTable, rightTable, usingColumns, natural, true, false)
+		case sqlparser.RightJoinStr, sqlparser.NaturalRightJoinStr:
+			join = logical.NewUsingJoin(leftTable, rightTable, usingColumns, natural, false, true)
+		case sqlparser.OuterJoinStr, sqlparser.NaturalOuterJoinStr:
+			join = logical.NewUsingJoin(leftTable, rightTable, usingColumns, natural, true, true)
+		default:
+			return nil, errors.Errorf("invalid join expression: %v", expr.Join)
+		}
+		join.SetPosition(expr.Position)
+		return join, nil
+	}
+
 	var joinOn *logical.Expression
 	if expr.Condition.On != nil {
 		predicate, err := ParseExpression(expr.Condition.On)
@@ -407,7 +837,9 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 			if joinOn == nil {
 				return nil, errors.Errorf("outer join without ON predicate")
 			}
-			node = logical.NewOuterJoin(leftTable, rightTable, *joinOn, expr.Join == sqlparser.LeftJoinStr || expr.Join == sqlparser.OuterJoinStr, expr.Join == sqlparser.RightJoinStr || expr.Join == sqlparser.OuterJoinStr)
+			outerJoin := logical.NewOuterJoin(leftTable, rightTable, *joinOn, expr.Join == sqlparser.LeftJoinStr || expr.Join == sqlparser.OuterJoinStr, expr.Join == sqlparser.RightJoinStr || expr.Join == sqlparser.OuterJoinStr)
+			outerJoin.SetPosition(expr.Position)
+			node = outerJoin
 		default:
 			return nil, errors.Errorf("invalid join expression: %v", expr.Join)
 		}
@@ -416,6 +848,88 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 	return node, nil
 }
 
+func ParsePivotTableExpression(expr *sqlparser.PivotTableExpr) (logical.Node, error) {
+	source, err := ParseTableExpression(expr.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse pivot source")
+	}
+
+	aggregates := make([]string, len(expr.Aggregates))
+	aggregateExprs := make([]logical.Expression, len(expr.Aggregates))
+	aggregateAliases := make([]string, len(expr.Aggregates))
+	for i := range expr.Aggregates {
+		aliasedExpr, ok := expr.Aggregates[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("invalid pivot aggregate with index %d", i)
+		}
+		aggregates[i], aggregateExprs[i], err = ParseAggregate(aliasedExpr.Expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse pivot aggregate with index %d", i)
+		}
+		aggregateAliases[i] = aliasedExpr.As.String()
+		if aggregateAliases[i] == "" {
+			aggregateAliases[i] = aggregates[i]
+		}
+	}
+
+	column, err := ParseExpression(expr.Column)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse pivot column")
+	}
+
+	values := make([]logical.Expression, len(expr.Values))
+	var fieldNames []string
+	for i := range expr.Values {
+		aliasedExpr, ok := expr.Values[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("invalid pivot value with index %d", i)
+		}
+		values[i], err = ParseExpression(aliasedExpr.Expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse pivot value with index %d", i)
+		}
+		valueName := aliasedExpr.As.String()
+		if valueName == "" {
+			if val, ok := aliasedExpr.Expr.(*sqlparser.SQLVal); ok {
+				valueName = string(val.Val)
+			} else {
+				valueName = sqlparser.String(aliasedExpr.Expr)
+			}
+		}
+		for j := range aggregates {
+			if len(aggregates) == 1 {
+				fieldNames = append(fieldNames, valueName)
+			} else {
+				fieldNames = append(fieldNames, fmt.Sprintf("%s_%s", valueName, aggregateAliases[j]))
+			}
+		}
+	}
+
+	var out logical.Node = logical.NewPivot(source, aggregates, aggregateExprs, column, values, fieldNames)
+	if !expr.As.IsEmpty() {
+		out = logical.NewRequalifier(expr.As.String(), out)
+	}
+	return out, nil
+}
+
+func ParseUnpivotTableExpression(expr *sqlparser.UnpivotTableExpr) (logical.Node, error) {
+	source, err := ParseTableExpression(expr.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse unpivot source")
+	}
+
+	columns := make([]string, len(expr.Columns))
+	for i := range expr.Columns {
+		columns[i] = expr.Columns[i].String()
+	}
+
+	var out logical.Node = logical.NewUnpivot(source, expr.ValueColumn.String(), expr.NameColumn.String(), columns)
+	if !expr.As.IsEmpty() {
+		out = logical.NewRequalifier(expr.As.String(), out)
+	}
+	return out, nil
+}
+
 func ParseTableValuedFunction(expr *sqlparser.TableValuedFunction) (logical.Node, error) {
 	name := expr.Name.String()
 	arguments := make(map[string]logical.TableValuedFunctionArgumentValue)
@@ -427,9 +941,12 @@ func ParseTableValuedFunction(expr *sqlparser.TableValuedFunction) (logical.Node
 		arguments[expr.Args[i].Name.String()] = parsed
 	}
 
+	tvf := logical.NewTableValuedFunction(name, arguments)
+	tvf.SetPosition(expr.Position)
+
 	return logical.NewRequalifier(
 		expr.As.String(),
-		logical.NewTableValuedFunction(name, arguments),
+		tvf,
 	), nil
 }
 
@@ -463,9 +980,32 @@ func ParseTableValuedFunctionArgument(expr sqlparser.TableValuedFunctionArgument
 
 var ErrNotAggregate = errors.New("expression is not aggregate")
 
+func ParseStarModifiers(expr *sqlparser.StarExpr) (logical.StarModifiers, error) {
+	var out logical.StarModifiers
+	for i := range expr.Except {
+		out.Except = append(out.Except, expr.Except[i].String())
+	}
+	for i := range expr.Replace {
+		aliasedExpr, ok := expr.Replace[i].(*sqlparser.AliasedExpr)
+		if !ok || aliasedExpr.As.IsEmpty() {
+			return logical.StarModifiers{}, errors.Errorf("star REPLACE expression with index %d must be of the form expression AS name", i)
+		}
+		replaceExpr, err := ParseExpression(aliasedExpr.Expr)
+		if err != nil {
+			return logical.StarModifiers{}, errors.Wrapf(err, "couldn't parse star REPLACE expression with index %d", i)
+		}
+		out.ReplaceExpressions = append(out.ReplaceExpressions, replaceExpr)
+		out.ReplaceNames = append(out.ReplaceNames, aliasedExpr.As.String())
+	}
+	return out, nil
+}
+
 func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, error) {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return "", nil, errors.Wrapf(ErrNotAggregate, "window function: %v", expr.Name)
+		}
 		curAggregate := strings.ToLower(expr.Name.String())
 		if expr.Distinct {
 			curAggregate = fmt.Sprintf("%v_distinct", curAggregate)
@@ -536,7 +1076,7 @@ func ParseTrigger(trigger sqlparser.Trigger) (logical.Trigger, error) {
 func ParseAliasedExpression(expr *sqlparser.AliasedExpr) (logical.Expression, string, error) {
 	subExpr, err := ParseExpression(expr.Expr)
 	if err != nil {
-		return nil, "", errors.Wrapf(err, "couldn't parse aliased expression: %+v", expr.Expr)
+		return nil, "", errors.Wrapf(err, "couldn't parse aliased expression: %s", sqlparser.String(expr.Expr))
 	}
 
 	return subExpr, expr.As.String(), nil
@@ -551,6 +1091,73 @@ func ParseFunctionArgument(expr *sqlparser.AliasedExpr) (logical.Expression, err
 	return subExpr, nil
 }
 
+// ParseListFunction parses a higher-order list function, which gets a lambda as its second argument.
+func ParseListFunction(name string, expr *sqlparser.FuncExpr) (logical.Expression, error) {
+	var arguments []logical.Expression
+	var lambda *logical.Lambda
+	for i := range expr.Exprs {
+		arg, ok := expr.Exprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("Unsupported argument %v of type %v", expr.Exprs[i], reflect.TypeOf(expr.Exprs[i]))
+		}
+		lambdaExpr, isLambda := arg.Expr.(*sqlparser.LambdaExpr)
+		if isLambda != (i == 1) {
+			return nil, errors.Errorf("%s expects a lambda as its second argument", name)
+		}
+		if !isLambda {
+			logicArg, err := ParseFunctionArgument(arg)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse an aliased expression argument")
+			}
+			arguments = append(arguments, logicArg)
+			continue
+		}
+
+		parameters := make([]string, len(lambdaExpr.Parameters))
+		for j, parameter := range lambdaExpr.Parameters {
+			colName, ok := parameter.(*sqlparser.ColName)
+			if !ok || !colName.Qualifier.IsEmpty() {
+				return nil, errors.Errorf("lambda parameter must be an identifier, is: %v", sqlparser.String(parameter))
+			}
+			parameters[j] = colName.Name.String()
+		}
+		body, err := ParseExpression(lambdaParameterFieldAccesses(lambdaExpr.Body, parameters))
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse lambda body")
+		}
+		lambda = logical.NewLambda(parameters, body)
+	}
+	if lambda == nil {
+		return nil, errors.Errorf("%s expects a lambda as its second argument", name)
+	}
+
+	function := logical.NewListFunction(name, arguments, lambda)
+	function.SetPosition(expr.Position)
+	return function, nil
+}
+
+// lambdaParameterFieldAccesses rewrites x.field in the lambda body into an object field access, if x is a lambda parameter.
+func lambdaParameterFieldAccesses(body sqlparser.Expr, parameters []string) sqlparser.Expr {
+	isParameter := make(map[string]bool)
+	for _, parameter := range parameters {
+		isParameter[parameter] = true
+	}
+	var fieldAccesses []*sqlparser.ColName
+	sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if colName, ok := node.(*sqlparser.ColName); ok && colName.Qualifier.Qualifier.IsEmpty() && isParameter[colName.Qualifier.Name.String()] {
+			fieldAccesses = append(fieldAccesses, colName)
+		}
+		return true, nil
+	}, body)
+	for _, colName := range fieldAccesses {
+		body = sqlparser.ReplaceExpr(body, colName, &sqlparser.ObjectFieldAccess{
+			Object: &sqlparser.ColName{Name: sqlparser.NewColIdent(colName.Qualifier.Name.String()), Position: colName.Position},
+			Field:  colName.Name,
+		})
+	}
+	return body
+}
+
 func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 	switch expr := expr.(type) {
 	case *sqlparser.UnaryExpr:
@@ -572,11 +1179,77 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 			return nil, errors.Wrap(err, "couldn't parse right child expression")
 		}
 
-		return logical.NewFunctionExpression(expr.Operator, []logical.Expression{left, right}), nil
+		function := logical.NewFunctionExpression(expr.Operator, []logical.Expression{left, right})
+		function.SetPosition(expr.Position)
+		return function, nil
+
+	case *sqlparser.SliceExpr:
+		list, err := ParseExpression(expr.Expr)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse sliced expression")
+		}
+
+		var from logical.Expression = logical.NewConstant(octosql.NewInt(0))
+		if expr.From != nil {
+			from, err = ParseExpression(expr.From)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse slice start expression")
+			}
+		}
+		arguments := []logical.Expression{list, from}
+		if expr.To != nil {
+			to, err := ParseExpression(expr.To)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse slice end expression")
+			}
+			arguments = append(arguments, to)
+		}
+
+		function := logical.NewFunctionExpression("[:]", arguments)
+		function.SetPosition(expr.Position)
+		return function, nil
+
+	case *sqlparser.ListExpr:
+		elements := make([]logical.Expression, len(expr.Exprs))
+		for i := range expr.Exprs {
+			element, err := ParseExpression(expr.Exprs[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse list element with index %d", i)
+			}
+			elements[i] = element
+		}
+		return logical.NewList(elements), nil
+
+	case *sqlparser.ObjectExpr:
+		names := make([]string, len(expr.Fields))
+		values := make([]logical.Expression, len(expr.Fields))
+		for i, field := range expr.Fields {
+			value, err := ParseExpression(field.Value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse object field %s", field.Name)
+			}
+			names[i] = field.Name
+			values[i] = value
+		}
+		return logical.NewObject(names, values), nil
+
+	case *sqlparser.LambdaExpr:
+		// In function arguments, obj->field gets parsed as a lambda, so it's turned back into an object field access here.
+		if field, ok := expr.Body.(*sqlparser.ColName); ok && len(expr.Parameters) == 1 && field.Qualifier.IsEmpty() {
+			return ParseExpression(&sqlparser.ObjectFieldAccess{Object: expr.Parameters[0], Field: field.Name})
+		}
+		return nil, errors.Errorf("lambda %v is only allowed as an argument of a list function", sqlparser.String(expr))
 
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return nil, errors.Errorf("window function %v is only allowed in the select list of a query without grouping", expr.Name)
+		}
 		functionName := strings.ToLower(expr.Name.String())
 
+		if logical.IsListFunction(functionName) {
+			return ParseListFunction(functionName, expr)
+		}
+
 		arguments := make([]logical.Expression, 0)
 		var logicArg logical.Expression
 		var err error
@@ -601,27 +1274,33 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 			return logical.NewCoalesce(arguments), nil
 		}
 
-		return logical.NewFunctionExpression(functionName, arguments), nil
+		function := logical.NewFunctionExpression(functionName, arguments)
+		function.SetPosition(expr.Position)
+		return function, nil
 
 	case *sqlparser.ColName:
 		name := expr.Name.String()
 		if !expr.Qualifier.Name.IsEmpty() {
 			name = fmt.Sprintf("%s.%s", expr.Qualifier.Name.String(), name)
 		}
-		return logical.NewVariable(name), nil
+		variable := logical.NewVariable(name)
+		variable.SetPosition(expr.Position)
+		return variable, nil
 
 	case *sqlparser.Subquery:
-		selectExpr, ok := expr.Select.(*sqlparser.Select)
-		if !ok {
-			return nil, errors.Errorf("expected select statement in subquery, go %v %v",
-				expr.Select, reflect.TypeOf(expr.Select))
-		}
-		subquery, err := ParseNestedNode(selectExpr)
+		subquery, err := ParseNestedNode(expr.Select)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't parse select expression")
 		}
 		return logical.NewQueryExpression(subquery), nil
 
+	case *sqlparser.ExistsExpr:
+		subquery, err := ParseNestedNode(expr.Subquery.Select)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse exists subquery")
+		}
+		return logical.NewExists(subquery), nil
+
 	case *sqlparser.SQLVal:
 		var value octosql.Value
 		var err error
@@ -636,6 +1315,11 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 			value = octosql.NewFloat(val)
 		case sqlparser.StrVal:
 			value = octosql.NewString(string(expr.Val))
+		case sqlparser.ValArg:
+			// The placeholder is either :name or $1, the prefix isn't part of the parameter name.
+			parameter := logical.NewParameter(string(expr.Val[1:]))
+			parameter.SetPosition(expr.Position)
+			return parameter, nil
 		default:
 			err = errors.Errorf("constant value type unsupported")
 		}
@@ -678,6 +1362,16 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 			return nil, errors.Wrap(err, "interval expression parameter must be Int constant, couldn't parse")
 		}
 
+		// Months and years don't have a fixed length, so they result in a calendar interval.
+		switch strings.TrimSuffix(strings.ToLower(expr.Unit), "s") {
+		case "month":
+			return logical.NewConstant(octosql.NewInterval(octosql.CalendarInterval{Months: int(i)})), nil
+		case "quarter":
+			return logical.NewConstant(octosql.NewInterval(octosql.CalendarInterval{Months: int(i) * 3})), nil
+		case "year":
+			return logical.NewConstant(octosql.NewInterval(octosql.CalendarInterval{Months: int(i) * 12})), nil
+		}
+
 		var unit time.Duration
 		switch strings.TrimSuffix(strings.ToLower(expr.Unit), "s") {
 		case "nanosecond":
@@ -695,7 +1389,7 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		case "day":
 			unit = time.Hour * 24
 		default:
-			return nil, errors.Errorf("invalid interval expression unit: %s, must be one of: nanosecond, microsecond, millisecond, second, minute, hour, day", expr.Unit)
+			return nil, errors.Errorf("invalid interval expression unit: %s, must be one of: nanosecond, microsecond, millisecond, second, minute, hour, day, month, quarter, year", expr.Unit)
 		}
 
 		return logical.NewConstant(octosql.NewDuration(time.Duration(i) * unit)), nil
@@ -711,7 +1405,21 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		}
 		return logical.NewFunctionExpression("not", []logical.Expression{childParsed}), nil
 	case *sqlparser.ComparisonExpr:
-		return ParseInfixComparison(expr.Left, expr.Right, expr.Operator)
+		return ParseInfixComparison(expr.Left, expr.Right, expr.Operator, expr.Position)
+	case *sqlparser.RangeCond:
+		left, err := ParseExpression(expr.Left)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse left hand side of %s operator %+v", expr.Operator, expr.Left)
+		}
+		from, err := ParseExpression(expr.From)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse lower bound of %s operator %+v", expr.Operator, expr.From)
+		}
+		to, err := ParseExpression(expr.To)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse upper bound of %s operator %+v", expr.Operator, expr.To)
+		}
+		return logical.NewFunctionExpression(expr.Operator, []logical.Expression{left, from, to}), nil
 	case *sqlparser.ParenExpr:
 		return ParseExpression(expr.Expr)
 	case *sqlparser.IsExpr:
@@ -738,10 +1446,12 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		}
 		targetType, err := ParseType(expr.Type)
 		if err != nil {
-			return nil, errors.Wrap(err, "couldn't parse type to cast to")
+			return nil, &sqlparser.PositionedError{Message: fmt.Sprintf("couldn't parse type to cast to: %s", err), Position: expr.Position}
 		}
 
-		return logical.NewTypeCast(arg, targetType), nil
+		typeCast := logical.NewTypeCast(arg, targetType)
+		typeCast.SetPosition(expr.Position)
+		return typeCast, nil
 	case *sqlparser.ObjectFieldAccess:
 		arg, err := ParseExpression(expr.Object)
 		if err != nil {
@@ -750,9 +1460,47 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		parts := strings.Split(expr.Field.String(), ".")
 		out := arg
 		for i := range parts {
-			out = logical.NewObjectFieldAccess(out, parts[i])
+			access := logical.NewObjectFieldAccess(out, parts[i])
+			access.SetPosition(expr.Position)
+			out = access
 		}
 		return out, nil
+	case *sqlparser.CaseExpr:
+		var subject logical.Expression
+		if expr.Expr != nil {
+			var err error
+			subject, err = ParseExpression(expr.Expr)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse CASE subject")
+			}
+		}
+		conditions := make([]logical.Expression, len(expr.Whens))
+		values := make([]logical.Expression, len(expr.Whens))
+		for i := range expr.Whens {
+			condition, err := ParseExpression(expr.Whens[i].Cond)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse WHEN condition with index %d", i)
+			}
+			if subject != nil {
+				// Simple CASE expressions compare the subject with each WHEN value.
+				condition = logical.NewFunctionExpression("=", []logical.Expression{subject, condition})
+			}
+			conditions[i] = condition
+
+			values[i], err = ParseExpression(expr.Whens[i].Val)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse THEN value with index %d", i)
+			}
+		}
+		var elseValue logical.Expression = logical.NewConstant(octosql.NewNull())
+		if expr.Else != nil {
+			var err error
+			elseValue, err = ParseExpression(expr.Else)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse ELSE value")
+			}
+		}
+		return logical.NewCase(conditions, values, elseValue), nil
 	default:
 		return nil, errors.Errorf("unsupported expression %+v of type %v", expr, reflect.TypeOf(expr))
 	}
@@ -780,6 +1528,10 @@ func ParseType(t sqlparser.ConvertType) (octosql.TypeID, error) {
 			return octosql.TypeIDTime, nil
 		case "duration":
 			return octosql.TypeIDDuration, nil
+		case "interval":
+			return octosql.TypeIDInterval, nil
+		case "decimal", "numeric":
+			return octosql.TypeIDDecimal, nil
 		default:
 			return 0, errors.Errorf("unknown type: %s", tName)
 		}
@@ -791,6 +1543,9 @@ func ParseType(t sqlparser.ConvertType) (octosql.TypeID, error) {
 func isAggregateExpression(expr sqlparser.Expr) bool {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return false
+		}
 		functionName := strings.ToLower(expr.Name.String())
 
 		if _, ok := aggregates.Aggregates[functionName]; ok {
@@ -803,6 +1558,241 @@ func isAggregateExpression(expr sqlparser.Expr) bool {
 	return false
 }
 
+// parseGroupBy expands the GROUP BY clause into the list of distinct key expressions and grouping sets, which contain indices into the key list.
+// The returned grouping sets are nil if the clause doesn't use GROUPING SETS, ROLLUP or CUBE.
+func parseGroupBy(groupBy sqlparser.GroupBy) ([]sqlparser.Expr, [][]int, error) {
+	usesGroupingSets := false
+	// The grouping sets contain indices into the expressions as they're written in the clause.
+	// Sets built from the same written expression always share its key, even if it's non-deterministic.
+	var exprs []sqlparser.Expr
+	addExprs := func(written []sqlparser.Expr) []int {
+		indices := make([]int, len(written))
+		for i := range written {
+			exprs = append(exprs, written[i])
+			indices[i] = len(exprs) - 1
+		}
+		return indices
+	}
+	// Each element of the clause is a list of grouping sets, the grouping sets of the whole clause are their cross product.
+	sets := [][]int{{}}
+	for i := range groupBy {
+		var elementSets [][]int
+		switch element := groupBy[i].(type) {
+		case *sqlparser.GroupingSets:
+			usesGroupingSets = true
+			for _, set := range element.Sets {
+				elementSets = append(elementSets, addExprs(groupingSetExpressions(set)))
+			}
+		case *sqlparser.FuncExpr:
+			name := element.Name.Lowered()
+			if name != "rollup" && name != "cube" || element.Qualifier.String() != "" {
+				elementSets = [][]int{addExprs([]sqlparser.Expr{element})}
+				break
+			}
+			usesGroupingSets = true
+			args := make([][]int, len(element.Exprs))
+			for j := range element.Exprs {
+				aliasedExpr, ok := element.Exprs[j].(*sqlparser.AliasedExpr)
+				if !ok {
+					return nil, nil, errors.Errorf("invalid %s argument with index %d", name, j)
+				}
+				args[j] = addExprs(groupingSetExpressions(aliasedExpr.Expr))
+			}
+			if name == "rollup" {
+				// ROLLUP(a, b) groups by (a, b), (a) and ().
+				for length := len(args); length >= 0; length-- {
+					var set []int
+					for _, arg := range args[:length] {
+						set = append(set, arg...)
+					}
+					elementSets = append(elementSets, set)
+				}
+			} else {
+				// CUBE(a, b) groups by (a, b), (a), (b) and ().
+				for mask := 1<<len(args) - 1; mask >= 0; mask-- {
+					var set []int
+					for j, arg := range args {
+						if mask&(1<<(len(args)-1-j)) != 0 {
+							set = append(set, arg...)
+						}
+					}
+					elementSets = append(elementSets, set)
+				}
+			}
+		default:
+			elementSets = [][]int{addExprs([]sqlparser.Expr{element})}
+		}
+
+		var newSets [][]int
+		for _, set := range sets {
+			for _, elementSet := range elementSets {
+				newSet := make([]int, 0, len(set)+len(elementSet))
+				newSet = append(newSet, set...)
+				newSet = append(newSet, elementSet...)
+				newSets = append(newSets, newSet)
+			}
+		}
+		sets = newSets
+	}
+
+	var key []sqlparser.Expr
+	exprKeyIndices := make([]int, len(exprs))
+exprLoop:
+	for i := range exprs {
+		for keyIndex := range key {
+			if equalKeyExpressions(key[keyIndex], exprs[i]) {
+				exprKeyIndices[i] = keyIndex
+				continue exprLoop
+			}
+		}
+		key = append(key, exprs[i])
+		exprKeyIndices[i] = len(key) - 1
+	}
+
+	groupingSets := make([][]int, len(sets))
+	for i, set := range sets {
+		groupingSets[i] = []int{}
+	setLoop:
+		for _, exprIndex := range set {
+			for _, keyIndex := range groupingSets[i] {
+				if keyIndex == exprKeyIndices[exprIndex] {
+					continue setLoop
+				}
+			}
+			groupingSets[i] = append(groupingSets[i], exprKeyIndices[exprIndex])
+		}
+	}
+	if !usesGroupingSets {
+		return key, nil, nil
+	}
+	return key, groupingSets, nil
+}
+
+// groupingSetExpressions returns the expressions of a parenthesized grouping set, or the expression itself.
+func groupingSetExpressions(expr sqlparser.Expr) []sqlparser.Expr {
+	switch expr := expr.(type) {
+	case sqlparser.ValTuple:
+		return expr
+	case *sqlparser.ParenExpr:
+		return []sqlparser.Expr{expr.Expr}
+	default:
+		return []sqlparser.Expr{expr}
+	}
+}
+
+// hasNonDeterministicCall checks if the expression contains a call to a non-deterministic function, like uuid().
+func hasNonDeterministicCall(expr sqlparser.Expr) bool {
+	found := false
+	sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if funcExpr, ok := node.(*sqlparser.FuncExpr); ok && functions.IsNonDeterministic(strings.ToLower(funcExpr.Name.String())) {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
+// equalKeyExpressions checks if both expressions compute the same group by key.
+// Calls to non-deterministic functions, like random(), never do, as each of them is evaluated separately.
+func equalKeyExpressions(left, right sqlparser.Expr) bool {
+	if hasNonDeterministicCall(left) || hasNonDeterministicCall(right) {
+		return false
+	}
+	leftParsed, err := ParseExpression(left)
+	if err != nil {
+		return false
+	}
+	rightParsed, err := ParseExpression(right)
+	if err != nil {
+		return false
+	}
+	return logical.EqualExpressions(leftParsed, rightParsed)
+}
+
+// matchKeyExpression is like equalKeyExpressions, but variables in the expression are matched to key variables regardless of qualifiers, like i to r.i.
+// The pairs of names of the variables matched that way are returned, as they must be checked to refer to the same field during typechecking.
+func matchKeyExpression(key, expr sqlparser.Expr) (bool, [][2]string) {
+	if hasNonDeterministicCall(key) || hasNonDeterministicCall(expr) {
+		return false, nil
+	}
+	keyParsed, err := ParseExpression(key)
+	if err != nil {
+		return false, nil
+	}
+	exprParsed, err := ParseExpression(expr)
+	if err != nil {
+		return false, nil
+	}
+	return logical.EqualExpressionsIgnoringQualifiers(exprParsed, keyParsed)
+}
+
+func isGroupingFunction(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	return ok && funcExpr.Name.Lowered() == "grouping" && funcExpr.Qualifier.String() == ""
+}
+
+// expandGroupingFunction rewrites a grouping(...) call into a CASE expression over the grouping set index field.
+// Each bit of the result, starting with the most significant one, is 1 if the corresponding argument is not grouped by in the record's grouping set.
+// The pairs of names of variables matched to key variables regardless of qualifiers are returned as well, see matchKeyExpression.
+func expandGroupingFunction(expr *sqlparser.FuncExpr, key []sqlparser.Expr, groupingSets [][]int, groupingSetName string) (sqlparser.Expr, [][2]string, error) {
+	if len(expr.Exprs) == 0 {
+		return nil, nil, errors.Errorf("grouping requires at least one argument")
+	}
+	var sameFieldVariables [][2]string
+	argKeyIndices := make([]int, len(expr.Exprs))
+	for i := range expr.Exprs {
+		aliasedExpr, ok := expr.Exprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, nil, errors.Errorf("invalid grouping argument with index %d", i)
+		}
+		argKeyIndices[i] = -1
+		var argSameFieldVariables [][2]string
+		for keyIndex := range key {
+			if equal, qualifierInsensitive := matchKeyExpression(key[keyIndex], aliasedExpr.Expr); equal {
+				argKeyIndices[i] = keyIndex
+				argSameFieldVariables = qualifierInsensitive
+			}
+		}
+		if argKeyIndices[i] == -1 {
+			return nil, nil, errors.Errorf("grouping argument with index %d must be part of group by key", i)
+		}
+		sameFieldVariables = append(sameFieldVariables, argSameFieldVariables...)
+	}
+	if groupingSets == nil {
+		return sqlparser.NewIntVal([]byte("0")), sameFieldVariables, nil
+	}
+
+	values := make([]sqlparser.Expr, len(groupingSets))
+	for setIndex, set := range groupingSets {
+		value := 0
+		for _, keyIndex := range argKeyIndices {
+			value <<= 1
+			grouped := false
+			for _, setKeyIndex := range set {
+				if setKeyIndex == keyIndex {
+					grouped = true
+				}
+			}
+			if !grouped {
+				value |= 1
+			}
+		}
+		values[setIndex] = sqlparser.NewIntVal([]byte(fmt.Sprint(value)))
+	}
+	out := &sqlparser.CaseExpr{
+		Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(groupingSetName)},
+		Else: values[len(values)-1],
+	}
+	for i := 0; i < len(values)-1; i++ {
+		out.Whens = append(out.Whens, &sqlparser.When{
+			Cond: sqlparser.NewIntVal([]byte(fmt.Sprint(i))),
+			Val:  values[i],
+		})
+	}
+	return out, sameFieldVariables, nil
+}
+
 func ParseInfixOperator(left, right sqlparser.Expr, operator string) (logical.Expression, error) {
 	leftParsed, err := ParseExpression(left)
 	if err != nil {
@@ -829,7 +1819,7 @@ func ParsePrefixOperator(child sqlparser.Expr, operator string) (logical.Express
 	return logical.NewFunctionExpression(operator, []logical.Expression{childParsed}), nil
 }
 
-func ParseInfixComparison(left, right sqlparser.Expr, operator string) (logical.Expression, error) {
+func ParseInfixComparison(left, right sqlparser.Expr, operator string, position int) (logical.Expression, error) {
 	leftParsed, err := ParseExpression(left)
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't parse left hand side of %s comparator %+v", operator, left)
@@ -838,29 +1828,75 @@ func ParseInfixComparison(left, right sqlparser.Expr, operator string) (logical.
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't parse right hand side of %s comparator %+v", operator, right)
 	}
+	var out *logical.FunctionExpression
 	if operator == sqlparser.NotLikeStr {
-		return logical.NewFunctionExpression(
+		out = logical.NewFunctionExpression(
 			"not",
 			[]logical.Expression{
 				logical.NewFunctionExpression("like", []logical.Expression{leftParsed, rightParsed}),
 			},
-		), nil
+		)
 	} else if operator == sqlparser.NotLikeRegexpStr {
-		return logical.NewFunctionExpression(
+		out = logical.NewFunctionExpression(
 			"not",
 			[]logical.Expression{
 				logical.NewFunctionExpression("~", []logical.Expression{leftParsed, rightParsed}),
 			},
-		), nil
+		)
 	} else if operator == sqlparser.NotLikeRegexpCaseInsensitiveStr {
-		return logical.NewFunctionExpression(
+		out = logical.NewFunctionExpression(
 			"not",
 			[]logical.Expression{
 				logical.NewFunctionExpression("~*", []logical.Expression{leftParsed, rightParsed}),
 			},
-		), nil
+		)
+	} else if operator == sqlparser.NullSafeEqualStr {
+		out = logical.NewFunctionExpression(sqlparser.IsNotDistinctFromStr, []logical.Expression{leftParsed, rightParsed})
+	} else {
+		out = logical.NewFunctionExpression(operator, []logical.Expression{leftParsed, rightParsed})
+	}
+	out.SetPosition(position)
+	return out, nil
+}
+
+func parseOrderByAndLimit(orderBy sqlparser.OrderBy, limit *sqlparser.Limit) ([]logical.Expression, []logical.OrderDirection, *logical.Expression, error) {
+	orderByExpressions, orderByDirections, err := parseOrderByExpressions(orderBy)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "couldn't parse keys of order by")
+	}
+
+	var limitExpr *logical.Expression
+	if limit != nil {
+		expr, err := ParseExpression(limit.Rowcount)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "couldn't parse limit")
+		}
+		limitExpr = &expr
+	}
+
+	return orderByExpressions, orderByDirections, limitExpr, nil
+}
+
+// substituteSelectAliases replaces order by keys referencing aliases of the select list with the aliased expressions.
+func substituteSelectAliases(orderBy sqlparser.OrderBy, selectExprs sqlparser.SelectExprs) sqlparser.OrderBy {
+	out := make(sqlparser.OrderBy, len(orderBy))
+	for i := range orderBy {
+		out[i] = &sqlparser.Order{
+			Expr:      orderBy[i].Expr,
+			Direction: orderBy[i].Direction,
+		}
+		colName, ok := orderBy[i].Expr.(*sqlparser.ColName)
+		if !ok || !colName.Qualifier.IsEmpty() {
+			continue
+		}
+		for _, selectExpr := range selectExprs {
+			if aliasedExpr, ok := selectExpr.(*sqlparser.AliasedExpr); ok && aliasedExpr.As.Equal(colName.Name) {
+				out[i].Expr = aliasedExpr.Expr
+				break
+			}
+		}
 	}
-	return logical.NewFunctionExpression(operator, []logical.Expression{leftParsed, rightParsed}), nil
+	return out
 }
 
 func parseOrderByExpressions(orderBy sqlparser.OrderBy) ([]logical.Expression, []logical.OrderDirection, error) {
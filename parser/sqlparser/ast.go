@@ -97,7 +97,7 @@ func Parse(sql string) (Statement, error) {
 			tokenizer.ParseTree = tokenizer.partialDDL
 			return tokenizer.ParseTree, nil
 		}
-		return nil, fmt.Errorf("invalid argument %v", tokenizer.LastError.Error())
+		return nil, fmt.Errorf("invalid argument %w", tokenizer.LastError)
 	}
 	if tokenizer.ParseTree == nil {
 		return nil, ErrEmpty
@@ -451,9 +451,15 @@ type Union struct {
 
 // Union.Type
 const (
-	UnionStr         = "union"
-	UnionAllStr      = "union all"
-	UnionDistinctStr = "union distinct"
+	UnionStr             = "union"
+	UnionAllStr          = "union all"
+	UnionDistinctStr     = "union distinct"
+	IntersectStr         = "intersect"
+	IntersectAllStr      = "intersect all"
+	IntersectDistinctStr = "intersect distinct"
+	ExceptStr            = "except"
+	ExceptAllStr         = "except all"
+	ExceptDistinctStr    = "except distinct"
 )
 
 // Format formats the node.
@@ -474,11 +480,16 @@ func (node *Union) walkSubtree(visit Visit) error {
 }
 
 type With struct {
+	Recursive              bool
 	CommonTableExpressions CommonTableExpressions
 	Select                 SelectStatement
 }
 
 func (node *With) Format(buf *TrackedBuffer) {
+	if node.Recursive {
+		buf.Myprintf("WITH RECURSIVE %v %v", node.CommonTableExpressions, node.Select)
+		return
+	}
 	buf.Myprintf("WITH %v %v", node.CommonTableExpressions, node.Select)
 }
 
@@ -1757,9 +1768,12 @@ func (*AliasedExpr) iSelectExpr()   {}
 func (Nextval) iSelectExpr()        {}
 func (*ObjectExplode) iSelectExpr() {}
 
-// StarExpr defines a '*' or 'table.*' expression.
+// StarExpr defines a '*' or 'table.*' expression,
+// optionally with EXCEPT (col, ...) and REPLACE (expr AS col, ...) modifiers.
 type StarExpr struct {
 	TableName TableName
+	Except    Columns
+	Replace   SelectExprs
 }
 
 // Format formats the node.
@@ -1768,6 +1782,12 @@ func (node *StarExpr) Format(buf *TrackedBuffer) {
 		buf.Myprintf("%v.", node.TableName)
 	}
 	buf.Myprintf("*")
+	if node.Except != nil {
+		buf.Myprintf(" except %v", node.Except)
+	}
+	if node.Replace != nil {
+		buf.Myprintf(" replace (%v)", node.Replace)
+	}
 }
 
 func (node *StarExpr) walkSubtree(visit Visit) error {
@@ -1777,6 +1797,8 @@ func (node *StarExpr) walkSubtree(visit Visit) error {
 	return Walk(
 		visit,
 		node.TableName,
+		node.Except,
+		node.Replace,
 	)
 }
 
@@ -1940,6 +1962,8 @@ func (*AliasedTableExpr) iTableExpr()    {}
 func (*ParenTableExpr) iTableExpr()      {}
 func (*JoinTableExpr) iTableExpr()       {}
 func (*TableValuedFunction) iTableExpr() {}
+func (*PivotTableExpr) iTableExpr()      {}
+func (*UnpivotTableExpr) iTableExpr()    {}
 
 // AliasedTableExpr represents a table expression
 // coupled with an optional alias or index hint.
@@ -1949,6 +1973,8 @@ type AliasedTableExpr struct {
 	Partitions Partitions
 	As         TableIdent
 	Hints      *IndexHints
+	// Position is the 1-based byte offset of the table expression in the parsed SQL.
+	Position int
 }
 
 // Format formats the node.
@@ -2076,6 +2102,68 @@ func (node *ParenTableExpr) walkSubtree(visit Visit) error {
 	)
 }
 
+// PivotTableExpr represents a PIVOT (agg(x), ... FOR column IN (value [AS alias], ...)) table operator.
+type PivotTableExpr struct {
+	Source     TableExpr
+	Aggregates SelectExprs
+	Column     *ColName
+	Values     SelectExprs
+	As         TableIdent
+}
+
+// Format formats the node.
+func (node *PivotTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v pivot (%v for %v in (%v))", node.Source, node.Aggregates, node.Column, node.Values)
+	if !node.As.IsEmpty() {
+		buf.Myprintf(" as %v", node.As)
+	}
+}
+
+func (node *PivotTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Source,
+		node.Aggregates,
+		node.Column,
+		node.Values,
+		node.As,
+	)
+}
+
+// UnpivotTableExpr represents an UNPIVOT (value_column FOR name_column IN (column, ...)) table operator.
+type UnpivotTableExpr struct {
+	Source      TableExpr
+	ValueColumn ColIdent
+	NameColumn  ColIdent
+	Columns     Columns
+	As          TableIdent
+}
+
+// Format formats the node.
+func (node *UnpivotTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v unpivot (%v for %v in %v)", node.Source, node.ValueColumn, node.NameColumn, node.Columns)
+	if !node.As.IsEmpty() {
+		buf.Myprintf(" as %v", node.As)
+	}
+}
+
+func (node *UnpivotTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Source,
+		node.ValueColumn,
+		node.NameColumn,
+		node.Columns,
+		node.As,
+	)
+}
+
 // JoinCondition represents the join conditions (either a ON or USING clause)
 // of a JoinTableExpr.
 type JoinCondition struct {
@@ -2108,6 +2196,8 @@ type JoinTableExpr struct {
 	Join      string
 	RightExpr TableExpr
 	Condition JoinCondition
+	// Position is the 1-based byte offset of the join keywords in the parsed SQL.
+	Position int
 }
 
 // JoinTableExpr.Join
@@ -2120,6 +2210,7 @@ const (
 	NaturalJoinStr        = "natural join"
 	NaturalLeftJoinStr    = "natural left join"
 	NaturalRightJoinStr   = "natural right join"
+	NaturalOuterJoinStr   = "natural outer join"
 	UndefinedJoinStrategy = "undefined"
 	LookupJoinStrategy    = "lookup"
 	StreamJoinStrategy    = "stream"
@@ -2146,6 +2237,8 @@ type TableValuedFunction struct {
 	Name ColIdent
 	Args TableValuedFunctionArguments
 	As   TableIdent
+	// Position is the 1-based byte offset of the function name in the parsed SQL.
+	Position int
 }
 
 // Format formats the node.
@@ -2386,6 +2479,11 @@ func (*MatchExpr) iExpr()         {}
 func (*GroupConcatExpr) iExpr()   {}
 func (*Default) iExpr()           {}
 func (*ObjectFieldAccess) iExpr() {}
+func (*GroupingSets) iExpr()      {}
+func (*SliceExpr) iExpr()         {}
+func (*ListExpr) iExpr()          {}
+func (*ObjectExpr) iExpr()        {}
+func (*LambdaExpr) iExpr()        {}
 
 // ReplaceExpr finds the from expression from root
 // and replaces it with to. If from matches root,
@@ -2541,6 +2639,8 @@ type ComparisonExpr struct {
 	Operator    string
 	Left, Right Expr
 	Escape      Expr
+	// Position is the 1-based byte offset of the operator in the parsed SQL.
+	Position int
 }
 
 // ComparisonExpr.Operator
@@ -2552,6 +2652,8 @@ const (
 	GreaterEqualStr                 = ">="
 	NotEqualStr                     = "!="
 	NullSafeEqualStr                = "<=>"
+	IsDistinctFromStr               = "is distinct from"
+	IsNotDistinctFromStr            = "is not distinct from"
 	InStr                           = "in"
 	NotInStr                        = "not in"
 	LikeStr                         = "like"
@@ -2749,6 +2851,8 @@ const (
 type SQLVal struct {
 	Type ValType
 	Val  []byte
+	// Position is the 1-based byte offset of the value in the parsed SQL, it's only set for ValArg.
+	Position int
 }
 
 // NewStrVal builds a new StrVal.
@@ -2867,6 +2971,8 @@ type ObjectFieldAccess struct {
 	Metadata interface{}
 	Object   Expr
 	Field    ColIdent
+	// Position is the 1-based byte offset of the operator in the parsed SQL.
+	Position int
 }
 
 // Format formats the node.
@@ -2886,9 +2992,157 @@ func (node *ObjectFieldAccess) walkSubtree(visit Visit) error {
 }
 
 func (node *ObjectFieldAccess) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Object)
+}
+
+// SliceExpr represents a list slice: expr[from:to].
+// Either of From and To may be nil if omitted.
+type SliceExpr struct {
+	Expr     Expr
+	From, To Expr
+	// Position is the 1-based byte offset of the opening bracket in the parsed SQL.
+	Position int
+}
+
+// Format formats the node.
+func (node *SliceExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v[", node.Expr)
+	if node.From != nil {
+		buf.Myprintf("%v", node.From)
+	}
+	buf.Myprintf(":")
+	if node.To != nil {
+		buf.Myprintf("%v", node.To)
+	}
+	buf.Myprintf("]")
+}
+
+func (node *SliceExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+		node.From,
+		node.To,
+	)
+}
+
+func (node *SliceExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr, &node.From, &node.To)
+}
+
+// ListExpr represents a list literal: [a, b, c].
+type ListExpr struct {
+	Exprs Exprs
+	// Position is the 1-based byte offset of the opening bracket in the parsed SQL.
+	Position int
+}
+
+// Format formats the node.
+func (node *ListExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("[%v]", node.Exprs)
+}
+
+func (node *ListExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Exprs)
+}
+
+func (node *ListExpr) replace(from, to Expr) bool {
+	for i := range node.Exprs {
+		if replaceExprs(from, to, &node.Exprs[i]) {
+			return true
+		}
+	}
 	return false
 }
 
+// ObjectExpr represents an object literal: {'a': x, b: y}.
+type ObjectExpr struct {
+	Fields []*ObjectField
+	// Position is the 1-based byte offset of the opening brace in the parsed SQL.
+	Position int
+}
+
+// Format formats the node.
+func (node *ObjectExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("{")
+	var prefix string
+	for _, field := range node.Fields {
+		buf.Myprintf("%s%v", prefix, field)
+		prefix = ", "
+	}
+	buf.Myprintf("}")
+}
+
+func (node *ObjectExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, field := range node.Fields {
+		if err := Walk(visit, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (node *ObjectExpr) replace(from, to Expr) bool {
+	for _, field := range node.Fields {
+		if replaceExprs(from, to, &field.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ObjectField represents a single field of an object literal.
+type ObjectField struct {
+	Name  string
+	Value Expr
+}
+
+// Format formats the node.
+func (node *ObjectField) Format(buf *TrackedBuffer) {
+	buf.Myprintf("'%s': %v", node.Name, node.Value)
+}
+
+func (node *ObjectField) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Value)
+}
+
+// LambdaExpr represents a lambda function argument: x -> body or (x, y) -> body.
+// The parameters are expressions, as they get parsed as a tuple, the parser checks they're column names.
+type LambdaExpr struct {
+	Parameters Exprs
+	Body       Expr
+	// Position is the 1-based byte offset of the arrow in the parsed SQL.
+	Position int
+}
+
+// Format formats the node.
+func (node *LambdaExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("(%v) -> %v", node.Parameters, node.Body)
+}
+
+func (node *LambdaExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Parameters, node.Body)
+}
+
+func (node *LambdaExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Body)
+}
+
 // ColName represents a column name.
 type ColName struct {
 	// Metadata is not populated by the parser.
@@ -2898,6 +3152,8 @@ type ColName struct {
 	Metadata  interface{}
 	Name      ColIdent
 	Qualifier TableName
+	// Position is the 1-based byte offset of the column name in the parsed SQL.
+	Position int
 }
 
 // Format formats the node.
@@ -3008,6 +3264,8 @@ func (node ListArg) replace(from, to Expr) bool {
 type BinaryExpr struct {
 	Operator    string
 	Left, Right Expr
+	// Position is the 1-based byte offset of the operator in the parsed SQL.
+	Position int
 }
 
 // BinaryExpr.Operator
@@ -3202,6 +3460,10 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	// Over is set for window function calls.
+	Over *OverClause
+	// Position is the 1-based byte offset of the function name in the parsed SQL.
+	Position int
 }
 
 // Format formats the node.
@@ -3217,6 +3479,9 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// if they match a reserved word. So, print the
 	// name as is.
 	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	if node.Over != nil {
+		buf.Myprintf(" %v", node.Over)
+	}
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -3228,9 +3493,89 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
+	)
+}
+
+// OverClause represents the window specification of a window function call.
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *WindowFrame
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	buf.Myprintf("over (")
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.Myprintf("%s%v", prefix, node.OrderBy)
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", prefix, node.Frame)
+	}
+	buf.Myprintf(")")
+}
+
+func (node *OverClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.PartitionBy,
+		node.OrderBy,
 	)
 }
 
+// WindowFrame represents a ROWS frame of a window specification.
+type WindowFrame struct {
+	Start, End *FrameBound
+}
+
+// Format formats the node.
+func (node *WindowFrame) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rows between %v and %v", node.Start, node.End)
+}
+
+func (node *WindowFrame) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// FrameBound represents one of the bounds of a window frame.
+// Offset is only set for the preceding and following bound types.
+type FrameBound struct {
+	Type   string
+	Offset Expr
+}
+
+// FrameBound.Type
+const (
+	UnboundedPrecedingStr = "unbounded preceding"
+	PrecedingStr          = "preceding"
+	CurrentRowStr         = "current row"
+	FollowingStr          = "following"
+	UnboundedFollowingStr = "unbounded following"
+)
+
+// Format formats the node.
+func (node *FrameBound) Format(buf *TrackedBuffer) {
+	if node.Offset != nil {
+		buf.Myprintf("%v %s", node.Offset, node.Type)
+		return
+	}
+	buf.Myprintf("%s", node.Type)
+}
+
+func (node *FrameBound) walkSubtree(visit Visit) error {
+	return nil
+}
+
 func (node *FuncExpr) replace(from, to Expr) bool {
 	for _, sel := range node.Exprs {
 		aliased, ok := sel.(*AliasedExpr)
@@ -3384,6 +3729,8 @@ func (node *SubstrExpr) walkSubtree(visit Visit) error {
 type ConvertExpr struct {
 	Expr Expr
 	Type ConvertType
+	// Position is the 1-based byte offset of the CAST or CONVERT keyword, or the :: operator, in the parsed SQL.
+	Position int
 }
 
 // Format formats the node.
@@ -3641,6 +3988,33 @@ func (node GroupBy) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// GroupingSets represents a GROUPING SETS element of a GROUP BY clause.
+// Each grouping set is either a single expression or a ValTuple of expressions.
+type GroupingSets struct {
+	Sets Exprs
+}
+
+// Format formats the node.
+func (node *GroupingSets) Format(buf *TrackedBuffer) {
+	buf.Myprintf("grouping sets (%v)", node.Sets)
+}
+
+func (node *GroupingSets) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Sets)
+}
+
+func (node *GroupingSets) replace(from, to Expr) bool {
+	for i := range node.Sets {
+		if replaceExprs(from, to, &node.Sets[i]) {
+			return true
+		}
+	}
+	return false
+}
+
 // OrderBy represents an ORDER By clause.
 type OrderBy []*Order
 
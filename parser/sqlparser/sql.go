@@ -38,7 +38,15 @@ func skipToEnd(yylex interface{}) {
 	yylex.(*Tokenizer).SkipToEnd = true
 }
 
-//line sql.y:59
+// lambdaParameters returns the elements of the tuple a lambda's parameters got parsed as.
+func lambdaParameters(tuple Expr) Exprs {
+	if paren, ok := tuple.(*ParenExpr); ok {
+		return Exprs{paren.Expr}
+	}
+	return Exprs(tuple.(ValTuple))
+}
+
+//line sql.y:67
 type yySymType struct {
 	yys                              int
 	tableValuedFunctionArguments     TableValuedFunctionArguments
@@ -54,6 +62,7 @@ type yySymType struct {
 	ins                              *Insert
 	byt                              byte
 	bytes                            []byte
+	pos                              int
 	bytes2                           [][]byte
 	str                              string
 	strs                             []string
@@ -80,6 +89,9 @@ type yySymType struct {
 	when                             *When
 	orderBy                          OrderBy
 	order                            *Order
+	overClause                       *OverClause
+	windowFrame                      *WindowFrame
+	frameBound                       *FrameBound
 	limit                            *Limit
 	triggers                         []Trigger
 	trigger                          Trigger
@@ -113,277 +125,300 @@ type yySymType struct {
 	vindexParams                     []VindexParam
 	showFilter                       *ShowFilter
 	optLike                          *OptLike
+	objectFields                     []*ObjectField
+	objectField                      *ObjectField
 }
 
 const LEX_ERROR = 57346
-const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const WATERMARK = 57362
-const DELAY = 57363
-const COUNTING = 57364
-const AFTER = 57365
-const ALL = 57366
-const DISTINCT = 57367
-const AS = 57368
-const EXISTS = 57369
-const ASC = 57370
-const DESC = 57371
-const INTO = 57372
-const DUPLICATE = 57373
-const KEY = 57374
-const DEFAULT = 57375
-const SET = 57376
-const LOCK = 57377
-const UNLOCK = 57378
-const KEYS = 57379
-const VALUES = 57380
-const LAST_INSERT_ID = 57381
-const NEXT = 57382
-const VALUE = 57383
-const SHARE = 57384
-const MODE = 57385
-const SQL_NO_CACHE = 57386
-const SQL_CACHE = 57387
-const JOIN = 57388
-const STRAIGHT_JOIN = 57389
-const LOOKUP = 57390
-const LEFT = 57391
-const RIGHT = 57392
-const INNER = 57393
-const OUTER = 57394
-const CROSS = 57395
-const NATURAL = 57396
-const USE = 57397
-const FORCE = 57398
-const ON = 57399
-const USING = 57400
-const ID = 57401
-const HEX = 57402
-const STRING = 57403
-const INTEGRAL = 57404
-const FLOAT = 57405
-const HEXNUM = 57406
-const VALUE_ARG = 57407
-const LIST_ARG = 57408
-const COMMENT = 57409
-const COMMENT_KEYWORD = 57410
-const BIT_LITERAL = 57411
-const LIST_TYPE = 57412
-const OBJECT_TYPE = 57413
-const NULL = 57414
-const TRUE = 57415
-const FALSE = 57416
-const OFF = 57417
-const OR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const OF = 57427
-const LE = 57428
-const GE = 57429
-const NE = 57430
-const NULL_SAFE_EQUAL = 57431
-const IS = 57432
-const LIKE = 57433
-const REGEXP = 57434
-const IN = 57435
-const RIGHTARROW = 57436
-const SHIFT_LEFT = 57437
-const SHIFT_RIGHT = 57438
-const DIV = 57439
-const MOD = 57440
-const NOT_LIKE_REGEXP = 57441
-const LIKE_REGEXP_CASE_INSENSITIVE = 57442
-const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57443
-const UNARY = 57444
-const COLLATE = 57445
-const BINARY = 57446
-const UNDERSCORE_BINARY = 57447
-const UNDERSCORE_UTF8MB4 = 57448
-const INTERVAL = 57449
-const JSON_EXPLODE_OP = 57450
-const JSON_EXTRACT_OP = 57451
-const JSON_UNQUOTE_EXTRACT_OP = 57452
-const CREATE = 57453
-const ALTER = 57454
-const DROP = 57455
-const RENAME = 57456
-const ANALYZE = 57457
-const ADD = 57458
-const FLUSH = 57459
-const SCHEMA = 57460
-const TABLE = 57461
-const DESCRIPTOR = 57462
-const INDEX = 57463
-const VIEW = 57464
-const TO = 57465
-const IGNORE = 57466
-const IF = 57467
-const UNIQUE = 57468
-const PRIMARY = 57469
-const COLUMN = 57470
-const SPATIAL = 57471
-const FULLTEXT = 57472
-const KEY_BLOCK_SIZE = 57473
-const ACTION = 57474
-const CASCADE = 57475
-const CONSTRAINT = 57476
-const FOREIGN = 57477
-const NO = 57478
-const REFERENCES = 57479
-const RESTRICT = 57480
-const SHOW = 57481
-const DESCRIBE = 57482
-const EXPLAIN = 57483
-const DATE = 57484
-const ESCAPE = 57485
-const REPAIR = 57486
-const OPTIMIZE = 57487
-const TRUNCATE = 57488
-const MAXVALUE = 57489
-const PARTITION = 57490
-const REORGANIZE = 57491
-const LESS = 57492
-const THAN = 57493
-const PROCEDURE = 57494
-const TRIGGER = 57495
-const VINDEX = 57496
-const VINDEXES = 57497
-const STATUS = 57498
-const VARIABLES = 57499
-const WARNINGS = 57500
-const BEGIN = 57501
-const START = 57502
-const TRANSACTION = 57503
-const COMMIT = 57504
-const ROLLBACK = 57505
-const BIT = 57506
-const TINYINT = 57507
-const SMALLINT = 57508
-const MEDIUMINT = 57509
-const INT = 57510
-const INTEGER = 57511
-const BIGINT = 57512
-const INTNUM = 57513
-const REAL = 57514
-const DOUBLE = 57515
-const FLOAT_TYPE = 57516
-const DECIMAL = 57517
-const NUMERIC = 57518
-const TIME = 57519
-const TIMESTAMP = 57520
-const DATETIME = 57521
-const YEAR = 57522
-const CHAR = 57523
-const VARCHAR = 57524
-const BOOL = 57525
-const CHARACTER = 57526
-const VARBINARY = 57527
-const NCHAR = 57528
-const TEXT = 57529
-const TINYTEXT = 57530
-const MEDIUMTEXT = 57531
-const LONGTEXT = 57532
-const BLOB = 57533
-const TINYBLOB = 57534
-const MEDIUMBLOB = 57535
-const LONGBLOB = 57536
-const JSON = 57537
-const ENUM = 57538
-const GEOMETRY = 57539
-const POINT = 57540
-const LINESTRING = 57541
-const POLYGON = 57542
-const GEOMETRYCOLLECTION = 57543
-const MULTIPOINT = 57544
-const MULTILINESTRING = 57545
-const MULTIPOLYGON = 57546
-const NULLX = 57547
-const AUTO_INCREMENT = 57548
-const APPROXNUM = 57549
-const SIGNED = 57550
-const UNSIGNED = 57551
-const ZEROFILL = 57552
-const COLLATION = 57553
-const DATABASES = 57554
-const SCHEMAS = 57555
-const TABLES = 57556
-const VITESS_KEYSPACES = 57557
-const VITESS_SHARDS = 57558
-const VITESS_TABLETS = 57559
-const VSCHEMA = 57560
-const VSCHEMA_TABLES = 57561
-const VITESS_TARGET = 57562
-const FULL = 57563
-const PROCESSLIST = 57564
-const COLUMNS = 57565
-const FIELDS = 57566
-const ENGINES = 57567
-const PLUGINS = 57568
-const NAMES = 57569
-const CHARSET = 57570
-const GLOBAL = 57571
-const SESSION = 57572
-const ISOLATION = 57573
-const LEVEL = 57574
-const READ = 57575
-const WRITE = 57576
-const ONLY = 57577
-const REPEATABLE = 57578
-const COMMITTED = 57579
-const UNCOMMITTED = 57580
-const SERIALIZABLE = 57581
-const CURRENT_TIMESTAMP = 57582
-const DATABASE = 57583
-const CURRENT_DATE = 57584
-const CURRENT_TIME = 57585
-const LOCALTIME = 57586
-const LOCALTIMESTAMP = 57587
-const UTC_DATE = 57588
-const UTC_TIME = 57589
-const UTC_TIMESTAMP = 57590
-const REPLACE = 57591
-const CONVERT = 57592
-const CAST = 57593
-const SUBSTR = 57594
-const SUBSTRING = 57595
-const GROUP_CONCAT = 57596
-const SEPARATOR = 57597
-const TIMESTAMPADD = 57598
-const TIMESTAMPDIFF = 57599
-const MATCH = 57600
-const AGAINST = 57601
-const BOOLEAN = 57602
-const LANGUAGE = 57603
-const WITH = 57604
-const QUERY = 57605
-const EXPANSION = 57606
-const UNUSED = 57607
+const STAR_MODIFIER = 57347
+const UNION = 57348
+const EXCEPT = 57349
+const INTERSECT = 57350
+const SELECT = 57351
+const STREAM = 57352
+const INSERT = 57353
+const UPDATE = 57354
+const DELETE = 57355
+const FROM = 57356
+const WHERE = 57357
+const GROUP = 57358
+const HAVING = 57359
+const ORDER = 57360
+const BY = 57361
+const LIMIT = 57362
+const OFFSET = 57363
+const FOR = 57364
+const WATERMARK = 57365
+const DELAY = 57366
+const COUNTING = 57367
+const AFTER = 57368
+const OVER = 57369
+const ROWS = 57370
+const PRECEDING = 57371
+const FOLLOWING = 57372
+const UNBOUNDED = 57373
+const CURRENT = 57374
+const ROW = 57375
+const GROUPING = 57376
+const SETS = 57377
+const PIVOT = 57378
+const UNPIVOT = 57379
+const ALL = 57380
+const DISTINCT = 57381
+const AS = 57382
+const EXISTS = 57383
+const ASC = 57384
+const DESC = 57385
+const INTO = 57386
+const DUPLICATE = 57387
+const KEY = 57388
+const DEFAULT = 57389
+const SET = 57390
+const LOCK = 57391
+const UNLOCK = 57392
+const KEYS = 57393
+const VALUES = 57394
+const LAST_INSERT_ID = 57395
+const NEXT = 57396
+const VALUE = 57397
+const SHARE = 57398
+const MODE = 57399
+const SQL_NO_CACHE = 57400
+const SQL_CACHE = 57401
+const JOIN = 57402
+const STRAIGHT_JOIN = 57403
+const LOOKUP = 57404
+const LEFT = 57405
+const RIGHT = 57406
+const INNER = 57407
+const OUTER = 57408
+const CROSS = 57409
+const NATURAL = 57410
+const USE = 57411
+const FORCE = 57412
+const ON = 57413
+const USING = 57414
+const ID = 57415
+const HEX = 57416
+const STRING = 57417
+const INTEGRAL = 57418
+const FLOAT = 57419
+const HEXNUM = 57420
+const VALUE_ARG = 57421
+const LIST_ARG = 57422
+const COMMENT = 57423
+const COMMENT_KEYWORD = 57424
+const BIT_LITERAL = 57425
+const LIST_TYPE = 57426
+const OBJECT_TYPE = 57427
+const NULL = 57428
+const TRUE = 57429
+const FALSE = 57430
+const OFF = 57431
+const OR = 57432
+const AND = 57433
+const NOT = 57434
+const BETWEEN = 57435
+const CASE = 57436
+const WHEN = 57437
+const THEN = 57438
+const ELSE = 57439
+const END = 57440
+const OF = 57441
+const LE = 57442
+const GE = 57443
+const NE = 57444
+const NULL_SAFE_EQUAL = 57445
+const IS = 57446
+const LIKE = 57447
+const REGEXP = 57448
+const IN = 57449
+const RIGHTARROW = 57450
+const SHIFT_LEFT = 57451
+const SHIFT_RIGHT = 57452
+const DIV = 57453
+const MOD = 57454
+const AT = 57455
+const NOT_LIKE_REGEXP = 57456
+const LIKE_REGEXP_CASE_INSENSITIVE = 57457
+const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57458
+const UNARY = 57459
+const COLLATE = 57460
+const BINARY = 57461
+const UNDERSCORE_BINARY = 57462
+const UNDERSCORE_UTF8MB4 = 57463
+const INTERVAL = 57464
+const JSON_EXPLODE_OP = 57465
+const JSON_EXTRACT_OP = 57466
+const JSON_UNQUOTE_EXTRACT_OP = 57467
+const CREATE = 57468
+const ALTER = 57469
+const DROP = 57470
+const RENAME = 57471
+const ANALYZE = 57472
+const ADD = 57473
+const FLUSH = 57474
+const SCHEMA = 57475
+const TABLE = 57476
+const DESCRIPTOR = 57477
+const INDEX = 57478
+const VIEW = 57479
+const TO = 57480
+const IGNORE = 57481
+const IF = 57482
+const UNIQUE = 57483
+const PRIMARY = 57484
+const COLUMN = 57485
+const SPATIAL = 57486
+const FULLTEXT = 57487
+const KEY_BLOCK_SIZE = 57488
+const ACTION = 57489
+const CASCADE = 57490
+const CONSTRAINT = 57491
+const FOREIGN = 57492
+const NO = 57493
+const REFERENCES = 57494
+const RESTRICT = 57495
+const SHOW = 57496
+const DESCRIBE = 57497
+const EXPLAIN = 57498
+const DATE = 57499
+const ESCAPE = 57500
+const REPAIR = 57501
+const OPTIMIZE = 57502
+const TRUNCATE = 57503
+const MAXVALUE = 57504
+const PARTITION = 57505
+const REORGANIZE = 57506
+const LESS = 57507
+const THAN = 57508
+const PROCEDURE = 57509
+const TRIGGER = 57510
+const VINDEX = 57511
+const VINDEXES = 57512
+const STATUS = 57513
+const VARIABLES = 57514
+const WARNINGS = 57515
+const BEGIN = 57516
+const START = 57517
+const TRANSACTION = 57518
+const COMMIT = 57519
+const ROLLBACK = 57520
+const BIT = 57521
+const TINYINT = 57522
+const SMALLINT = 57523
+const MEDIUMINT = 57524
+const INT = 57525
+const INTEGER = 57526
+const BIGINT = 57527
+const INTNUM = 57528
+const REAL = 57529
+const DOUBLE = 57530
+const FLOAT_TYPE = 57531
+const DECIMAL = 57532
+const NUMERIC = 57533
+const TIME = 57534
+const TIMESTAMP = 57535
+const DATETIME = 57536
+const YEAR = 57537
+const CHAR = 57538
+const VARCHAR = 57539
+const BOOL = 57540
+const CHARACTER = 57541
+const VARBINARY = 57542
+const NCHAR = 57543
+const TEXT = 57544
+const TINYTEXT = 57545
+const MEDIUMTEXT = 57546
+const LONGTEXT = 57547
+const BLOB = 57548
+const TINYBLOB = 57549
+const MEDIUMBLOB = 57550
+const LONGBLOB = 57551
+const JSON = 57552
+const ENUM = 57553
+const GEOMETRY = 57554
+const POINT = 57555
+const LINESTRING = 57556
+const POLYGON = 57557
+const GEOMETRYCOLLECTION = 57558
+const MULTIPOINT = 57559
+const MULTILINESTRING = 57560
+const MULTIPOLYGON = 57561
+const NULLX = 57562
+const AUTO_INCREMENT = 57563
+const APPROXNUM = 57564
+const SIGNED = 57565
+const UNSIGNED = 57566
+const ZEROFILL = 57567
+const COLLATION = 57568
+const DATABASES = 57569
+const SCHEMAS = 57570
+const TABLES = 57571
+const VITESS_KEYSPACES = 57572
+const VITESS_SHARDS = 57573
+const VITESS_TABLETS = 57574
+const VSCHEMA = 57575
+const VSCHEMA_TABLES = 57576
+const VITESS_TARGET = 57577
+const FULL = 57578
+const PROCESSLIST = 57579
+const COLUMNS = 57580
+const FIELDS = 57581
+const ENGINES = 57582
+const PLUGINS = 57583
+const NAMES = 57584
+const CHARSET = 57585
+const GLOBAL = 57586
+const SESSION = 57587
+const ISOLATION = 57588
+const LEVEL = 57589
+const READ = 57590
+const WRITE = 57591
+const ONLY = 57592
+const REPEATABLE = 57593
+const COMMITTED = 57594
+const UNCOMMITTED = 57595
+const SERIALIZABLE = 57596
+const CURRENT_TIMESTAMP = 57597
+const DATABASE = 57598
+const CURRENT_DATE = 57599
+const CURRENT_TIME = 57600
+const LOCALTIME = 57601
+const LOCALTIMESTAMP = 57602
+const UTC_DATE = 57603
+const UTC_TIME = 57604
+const UTC_TIMESTAMP = 57605
+const REPLACE = 57606
+const CONVERT = 57607
+const CAST = 57608
+const SUBSTR = 57609
+const SUBSTRING = 57610
+const GROUP_CONCAT = 57611
+const SEPARATOR = 57612
+const TIMESTAMPADD = 57613
+const TIMESTAMPDIFF = 57614
+const EXTRACT = 57615
+const ZONE = 57616
+const MATCH = 57617
+const AGAINST = 57618
+const BOOLEAN = 57619
+const LANGUAGE = 57620
+const WITH = 57621
+const QUERY = 57622
+const EXPANSION = 57623
+const RECURSIVE = 57624
+const UNUSED = 57625
 
 var yyToknames = [...]string{
 	"$end",
 	"error",
 	"$unk",
 	"LEX_ERROR",
+	"STAR_MODIFIER",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -402,6 +437,17 @@ var yyToknames = [...]string{
 	"DELAY",
 	"COUNTING",
 	"AFTER",
+	"OVER",
+	"ROWS",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"CURRENT",
+	"ROW",
+	"GROUPING",
+	"SETS",
+	"PIVOT",
+	"UNPIVOT",
 	"ALL",
 	"DISTINCT",
 	"AS",
@@ -492,6 +538,7 @@ var yyToknames = [...]string{
 	"'%'",
 	"MOD",
 	"'^'",
+	"AT",
 	"'~'",
 	"NOT_LIKE_REGEXP",
 	"LIKE_REGEXP_CASE_INSENSITIVE",
@@ -653,6 +700,8 @@ var yyToknames = [...]string{
 	"SEPARATOR",
 	"TIMESTAMPADD",
 	"TIMESTAMPDIFF",
+	"EXTRACT",
+	"ZONE",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -660,10 +709,14 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"RECURSIVE",
 	"UNUSED",
 	"';'",
 	"'['",
 	"']'",
+	"':'",
+	"'{'",
+	"'}'",
 }
 
 var yyStatenames = [...]string{}
@@ -673,1871 +726,2239 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 22,
+	6, 38,
+	7, 38,
+	8, 38,
+	-2, 632,
+	-1, 24,
+	6, 40,
+	7, 40,
+	8, 40,
+	-2, 632,
 	-1, 38,
-	173, 303,
-	174, 303,
-	-2, 293,
-	-1, 282,
-	124, 661,
+	188, 306,
+	189, 306,
+	-2, 296,
+	-1, 294,
+	139, 720,
+	-2, 716,
+	-1, 295,
+	139, 721,
+	-2, 717,
+	-1, 368,
+	104, 914,
+	-2, 71,
+	-1, 369,
+	104, 864,
+	-2, 72,
+	-1, 374,
+	104, 838,
+	-2, 682,
+	-1, 376,
+	104, 886,
+	-2, 684,
+	-1, 677,
+	60, 403,
+	65, 403,
+	67, 403,
+	-2, 363,
+	-1, 681,
+	1, 369,
+	6, 369,
+	7, 369,
+	8, 369,
+	10, 369,
+	15, 369,
+	16, 369,
+	17, 369,
+	18, 369,
+	20, 369,
+	22, 369,
+	36, 369,
+	37, 369,
+	48, 369,
+	49, 369,
+	60, 369,
+	61, 369,
+	62, 369,
+	63, 369,
+	64, 369,
+	65, 369,
+	66, 369,
+	67, 369,
+	68, 369,
+	71, 369,
+	72, 369,
+	74, 369,
+	75, 369,
+	185, 369,
+	301, 369,
+	-2, 398,
+	-1, 685,
+	72, 52,
+	74, 52,
+	-2, 56,
+	-1, 833,
+	139, 723,
+	-2, 719,
+	-1, 1101,
+	6, 39,
+	7, 39,
+	8, 39,
+	-2, 475,
+	-1, 1139,
+	60, 403,
+	65, 403,
+	67, 403,
+	-2, 364,
+	-1, 1393,
+	6, 39,
+	7, 39,
+	8, 39,
 	-2, 657,
-	-1, 283,
-	124, 662,
-	-2, 658,
-	-1, 351,
-	90, 842,
-	-2, 68,
-	-1, 352,
-	90, 797,
-	-2, 69,
-	-1, 357,
-	90, 773,
-	-2, 623,
-	-1, 359,
-	90, 818,
-	-2, 625,
-	-1, 635,
-	46, 388,
-	51, 388,
-	53, 388,
-	-2, 350,
-	-1, 639,
-	1, 356,
-	7, 356,
-	12, 356,
-	13, 356,
-	14, 356,
-	15, 356,
-	17, 356,
-	19, 356,
-	34, 356,
-	35, 356,
-	46, 356,
-	47, 356,
-	48, 356,
-	49, 356,
-	50, 356,
-	51, 356,
-	52, 356,
-	53, 356,
-	54, 356,
-	57, 356,
-	58, 356,
-	60, 356,
-	61, 356,
-	170, 356,
-	283, 356,
-	-2, 383,
-	-1, 643,
-	58, 49,
-	60, 49,
-	-2, 53,
-	-1, 788,
-	124, 664,
+	-1, 1551,
+	6, 39,
+	7, 39,
+	8, 39,
 	-2, 660,
-	-1, 1025,
-	5, 35,
-	-2, 458,
-	-1, 1061,
-	46, 388,
-	51, 388,
-	53, 388,
-	-2, 351,
-	-1, 1289,
-	5, 35,
-	-2, 598,
-	-1, 1431,
-	5, 35,
-	-2, 601,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 14407
+const yyLast = 17565
 
-var yyAct = [...]int{
-	283, 1471, 1155, 1481, 1443, 1058, 286, 595, 1330, 909,
-	1362, 1260, 1082, 313, 1417, 1196, 1317, 299, 1234, 62,
-	905, 1197, 884, 1080, 66, 1213, 879, 635, 1193, 288,
-	1059, 881, 988, 208, 258, 1088, 938, 66, 918, 817,
-	66, 908, 58, 1109, 356, 821, 1203, 249, 739, 832,
-	594, 3, 636, 1016, 829, 752, 1135, 524, 1126, 922,
-	870, 656, 790, 850, 952, 517, 314, 52, 458, 655,
-	948, 345, 350, 541, 533, 270, 342, 347, 645, 25,
-	571, 609, 863, 57, 932, 25, 1474, 1449, 1469, 1429,
-	1465, 1261, 1448, 250, 251, 252, 253, 610, 1428, 256,
-	25, 1185, 1281, 257, 463, 1228, 61, 1053, 1229, 1230,
-	1097, 1054, 642, 1096, 900, 901, 1098, 1346, 657, 52,
-	658, 571, 571, 899, 559, 210, 255, 212, 254, 511,
-	569, 1117, 55, 549, 931, 556, 1320, 572, 55, 464,
-	939, 571, 573, 574, 575, 576, 577, 578, 579, 220,
-	550, 555, 548, 55, 558, 557, 567, 568, 560, 561,
-	562, 563, 564, 565, 566, 559, 551, 553, 552, 554,
-	209, 569, 569, 22, 248, 500, 501, 546, 572, 572,
-	562, 563, 564, 565, 566, 559, 218, 214, 510, 215,
-	216, 569, 1158, 1157, 66, 208, 726, 1423, 572, 66,
-	325, 66, 331, 332, 329, 330, 328, 327, 326, 1467,
-	728, 66, 1461, 1418, 66, 1154, 333, 334, 1410, 188,
-	66, 211, 831, 66, 476, 208, 864, 208, 208, 507,
-	208, 208, 571, 208, 923, 208, 488, 508, 505, 506,
-	274, 1489, 1371, 477, 208, 727, 190, 191, 192, 193,
-	194, 465, 1151, 266, 212, 1159, 732, 719, 1153, 925,
-	1083, 1085, 1223, 66, 1222, 558, 557, 567, 568, 560,
-	561, 562, 563, 564, 565, 566, 559, 208, 486, 1221,
-	461, 729, 569, 468, 925, 222, 213, 982, 1397, 572,
-	981, 487, 1292, 487, 487, 570, 487, 487, 1165, 487,
-	1485, 487, 513, 514, 1093, 1044, 344, 529, 1010, 217,
-	487, 460, 526, 462, 1110, 761, 1363, 530, 651, 545,
-	483, 1427, 1246, 469, 895, 906, 475, 540, 52, 1365,
-	528, 591, 482, 52, 1220, 484, 570, 570, 758, 197,
-	66, 66, 66, 466, 467, 925, 1084, 1408, 582, 208,
-	1463, 753, 23, 1372, 1370, 208, 570, 538, 23, 490,
-	265, 1380, 339, 340, 1152, 924, 1150, 990, 592, 527,
-	639, 1207, 634, 23, 540, 459, 276, 659, 198, 593,
-	1247, 597, 598, 599, 600, 601, 602, 603, 604, 605,
-	924, 608, 611, 611, 611, 617, 611, 611, 617, 611,
-	625, 626, 627, 628, 629, 630, 1364, 640, 612, 614,
-	616, 618, 620, 622, 623, 1455, 1483, 1187, 644, 1484,
-	851, 1482, 649, 721, 613, 615, 653, 619, 621, 797,
-	624, 353, 971, 492, 1490, 459, 494, 1115, 1413, 473,
-	754, 479, 480, 481, 795, 796, 794, 570, 1019, 535,
-	970, 924, 633, 989, 643, 760, 921, 919, 66, 920,
-	1029, 1435, 1028, 208, 917, 923, 491, 493, 66, 66,
-	208, 457, 764, 765, 66, 55, 1491, 66, 1030, 975,
-	66, 539, 538, 1456, 66, 793, 208, 531, 969, 1326,
-	208, 208, 208, 66, 208, 208, 851, 759, 1041, 540,
-	1325, 208, 208, 1130, 1129, 495, 496, 1118, 497, 498,
-	470, 499, 471, 502, 1437, 472, 539, 538, 1409, 1341,
-	539, 538, 512, 539, 538, 1323, 1162, 1189, 741, 487,
-	1127, 539, 538, 208, 540, 1406, 487, 66, 540, 928,
-	818, 540, 819, 208, 1263, 929, 966, 963, 964, 540,
-	962, 1110, 487, 733, 1105, 489, 487, 487, 487, 827,
-	487, 487, 791, 1099, 767, 1100, 738, 487, 487, 737,
-	667, 722, 823, 208, 720, 539, 538, 717, 792, 766,
-	723, 724, 973, 976, 485, 353, 730, 1368, 1466, 344,
-	788, 208, 736, 540, 786, 52, 780, 782, 783, 1007,
-	1008, 1009, 781, 1439, 516, 746, 478, 769, 516, 841,
-	844, 1368, 1421, 1368, 516, 852, 784, 1377, 968, 1368,
-	1398, 1368, 1367, 1315, 1314, 1376, 208, 208, 1294, 516,
-	1291, 516, 1243, 66, 1253, 1252, 520, 525, 1249, 1250,
-	967, 66, 1089, 66, 1249, 1248, 66, 66, 836, 776,
-	66, 66, 66, 208, 1023, 516, 647, 580, 1089, 52,
-	867, 516, 886, 926, 597, 59, 208, 834, 516, 866,
-	639, 666, 665, 1194, 1454, 639, 1206, 890, 1206, 639,
-	848, 892, 1168, 860, 972, 1142, 889, 834, 646, 1287,
-	867, 596, 1379, 647, 867, 867, 741, 1251, 1219, 974,
-	607, 1101, 898, 648, 1047, 650, 1206, 882, 883, 1046,
-	1023, 1023, 640, 267, 646, 1140, 640, 940, 941, 942,
-	66, 208, 893, 208, 897, 896, 888, 208, 208, 66,
-	66, 1023, 66, 66, 652, 913, 66, 208, 762, 731,
-	648, 718, 646, 262, 55, 865, 1450, 1332, 725, 933,
-	1302, 1239, 66, 1104, 66, 66, 953, 66, 949, 891,
-	934, 935, 936, 937, 742, 944, 55, 943, 743, 744,
-	745, 1156, 747, 748, 1446, 1445, 945, 946, 947, 749,
-	750, 954, 787, 855, 950, 951, 956, 487, 1476, 487,
-	1472, 1141, 1214, 1215, 1241, 1212, 1146, 1143, 1136, 1144,
-	1139, 1194, 1131, 487, 1137, 1138, 788, 756, 791, 1444,
-	997, 735, 775, 1074, 1217, 1216, 875, 876, 1145, 877,
-	998, 837, 838, 1063, 792, 843, 846, 847, 1064, 1071,
-	1065, 1069, 957, 1000, 1210, 1072, 1209, 1070, 1073, 271,
-	272, 979, 980, 1459, 983, 984, 1447, 1164, 985, 994,
-	859, 534, 861, 862, 1011, 1452, 1005, 1012, 1004, 518,
-	1285, 1122, 664, 873, 987, 1114, 532, 1415, 66, 993,
-	66, 66, 66, 1060, 1414, 519, 1344, 1112, 1106, 1328,
-	66, 521, 959, 66, 208, 734, 755, 880, 66, 353,
-	66, 268, 269, 1457, 263, 1061, 534, 639, 1067, 639,
-	639, 639, 910, 874, 872, 875, 876, 1087, 877, 208,
-	878, 259, 639, 1040, 1055, 777, 778, 516, 1102, 639,
-	1384, 1090, 1066, 260, 1068, 59, 1091, 1003, 1092, 1383,
-	1056, 1057, 1334, 836, 640, 1002, 640, 640, 640, 1075,
-	1089, 509, 1478, 1477, 187, 1035, 1034, 1032, 1031, 882,
-	571, 751, 1086, 536, 1478, 1394, 640, 208, 208, 1121,
-	1094, 1123, 1124, 1125, 1321, 757, 1111, 1468, 189, 56,
-	1, 1119, 1120, 1470, 596, 1262, 873, 839, 840, 1329,
-	1107, 1108, 965, 1416, 868, 1361, 208, 560, 561, 562,
-	563, 564, 565, 566, 559, 1233, 916, 1006, 787, 958,
-	569, 960, 66, 1128, 873, 907, 196, 572, 456, 1134,
-	195, 208, 1407, 915, 914, 986, 874, 872, 875, 876,
-	1147, 877, 1369, 878, 487, 1319, 1214, 1215, 927, 823,
-	1116, 823, 930, 1240, 1113, 1412, 904, 672, 670, 671,
-	669, 515, 674, 1161, 874, 872, 875, 876, 673, 877,
-	668, 878, 487, 1022, 233, 348, 660, 208, 208, 1195,
-	1060, 955, 537, 66, 199, 1149, 1171, 1172, 1198, 1179,
-	1148, 1038, 278, 1177, 1178, 961, 1180, 503, 504, 235,
-	581, 1186, 1001, 1095, 354, 1201, 1442, 208, 1422, 788,
-	763, 523, 639, 997, 1205, 1382, 1333, 1039, 606, 849,
-	287, 779, 208, 300, 208, 208, 297, 1200, 298, 770,
-	284, 1052, 547, 1232, 1166, 1208, 285, 279, 638, 631,
-	871, 1199, 869, 52, 1062, 343, 1227, 1211, 1298, 640,
-	1305, 1225, 66, 1224, 1078, 1079, 995, 996, 637, 525,
-	1244, 1245, 1167, 1231, 1236, 910, 1280, 1389, 774, 66,
-	27, 1237, 1238, 186, 273, 208, 19, 18, 208, 208,
-	66, 17, 20, 16, 15, 570, 208, 14, 474, 66,
-	31, 583, 584, 585, 586, 587, 588, 589, 590, 21,
-	13, 12, 11, 1255, 10, 9, 8, 7, 6, 5,
-	4, 60, 261, 264, 1267, 1256, 24, 1258, 639, 2,
-	1269, 0, 0, 1268, 0, 0, 0, 0, 0, 0,
-	0, 1024, 0, 1060, 0, 0, 0, 0, 0, 0,
-	208, 0, 0, 0, 1286, 0, 0, 0, 1042, 0,
-	312, 0, 208, 0, 1299, 640, 1133, 1296, 0, 0,
-	208, 1102, 0, 1304, 1254, 1303, 0, 1170, 1295, 0,
-	0, 0, 1279, 0, 0, 208, 0, 0, 0, 0,
-	1313, 1257, 208, 206, 1160, 0, 0, 0, 0, 0,
-	0, 0, 1266, 0, 0, 0, 0, 0, 0, 0,
-	0, 1190, 0, 0, 0, 1322, 0, 1324, 1309, 1310,
-	1311, 0, 208, 208, 0, 208, 0, 0, 0, 0,
-	0, 1198, 0, 208, 66, 0, 0, 0, 0, 1345,
-	208, 208, 208, 66, 768, 0, 208, 0, 0, 1353,
-	1316, 487, 0, 0, 0, 0, 1357, 1358, 1359, 0,
-	1352, 0, 886, 208, 1366, 1360, 0, 0, 910, 1373,
-	910, 1347, 0, 1374, 0, 1375, 0, 0, 0, 1381,
-	0, 0, 0, 0, 1199, 0, 66, 1348, 0, 0,
-	1198, 0, 1395, 1400, 0, 0, 0, 1163, 0, 208,
-	0, 0, 1405, 1404, 1355, 1356, 0, 0, 833, 835,
-	208, 208, 0, 1399, 0, 639, 0, 0, 1419, 0,
-	1420, 0, 1425, 0, 0, 1378, 0, 0, 208, 1396,
-	1430, 1060, 1170, 0, 0, 0, 0, 0, 0, 0,
-	0, 66, 0, 1199, 0, 52, 0, 0, 1188, 208,
-	0, 0, 640, 0, 1441, 355, 0, 0, 0, 789,
-	0, 0, 798, 799, 800, 801, 802, 803, 804, 805,
-	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
-	816, 208, 820, 1453, 1451, 355, 0, 355, 355, 1462,
-	355, 355, 0, 355, 0, 355, 1226, 1460, 910, 0,
-	0, 0, 1475, 0, 355, 278, 0, 0, 0, 0,
-	278, 278, 1486, 0, 278, 278, 278, 0, 0, 0,
-	0, 0, 0, 856, 0, 0, 0, 0, 1331, 0,
-	0, 0, 0, 0, 571, 0, 0, 543, 0, 278,
-	278, 278, 278, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1436, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1327, 1473, 1390, 0, 558, 557, 567,
-	568, 560, 561, 562, 563, 564, 565, 566, 559, 0,
-	999, 0, 0, 0, 569, 0, 0, 0, 0, 0,
-	0, 572, 0, 0, 0, 0, 1282, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 596, 0, 0, 355,
-	0, 0, 0, 0, 1297, 661, 0, 0, 0, 1300,
-	0, 1301, 0, 0, 0, 0, 0, 1306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1020, 0, 1021, 0, 0, 1331, 910, 0, 0,
-	1025, 1026, 1027, 0, 0, 0, 0, 1033, 0, 0,
-	1036, 1037, 0, 0, 0, 0, 1043, 0, 0, 1284,
-	1045, 0, 0, 1048, 1049, 1050, 1051, 0, 571, 0,
-	0, 0, 0, 0, 0, 0, 278, 0, 0, 0,
-	0, 0, 0, 0, 0, 1077, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1013, 1014, 1015,
-	0, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 355, 0, 0, 0, 0, 569, 0,
-	355, 0, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 278, 0, 1391, 0, 355, 0, 0, 570,
-	355, 355, 355, 0, 355, 355, 0, 0, 0, 0,
-	278, 355, 355, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 25, 26, 53, 28, 29, 0, 0, 0, 0,
-	1424, 596, 0, 771, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 543, 44, 522, 355, 0, 0, 30,
-	49, 50, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 63,
-	39, 0, 516, 826, 55, 0, 1176, 0, 0, 0,
-	571, 0, 221, 0, 0, 247, 0, 0, 0, 0,
-	0, 828, 0, 1458, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1464, 0, 0, 0, 0, 853,
-	0, 0, 0, 558, 557, 567, 568, 560, 561, 562,
-	563, 564, 565, 566, 559, 0, 857, 858, 0, 0,
-	569, 0, 1218, 570, 0, 0, 0, 572, 0, 0,
-	0, 0, 0, 32, 33, 35, 34, 37, 0, 51,
-	0, 0, 0, 355, 0, 0, 0, 0, 1283, 0,
-	0, 0, 0, 278, 0, 0, 355, 571, 0, 1174,
-	1175, 38, 45, 46, 0, 278, 47, 48, 36, 0,
-	0, 0, 0, 1181, 1182, 0, 1183, 1184, 0, 0,
-	0, 40, 41, 0, 42, 43, 0, 0, 1191, 1192,
-	558, 557, 567, 568, 560, 561, 562, 563, 564, 565,
-	566, 559, 0, 0, 0, 0, 0, 569, 0, 0,
-	0, 355, 0, 355, 572, 1270, 0, 977, 978, 0,
-	0, 0, 1272, 1273, 1274, 0, 277, 355, 0, 346,
-	0, 0, 0, 0, 221, 0, 221, 0, 0, 0,
-	0, 0, 0, 1288, 1289, 1290, 221, 1293, 0, 221,
-	0, 0, 355, 0, 0, 221, 1242, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 571, 0, 1312, 0,
-	0, 54, 0, 0, 0, 0, 0, 0, 549, 0,
-	556, 0, 0, 0, 23, 570, 0, 573, 574, 575,
-	576, 577, 578, 579, 0, 550, 555, 548, 63, 558,
-	557, 567, 568, 560, 561, 562, 563, 564, 565, 566,
-	559, 551, 553, 552, 554, 1271, 569, 0, 0, 0,
-	0, 0, 0, 572, 1340, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 853, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 570, 0, 1081, 221, 221, 221, 1278, 1385,
-	1386, 1387, 1388, 0, 0, 0, 1392, 1393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
-	0, 1401, 1402, 1403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1335, 1336, 1337,
-	1338, 1339, 0, 0, 0, 1342, 1343, 0, 571, 0,
-	0, 0, 0, 1426, 0, 0, 0, 0, 0, 0,
-	1431, 0, 0, 1433, 1434, 0, 0, 1132, 355, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1438, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 0, 0, 1277, 355, 0, 569, 0,
-	0, 570, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
-	0, 355, 0, 221, 221, 0, 0, 0, 0, 221,
-	0, 0, 221, 0, 0, 221, 0, 0, 0, 740,
-	0, 0, 0, 1487, 1488, 571, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 355, 0, 0, 0, 0,
-	0, 0, 0, 0, 853, 0, 0, 1202, 1204, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 557,
-	567, 568, 560, 561, 562, 563, 564, 565, 566, 559,
-	0, 0, 221, 0, 0, 569, 0, 1204, 0, 0,
-	0, 740, 572, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 355, 0, 355, 1235, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1479, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	0, 0, 0, 0, 277, 277, 0, 0, 277, 277,
-	277, 0, 0, 570, 854, 1259, 0, 0, 1264, 1265,
-	0, 0, 0, 0, 0, 0, 355, 0, 0, 0,
-	0, 0, 0, 277, 277, 277, 277, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 221, 571, 63, 0,
-	0, 221, 221, 0, 0, 221, 894, 740, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 853, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1081, 557, 567, 568, 560, 561, 562, 563, 564, 565,
-	566, 559, 355, 0, 0, 0, 0, 569, 1276, 0,
-	1318, 0, 0, 0, 572, 0, 0, 0, 0, 0,
-	570, 0, 0, 0, 0, 355, 0, 0, 0, 0,
-	0, 0, 355, 0, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 221, 0, 221, 221, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 571, 0,
-	0, 0, 1349, 1350, 0, 1351, 0, 221, 0, 991,
-	992, 0, 221, 1318, 0, 689, 0, 740, 0, 0,
-	1318, 1318, 1318, 0, 0, 0, 1235, 0, 0, 0,
-	277, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 1318, 0, 0, 0, 0, 569, 0,
-	0, 0, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 853, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1411,
-	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
-	355, 355, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 677, 0, 0, 277, 853, 0, 0, 1432, 0,
-	0, 0, 570, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 854, 221, 0, 221, 221, 221, 0, 1440,
-	0, 0, 1275, 0, 0, 1076, 0, 0, 221, 0,
-	690, 0, 0, 63, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1318, 703, 706, 707, 708, 709, 710, 711, 0,
-	712, 713, 714, 715, 716, 691, 692, 693, 694, 675,
-	676, 704, 571, 678, 0, 679, 680, 681, 682, 683,
-	684, 685, 686, 687, 688, 695, 696, 697, 698, 699,
-	700, 701, 702, 0, 0, 0, 571, 0, 0, 0,
-	0, 0, 0, 570, 0, 558, 557, 567, 568, 560,
-	561, 562, 563, 564, 565, 566, 559, 0, 0, 0,
-	0, 0, 569, 0, 0, 0, 0, 0, 0, 572,
-	0, 567, 568, 560, 561, 562, 563, 564, 565, 566,
-	559, 571, 0, 0, 0, 0, 569, 221, 705, 0,
-	0, 0, 1173, 572, 0, 0, 0, 277, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	0, 0, 0, 0, 558, 557, 567, 568, 560, 561,
-	562, 563, 564, 565, 566, 559, 0, 0, 0, 0,
-	740, 569, 0, 0, 0, 0, 0, 0, 572, 854,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 542, 0,
-	0, 0, 0, 91, 0, 0, 0, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 544, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 539, 538, 0, 0, 0, 0, 221, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 570, 0, 540,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
-	0, 570, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 854, 143, 131, 165, 570, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 1354,
-	571, 0, 0, 0, 0, 0, 0, 0, 63, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 68, 75,
-	110, 0, 138, 95, 168, 0, 0, 0, 0, 571,
-	0, 0, 0, 558, 557, 567, 568, 560, 561, 562,
-	563, 564, 565, 566, 559, 0, 0, 0, 0, 0,
-	569, 221, 854, 0, 0, 0, 0, 572, 0, 0,
-	0, 0, 558, 557, 567, 568, 560, 561, 562, 563,
-	564, 565, 566, 559, 0, 0, 0, 0, 0, 569,
-	0, 0, 0, 0, 0, 0, 572, 0, 0, 0,
-	854, 1017, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 443, 431, 221, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 207, 0, 911, 912, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 1103,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 570, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 570, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 911, 912, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	443, 431, 0, 402, 446, 381, 394, 454, 395, 396,
-	424, 367, 410, 129, 392, 182, 89, 85, 67, 0,
-	384, 362, 389, 363, 382, 404, 91, 407, 380, 433,
-	413, 445, 109, 452, 111, 418, 0, 150, 120, 0,
-	0, 406, 435, 0, 408, 429, 401, 425, 372, 417,
-	447, 393, 422, 448, 55, 0, 0, 207, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	420, 442, 391, 421, 423, 361, 419, 0, 365, 368,
-	453, 437, 387, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 405, 409, 426, 399, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 385, 0, 416, 0, 0,
-	0, 0, 0, 0, 369, 366, 0, 0, 403, 0,
-	0, 0, 0, 371, 0, 386, 427, 0, 360, 98,
-	430, 436, 0, 400, 172, 440, 398, 397, 444, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 434, 383, 390, 86, 388, 143, 131, 165, 415,
-	132, 142, 112, 158, 137, 441, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 364, 0,
-	151, 167, 185, 80, 379, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 375, 378, 373, 374, 411, 412, 449, 450,
-	451, 428, 370, 0, 376, 377, 0, 432, 438, 439,
-	414, 68, 75, 110, 455, 138, 95, 168, 443, 431,
-	0, 402, 446, 381, 394, 454, 395, 396, 424, 367,
-	410, 129, 392, 182, 89, 85, 67, 0, 384, 362,
-	389, 363, 382, 404, 91, 407, 380, 433, 413, 445,
-	109, 452, 111, 418, 0, 150, 120, 0, 0, 406,
-	435, 0, 408, 429, 401, 425, 372, 417, 447, 393,
-	422, 448, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 420, 442,
-	391, 421, 423, 361, 419, 0, 365, 368, 453, 437,
-	387, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	405, 409, 426, 399, 0, 0, 0, 0, 0, 0,
-	0, 1169, 0, 385, 0, 416, 0, 0, 0, 0,
-	0, 0, 369, 366, 0, 0, 403, 0, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 420, 442, 391, 421,
-	423, 361, 419, 0, 365, 368, 453, 437, 387, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 405, 409,
-	426, 399, 0, 0, 0, 0, 0, 0, 0, 895,
-	0, 385, 0, 416, 0, 0, 0, 0, 0, 0,
-	369, 366, 0, 0, 403, 0, 0, 0, 0, 371,
-	0, 386, 427, 0, 360, 98, 430, 436, 0, 400,
-	172, 440, 398, 397, 444, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 434, 383, 390,
-	86, 388, 143, 131, 165, 415, 132, 142, 112, 158,
-	137, 441, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 364, 0, 151, 167, 185, 80,
-	379, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 375, 378,
-	373, 374, 411, 412, 449, 450, 451, 428, 370, 0,
-	376, 377, 0, 432, 438, 439, 414, 68, 75, 110,
-	455, 138, 95, 168, 443, 431, 0, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 282, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 785, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	443, 431, 0, 402, 446, 381, 394, 454, 395, 396,
-	424, 367, 410, 129, 392, 182, 89, 85, 67, 0,
-	384, 362, 389, 363, 382, 404, 91, 407, 380, 433,
-	413, 445, 109, 452, 111, 418, 0, 150, 120, 0,
-	0, 406, 435, 0, 408, 429, 401, 425, 372, 417,
-	447, 393, 422, 448, 0, 0, 0, 282, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	420, 442, 391, 421, 423, 361, 419, 0, 365, 368,
-	453, 437, 387, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 405, 409, 426, 399, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 385, 0, 416, 0, 0,
-	0, 0, 0, 0, 369, 366, 0, 0, 403, 0,
-	0, 0, 0, 371, 0, 386, 427, 0, 360, 98,
-	430, 436, 0, 400, 172, 440, 398, 397, 444, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 434, 383, 390, 86, 388, 143, 131, 165, 415,
-	132, 142, 112, 158, 137, 441, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 364, 0,
-	151, 167, 185, 80, 379, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 375, 378, 373, 374, 411, 412, 449, 450,
-	451, 428, 370, 0, 376, 377, 0, 432, 438, 439,
-	414, 68, 75, 110, 455, 138, 95, 168, 443, 431,
-	0, 402, 446, 381, 394, 454, 395, 396, 424, 367,
-	410, 129, 392, 182, 89, 85, 67, 0, 384, 362,
-	389, 363, 382, 404, 91, 407, 380, 433, 413, 445,
-	109, 452, 111, 418, 0, 150, 120, 0, 0, 406,
-	435, 0, 408, 429, 401, 425, 372, 417, 447, 393,
-	422, 448, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 420, 442,
-	391, 421, 423, 361, 419, 0, 365, 368, 453, 437,
-	387, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	405, 409, 426, 399, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 385, 0, 416, 0, 0, 0, 0,
-	0, 0, 369, 366, 0, 0, 403, 0, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 358, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 359, 357, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 420, 442, 391, 421,
-	423, 361, 419, 0, 365, 368, 453, 437, 387, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 405, 409,
-	426, 399, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 385, 0, 416, 0, 0, 0, 0, 0, 0,
-	369, 366, 0, 0, 403, 0, 0, 0, 0, 371,
-	0, 386, 427, 0, 360, 98, 430, 436, 0, 400,
-	172, 440, 398, 397, 444, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 434, 383, 390,
-	86, 388, 143, 131, 165, 415, 132, 142, 112, 158,
-	137, 441, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 364, 0, 151, 167, 185, 80,
-	379, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 375, 378,
-	373, 374, 411, 412, 449, 450, 451, 428, 370, 0,
-	376, 377, 0, 432, 438, 439, 414, 68, 75, 110,
-	455, 138, 95, 168, 443, 431, 0, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 654, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	358, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 359, 357, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 349, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 358, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 359, 357, 352, 351, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 301,
-	0, 0, 0, 91, 0, 281, 0, 0, 0, 109,
-	324, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 315, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 55, 0, 0, 282, 303, 302, 305, 306, 307,
-	308, 0, 0, 82, 304, 0, 0, 309, 310, 311,
-	0, 0, 0, 280, 295, 0, 323, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
-	0, 0, 0, 0, 337, 0, 294, 0, 0, 0,
-	0, 0, 289, 290, 291, 296, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 1307, 1308,
-	0, 172, 0, 0, 335, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 325,
-	336, 331, 332, 329, 330, 328, 327, 326, 338, 317,
-	318, 319, 320, 322, 0, 333, 334, 321, 68, 75,
-	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 301, 0, 0, 0, 91, 0,
-	281, 0, 0, 0, 109, 324, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 315, 316, 0, 0,
-	0, 0, 0, 0, 902, 0, 55, 0, 0, 282,
-	303, 302, 305, 306, 307, 308, 0, 0, 82, 304,
-	0, 0, 309, 310, 311, 903, 0, 0, 280, 295,
-	0, 323, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 292, 293, 0, 0, 0, 0, 337,
-	0, 294, 0, 0, 0, 0, 0, 289, 290, 291,
-	296, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 335,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 325, 336, 331, 332, 329, 330,
-	328, 327, 326, 338, 317, 318, 319, 320, 322, 25,
-	333, 334, 321, 68, 75, 110, 0, 138, 95, 168,
-	0, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 23, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 830, 0, 301, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 280,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 275, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 516, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 301, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 280,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 275, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 845, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 275, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 301, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 842, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 280,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 275, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	301, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 280, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 0, 0, 0, 91,
-	0, 0, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 0,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 0, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 1480, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 516, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 0, 295, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
-	293, 0, 0, 0, 0, 337, 0, 294, 0, 0,
-	0, 0, 0, 289, 290, 291, 296, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 335, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	325, 336, 331, 332, 329, 330, 328, 327, 326, 338,
-	317, 318, 319, 320, 322, 0, 333, 334, 321, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 0, 0, 0, 91,
-	0, 0, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 0,
-	295, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 292, 293, 0, 0, 0, 0,
-	337, 0, 294, 0, 0, 0, 0, 0, 289, 290,
-	291, 296, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 571, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 558, 557, 567, 568, 560,
-	561, 562, 563, 564, 565, 566, 559, 0, 0, 0,
-	0, 0, 569, 0, 0, 0, 0, 0, 0, 572,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 68,
-	75, 110, 91, 138, 95, 168, 0, 570, 109, 0,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 571, 0,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 1018,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
-	201, 0, 0, 0, 0, 0, 0, 0, 0, 93,
-	128, 558, 557, 567, 568, 560, 561, 562, 563, 564,
-	565, 566, 559, 0, 0, 0, 0, 0, 569, 0,
-	0, 0, 0, 0, 0, 572, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 203, 204, 0, 0,
-	200, 0, 0, 0, 205, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 0, 202,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 25, 570, 0, 0, 0, 68, 75, 110,
-	0, 138, 95, 168, 129, 0, 182, 89, 85, 67,
-	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 0, 0, 109, 0, 111, 0, 0, 150, 120,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 55, 0, 0, 207, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 25, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 68, 75, 110, 23, 138, 95, 168, 91,
-	0, 0, 0, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	641, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 75, 110, 23, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 887,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 65, 0, 64, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 68,
-	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 822, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 824, 825, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
-	89, 85, 67, 0, 0, 887, 0, 68, 75, 110,
-	91, 138, 95, 168, 0, 0, 109, 0, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 65, 0, 64, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 885, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
-	0, 0, 772, 0, 0, 773, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
-	89, 85, 67, 68, 75, 110, 0, 138, 95, 168,
-	91, 0, 663, 0, 0, 0, 109, 0, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 207, 0, 662, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 55, 0, 0, 641,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 0, 68, 75, 110, 91, 138, 95, 168,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 65, 0, 64,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 0, 0, 0, 86, 0, 143, 131, 165, 0,
-	132, 142, 112, 158, 137, 0, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 0, 0,
-	151, 167, 185, 80, 0, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 68, 75, 110, 91, 138, 95, 168, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 544, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 68,
-	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 0, 0, 632, 91,
-	0, 0, 0, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	65, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 341, 0, 0,
-	0, 0, 0, 0, 129, 0, 182, 89, 85, 67,
-	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
-	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 65, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 0, 182, 89, 85, 67, 0, 0,
-	0, 0, 68, 75, 110, 91, 138, 95, 168, 0,
-	0, 109, 0, 111, 0, 0, 150, 120, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 65, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	219, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 68, 75,
-	110, 91, 138, 95, 168, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
+var yyAct = [...]int16{
+	295, 1636, 1625, 1607, 1571, 946, 1565, 1357, 1540, 1235,
+	1136, 1531, 637, 971, 920, 1435, 1473, 298, 1291, 1331,
+	1162, 329, 311, 1292, 68, 941, 1070, 677, 274, 818,
+	1160, 1137, 1000, 218, 1308, 1288, 980, 68, 1298, 59,
+	68, 967, 867, 570, 895, 970, 299, 300, 1189, 1168,
+	678, 862, 373, 886, 919, 943, 1084, 1215, 1050, 794,
+	1206, 636, 3, 68, 197, 684, 984, 781, 908, 994,
+	698, 930, 511, 948, 835, 630, 558, 1010, 282, 1014,
+	697, 367, 481, 359, 566, 576, 364, 923, 362, 687,
+	880, 882, 198, 881, 877, 260, 58, 64, 1629, 651,
+	1578, 1621, 231, 1549, 1611, 509, 1358, 1577, 1548, 652,
+	1279, 1090, 1384, 819, 486, 1326, 1327, 273, 1325, 200,
+	201, 202, 203, 204, 25, 63, 551, 229, 225, 277,
+	226, 227, 961, 52, 606, 606, 64, 962, 963, 266,
+	534, 261, 262, 263, 264, 606, 584, 267, 591, 606,
+	699, 265, 700, 1197, 25, 609, 610, 611, 612, 613,
+	614, 615, 24, 585, 590, 583, 22, 593, 592, 602,
+	603, 595, 596, 597, 598, 599, 600, 601, 594, 608,
+	586, 588, 587, 589, 993, 604, 604, 1425, 56, 594,
+	608, 581, 607, 607, 608, 1177, 604, 1455, 1176, 533,
+	604, 1178, 1001, 607, 68, 218, 530, 607, 25, 68,
+	874, 68, 259, 220, 531, 528, 529, 1238, 56, 487,
+	606, 221, 68, 223, 1237, 68, 281, 523, 524, 768,
+	1537, 68, 770, 1615, 68, 1602, 218, 1532, 218, 218,
+	1443, 218, 218, 1234, 218, 286, 218, 1131, 924, 1525,
+	228, 1132, 985, 1644, 500, 218, 223, 595, 596, 597,
+	598, 599, 600, 601, 594, 608, 488, 769, 1481, 361,
+	606, 604, 56, 1239, 483, 774, 485, 370, 607, 68,
+	1163, 1165, 499, 761, 1320, 1319, 1318, 492, 484, 218,
+	498, 771, 491, 233, 224, 1044, 505, 1100, 1043, 507,
+	1510, 1503, 56, 593, 592, 602, 603, 595, 596, 597,
+	598, 599, 600, 601, 594, 608, 218, 222, 987, 1474,
+	1396, 604, 879, 878, 1245, 1173, 627, 1121, 607, 1078,
+	987, 804, 1476, 693, 1547, 580, 506, 968, 957, 560,
+	1317, 1513, 1512, 518, 519, 801, 520, 521, 1190, 522,
+	482, 525, 64, 605, 605, 795, 606, 68, 68, 68,
+	535, 536, 537, 575, 605, 633, 218, 1164, 605, 1343,
+	1099, 342, 218, 348, 349, 346, 347, 345, 344, 343,
+	1482, 1480, 1640, 1052, 207, 1523, 480, 350, 351, 593,
+	592, 602, 603, 595, 596, 597, 598, 599, 600, 601,
+	594, 608, 538, 542, 561, 681, 1490, 604, 564, 562,
+	1475, 23, 513, 676, 607, 356, 357, 1302, 701, 1604,
+	288, 1281, 675, 208, 685, 986, 909, 606, 1344, 1195,
+	763, 540, 540, 489, 490, 539, 539, 986, 370, 605,
+	1586, 23, 686, 654, 656, 658, 660, 662, 664, 665,
+	691, 914, 695, 655, 657, 796, 661, 663, 1610, 666,
+	593, 592, 602, 603, 595, 596, 597, 598, 599, 600,
+	601, 594, 608, 1527, 1557, 987, 496, 909, 604, 1118,
+	1051, 68, 568, 1504, 573, 607, 218, 515, 1231, 605,
+	517, 68, 68, 218, 1233, 23, 606, 68, 1431, 1638,
+	68, 575, 1639, 68, 1637, 482, 1106, 68, 1105, 218,
+	502, 503, 504, 218, 218, 218, 68, 218, 218, 1587,
+	514, 516, 1645, 1430, 218, 218, 1210, 574, 573, 593,
+	592, 602, 603, 595, 596, 597, 598, 599, 600, 601,
+	594, 608, 574, 573, 1033, 575, 709, 604, 493, 1283,
+	494, 1559, 990, 495, 607, 1209, 765, 766, 991, 783,
+	575, 550, 772, 1198, 1646, 361, 218, 1524, 778, 842,
+	68, 574, 573, 1032, 1450, 605, 1087, 1088, 218, 574,
+	573, 788, 986, 775, 840, 841, 839, 983, 981, 575,
+	982, 760, 1428, 1242, 56, 979, 985, 575, 767, 606,
+	1207, 1232, 1037, 1230, 838, 836, 1521, 870, 218, 512,
+	863, 1031, 864, 1179, 784, 1180, 1573, 1574, 785, 786,
+	787, 1360, 789, 790, 809, 810, 1190, 218, 1185, 791,
+	792, 837, 875, 833, 799, 817, 1618, 550, 597, 598,
+	599, 600, 601, 594, 608, 780, 605, 1373, 831, 1107,
+	604, 218, 779, 832, 894, 896, 764, 607, 901, 904,
+	905, 899, 902, 829, 1575, 1251, 1614, 910, 218, 218,
+	1028, 1025, 1026, 762, 1024, 68, 807, 808, 1573, 1574,
+	887, 759, 890, 68, 918, 68, 921, 922, 68, 68,
+	1251, 550, 68, 68, 68, 218, 554, 559, 1073, 508,
+	893, 501, 574, 573, 1102, 550, 1035, 1038, 218, 1561,
+	550, 1251, 1535, 616, 550, 605, 1264, 1251, 1511, 1487,
+	575, 1486, 1251, 1478, 1340, 549, 1575, 574, 573, 803,
+	681, 906, 1421, 1420, 628, 681, 1398, 550, 1289, 681,
+	925, 1301, 1030, 952, 1572, 575, 783, 954, 1074, 1075,
+	1076, 628, 1395, 550, 953, 1002, 1003, 1004, 988, 824,
+	648, 1585, 68, 218, 1029, 218, 1169, 370, 1407, 218,
+	218, 68, 68, 950, 68, 68, 958, 959, 68, 218,
+	972, 955, 802, 1350, 1349, 1346, 1347, 996, 997, 998,
+	999, 975, 1346, 1345, 68, 1248, 68, 68, 933, 68,
+	1301, 574, 573, 1007, 1008, 1009, 927, 550, 1034, 826,
+	827, 828, 877, 550, 1169, 825, 708, 707, 605, 575,
+	1102, 218, 951, 877, 688, 927, 1036, 1019, 689, 60,
+	1068, 689, 1569, 1012, 1013, 1391, 1041, 1042, 1016, 1045,
+	1046, 1489, 927, 1047, 939, 940, 1348, 1316, 1181, 934,
+	932, 935, 936, 1222, 937, 1102, 938, 833, 1388, 1049,
+	960, 836, 541, 550, 1055, 1102, 926, 606, 1020, 1125,
+	1022, 1124, 1060, 1301, 1077, 1098, 688, 832, 1056, 1309,
+	1310, 218, 1061, 1220, 1048, 1102, 690, 837, 692, 690,
+	694, 688, 927, 805, 773, 278, 56, 1568, 1567, 1097,
+	593, 592, 602, 603, 595, 596, 597, 598, 599, 600,
+	601, 594, 608, 1581, 1080, 1437, 995, 1406, 604, 1336,
+	1184, 1115, 939, 940, 1015, 607, 56, 1011, 68, 1006,
+	68, 68, 68, 1005, 1236, 1018, 1631, 330, 55, 1626,
+	1338, 1289, 68, 1566, 1138, 68, 218, 1211, 798, 777,
+	68, 61, 68, 797, 1141, 1139, 1093, 1307, 1145, 1142,
+	1221, 1143, 816, 1312, 1149, 1226, 1223, 1216, 1224, 1219,
+	1150, 218, 1311, 1217, 1218, 681, 1117, 681, 681, 681,
+	1144, 1305, 1146, 1147, 1182, 1133, 1304, 1225, 1151, 1148,
+	283, 284, 681, 55, 821, 822, 1598, 1576, 1244, 681,
+	933, 567, 1171, 1057, 1172, 1152, 893, 1170, 935, 936,
+	1153, 937, 1583, 1067, 279, 1066, 565, 1202, 706, 218,
+	218, 1194, 1529, 1167, 1528, 1453, 1192, 1186, 552, 1199,
+	1200, 1174, 1389, 1191, 1021, 1433, 776, 942, 280, 933,
+	547, 548, 1201, 972, 1203, 1204, 1205, 567, 218, 1187,
+	1188, 934, 932, 935, 936, 553, 937, 1595, 938, 628,
+	545, 546, 897, 898, 68, 543, 544, 1208, 939, 940,
+	1570, 1596, 1597, 1593, 1594, 1544, 1266, 1588, 1408, 218,
+	275, 1497, 1494, 1227, 1249, 276, 605, 60, 891, 892,
+	934, 932, 935, 936, 1065, 937, 1493, 938, 1439, 1169,
+	1309, 1310, 1064, 532, 1633, 1632, 870, 1254, 870, 1241,
+	1253, 1122, 1112, 1111, 1109, 1108, 1072, 793, 569, 1214,
+	1633, 1507, 966, 1426, 800, 1213, 1616, 272, 571, 1246,
+	270, 271, 272, 199, 1257, 218, 218, 57, 1258, 1,
+	1624, 68, 1290, 887, 1359, 890, 1434, 1271, 1272, 1138,
+	1274, 1256, 1268, 1240, 1293, 1280, 218, 1027, 1273, 1530,
+	928, 1472, 1330, 978, 969, 206, 479, 218, 205, 1313,
+	1522, 977, 976, 510, 833, 510, 510, 1479, 510, 510,
+	1424, 510, 218, 510, 218, 218, 1300, 989, 681, 1060,
+	1196, 1303, 510, 992, 1284, 1337, 1295, 1329, 1193, 1526,
+	714, 712, 713, 711, 716, 1321, 55, 55, 1322, 715,
+	710, 244, 68, 365, 702, 55, 1017, 572, 563, 209,
+	1324, 1229, 1333, 1058, 1059, 1228, 559, 1328, 1023, 68,
+	526, 618, 527, 246, 617, 218, 1334, 1335, 218, 218,
+	68, 1341, 1342, 1063, 1175, 371, 218, 1296, 1564, 68,
+	1536, 806, 218, 1543, 972, 634, 972, 1442, 1441, 557,
+	1492, 1606, 1062, 1539, 1438, 1116, 635, 647, 638, 639,
+	640, 641, 642, 643, 644, 645, 646, 1351, 649, 650,
+	653, 653, 653, 659, 653, 653, 659, 653, 667, 668,
+	669, 670, 671, 672, 1354, 682, 681, 1365, 1092, 1370,
+	1364, 907, 1094, 1095, 1366, 1363, 629, 823, 312, 309,
+	310, 1352, 811, 296, 1130, 582, 297, 218, 1256, 1138,
+	291, 680, 673, 1353, 931, 1355, 1390, 1091, 929, 218,
+	1119, 1140, 360, 1400, 1306, 1096, 1402, 218, 1403, 1411,
+	1399, 1409, 1182, 1158, 1159, 1101, 1103, 1410, 1104, 679,
+	1247, 1419, 218, 1110, 888, 883, 1113, 1114, 885, 218,
+	1383, 1502, 1120, 1423, 815, 27, 269, 1123, 268, 285,
+	1126, 1127, 19, 1128, 1129, 18, 17, 20, 1427, 16,
+	1429, 15, 14, 497, 31, 21, 13, 12, 11, 10,
+	9, 8, 7, 6, 5, 1157, 218, 218, 4, 218,
+	1440, 972, 1422, 2, 0, 0, 0, 218, 0, 218,
+	68, 0, 0, 1293, 0, 1454, 218, 218, 218, 68,
+	0, 0, 218, 510, 1471, 1463, 0, 0, 1461, 0,
+	510, 1436, 0, 1462, 0, 556, 0, 0, 218, 0,
+	0, 1477, 1468, 1469, 1470, 0, 510, 0, 0, 1483,
+	510, 510, 510, 0, 510, 510, 1456, 1432, 0, 65,
+	0, 510, 510, 0, 1491, 0, 0, 68, 0, 1484,
+	0, 1485, 232, 1243, 1508, 258, 0, 1293, 0, 0,
+	0, 0, 1496, 0, 0, 1515, 0, 0, 0, 1520,
+	218, 218, 1519, 0, 1514, 0, 0, 0, 65, 0,
+	55, 55, 0, 0, 1534, 1533, 0, 0, 0, 820,
+	1545, 218, 0, 0, 681, 0, 0, 0, 1550, 1509,
+	1269, 1270, 1250, 0, 0, 1138, 68, 0, 0, 0,
+	0, 0, 0, 218, 0, 0, 0, 0, 0, 1282,
+	0, 0, 0, 1285, 0, 1563, 0, 0, 0, 1267,
+	0, 0, 0, 0, 0, 218, 0, 0, 606, 0,
+	0, 0, 1436, 972, 0, 0, 0, 0, 1579, 0,
+	0, 1584, 55, 1582, 0, 0, 638, 1592, 1590, 0,
+	0, 1580, 218, 0, 0, 0, 0, 0, 0, 0,
+	1323, 1558, 1603, 602, 603, 595, 596, 597, 598, 599,
+	600, 601, 594, 608, 0, 0, 0, 0, 1601, 604,
+	1315, 0, 0, 68, 68, 1620, 607, 0, 1622, 1623,
+	944, 945, 1628, 0, 0, 682, 0, 1630, 0, 682,
+	550, 0, 0, 0, 0, 1641, 289, 0, 606, 363,
+	0, 0, 0, 0, 232, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	232, 0, 0, 0, 0, 0, 232, 0, 0, 232,
+	0, 593, 592, 602, 603, 595, 596, 597, 598, 599,
+	600, 601, 594, 608, 0, 0, 0, 0, 0, 604,
+	0, 0, 0, 0, 0, 0, 607, 0, 0, 0,
+	510, 1367, 510, 0, 1385, 0, 0, 0, 0, 1371,
+	0, 0, 0, 0, 65, 628, 510, 0, 0, 0,
+	0, 0, 0, 1401, 1375, 1376, 1377, 0, 1404, 0,
+	1405, 0, 0, 0, 0, 0, 0, 1386, 1412, 0,
+	0, 0, 0, 0, 0, 0, 1392, 1393, 1394, 0,
+	1397, 0, 0, 0, 0, 0, 0, 1069, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1079, 0, 0, 1418, 606, 0, 0, 605, 0, 0,
+	0, 0, 0, 0, 0, 0, 584, 0, 591, 0,
+	0, 0, 232, 232, 232, 609, 610, 611, 612, 613,
+	614, 615, 0, 585, 590, 583, 0, 593, 592, 602,
+	603, 595, 596, 597, 598, 599, 600, 601, 594, 608,
+	586, 588, 587, 589, 0, 604, 0, 635, 0, 0,
+	0, 0, 607, 0, 0, 1449, 0, 0, 0, 555,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 605, 0, 0,
+	0, 1134, 1135, 0, 0, 682, 0, 682, 682, 682,
+	0, 0, 0, 0, 0, 0, 0, 1154, 1155, 0,
+	0, 0, 944, 0, 0, 1166, 0, 0, 0, 682,
+	0, 0, 0, 1495, 0, 0, 1498, 1499, 1500, 1501,
+	0, 0, 0, 1505, 1506, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1538, 1541, 232, 0, 628, 0,
+	1516, 1517, 1518, 0, 0, 0, 232, 232, 0, 0,
+	0, 0, 232, 0, 0, 232, 0, 0, 232, 0,
+	0, 0, 782, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 1546, 0, 0, 510, 0, 0,
+	0, 1551, 0, 0, 0, 0, 1555, 1556, 0, 0,
+	0, 0, 0, 0, 0, 1387, 0, 0, 0, 0,
+	0, 0, 1560, 0, 606, 510, 0, 0, 0, 1589,
+	1541, 0, 0, 605, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 1605,
+	0, 0, 1608, 0, 0, 0, 782, 593, 592, 602,
+	603, 595, 596, 597, 598, 599, 600, 601, 594, 608,
+	628, 1599, 1600, 0, 0, 604, 0, 0, 0, 1608,
+	290, 0, 607, 0, 0, 0, 0, 0, 0, 0,
+	0, 1612, 1613, 0, 0, 1381, 0, 0, 1617, 0,
+	0, 1619, 0, 0, 0, 0, 0, 0, 0, 0,
+	1294, 289, 55, 0, 0, 289, 289, 0, 682, 289,
+	289, 289, 0, 0, 0, 912, 1642, 1643, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 289, 289, 289, 0,
+	232, 0, 0, 0, 0, 0, 606, 0, 232, 0,
+	65, 0, 0, 232, 232, 0, 0, 232, 956, 782,
+	25, 26, 53, 28, 29, 0, 0, 0, 0, 0,
+	0, 619, 620, 621, 622, 623, 624, 625, 626, 593,
+	592, 602, 603, 595, 596, 597, 598, 599, 600, 601,
+	594, 608, 0, 0, 44, 0, 0, 604, 0, 30,
+	49, 50, 0, 0, 607, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 682, 0, 0, 0,
+	39, 0, 0, 0, 56, 0, 0, 232, 0, 0,
+	0, 0, 0, 605, 1374, 0, 232, 232, 0, 232,
+	232, 0, 0, 232, 0, 0, 0, 1382, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 1053, 1054, 0, 232, 0, 0, 606, 0, 0,
+	782, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 0, 0, 0, 0, 1415, 1416, 1417, 0,
+	0, 0, 0, 0, 32, 33, 35, 34, 37, 0,
+	51, 592, 602, 603, 595, 596, 597, 598, 599, 600,
+	601, 594, 608, 0, 0, 0, 0, 0, 604, 510,
+	0, 0, 38, 45, 46, 607, 0, 47, 48, 36,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 40, 41, 0, 42, 43, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 1294,
+	0, 0, 1457, 0, 0, 605, 0, 0, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 1466, 1467, 0, 0, 328, 0, 0, 0, 0,
+	0, 0, 912, 232, 0, 232, 232, 232, 0, 0,
+	0, 1488, 0, 0, 0, 0, 0, 1156, 0, 0,
+	232, 0, 0, 0, 0, 65, 0, 232, 216, 0,
+	0, 0, 0, 1294, 0, 55, 0, 0, 0, 0,
+	0, 0, 54, 0, 682, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 23, 0, 0,
+	0, 0, 834, 0, 0, 843, 844, 845, 846, 847,
+	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
+	858, 859, 860, 861, 0, 865, 0, 0, 0, 0,
+	1553, 1554, 0, 0, 0, 731, 605, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 290, 0, 0, 0, 290,
+	290, 0, 0, 290, 290, 290, 0, 0, 0, 0,
+	0, 0, 0, 915, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 289, 0, 0, 1591, 290,
+	290, 290, 290, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1609,
+	0, 0, 0, 0, 289, 0, 0, 0, 289, 0,
+	0, 0, 719, 0, 0, 0, 0, 638, 0, 0,
+	0, 0, 0, 1627, 0, 0, 1609, 782, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 912, 0, 0,
+	0, 0, 0, 0, 0, 0, 232, 0, 0, 0,
+	0, 732, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 372, 0, 372, 372, 0, 372, 372, 0, 372,
+	0, 372, 0, 745, 748, 749, 750, 751, 752, 753,
+	372, 754, 755, 756, 757, 758, 733, 734, 735, 736,
+	717, 718, 746, 0, 720, 0, 721, 722, 723, 724,
+	725, 726, 727, 728, 729, 730, 737, 738, 739, 740,
+	741, 742, 743, 744, 578, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 1380, 290, 0, 0, 0, 0,
+	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 632, 0, 0, 0, 232, 0, 0, 0, 0,
+	1081, 1082, 1083, 0, 232, 0, 1379, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 747, 0, 0, 0, 0, 1089, 0, 0, 0,
+	0, 0, 0, 1378, 0, 606, 0, 0, 0, 0,
+	0, 372, 0, 0, 290, 0, 0, 703, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 912, 0, 0,
+	0, 0, 0, 0, 0, 0, 290, 606, 593, 592,
+	602, 603, 595, 596, 597, 598, 599, 600, 601, 594,
+	608, 0, 0, 0, 0, 0, 604, 0, 0, 0,
+	0, 0, 0, 607, 606, 0, 0, 0, 0, 0,
+	593, 592, 602, 603, 595, 596, 597, 598, 599, 600,
+	601, 594, 608, 0, 0, 0, 0, 0, 604, 0,
+	0, 0, 0, 0, 0, 607, 0, 593, 592, 602,
+	603, 595, 596, 597, 598, 599, 600, 601, 594, 608,
+	0, 0, 0, 0, 0, 604, 0, 0, 0, 0,
+	0, 0, 607, 0, 0, 0, 0, 0, 0, 0,
+	0, 372, 0, 912, 0, 1465, 0, 0, 372, 606,
+	0, 0, 0, 0, 65, 0, 0, 0, 0, 0,
+	1259, 0, 0, 0, 372, 0, 0, 0, 372, 372,
+	372, 0, 372, 372, 0, 0, 0, 0, 0, 372,
+	372, 0, 593, 592, 602, 603, 595, 596, 597, 598,
+	599, 600, 601, 594, 608, 0, 0, 0, 0, 0,
+	604, 0, 232, 912, 0, 0, 0, 607, 0, 290,
+	0, 0, 1252, 0, 0, 0, 0, 0, 0, 290,
+	0, 812, 0, 0, 0, 1260, 1261, 0, 1262, 0,
+	1265, 0, 0, 578, 605, 0, 372, 0, 290, 0,
+	0, 0, 290, 912, 0, 0, 0, 0, 1275, 1276,
+	0, 1277, 1278, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 873, 1286, 1287, 605, 0, 0, 0,
+	0, 0, 0, 0, 606, 0, 0, 0, 0, 0,
+	0, 0, 876, 0, 0, 0, 0, 0, 0, 289,
+	0, 889, 0, 605, 290, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 911, 913, 593, 592, 602,
+	603, 595, 596, 597, 598, 599, 600, 601, 594, 608,
+	0, 0, 0, 916, 917, 604, 0, 0, 0, 0,
+	0, 0, 607, 0, 1339, 0, 606, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1086, 65, 65,
+	372, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 372, 0, 241, 1085, 0, 605, 593,
+	592, 602, 603, 595, 596, 597, 598, 599, 600, 601,
+	594, 608, 0, 0, 0, 0, 0, 604, 0, 0,
+	254, 0, 0, 1369, 607, 0, 0, 0, 0, 1372,
+	0, 0, 0, 606, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	372, 0, 0, 0, 1039, 1040, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 593, 592, 602, 603,
+	595, 596, 597, 598, 599, 600, 601, 594, 608, 234,
+	0, 0, 0, 0, 604, 0, 0, 236, 0, 0,
+	372, 607, 0, 0, 0, 245, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 0, 1071, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 605, 0, 0, 0, 0, 243, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1444, 1445,
+	1446, 1447, 1448, 0, 0, 0, 0, 1451, 1452, 0,
+	0, 0, 0, 0, 0, 0, 632, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 605, 0, 247, 237, 238,
+	0, 248, 249, 250, 252, 0, 251, 257, 0, 0,
+	0, 239, 242, 0, 235, 256, 255, 0, 0, 0,
+	0, 0, 911, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 605, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1212, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 372, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 889, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1634, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 911, 0, 0,
+	1297, 1299, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1314, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 372, 0, 372,
+	1332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1356, 0, 0, 1361, 1362, 0, 0, 0, 0, 0,
+	0, 372, 0, 0, 0, 0, 0, 1368, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 911, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1161, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 0, 1071, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 372, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1458, 1459, 0, 1460, 0, 0, 0, 0, 0,
+	0, 0, 1071, 911, 1464, 0, 0, 0, 0, 0,
+	0, 1071, 1071, 1071, 0, 0, 0, 1332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1071, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 911, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 372, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 911, 0, 0, 1552, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1562, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1071, 0, 0, 0, 0, 0, 465, 407, 423, 453,
+	0, 422, 469, 399, 414, 477, 415, 416, 445, 385,
+	431, 134, 412, 192, 92, 87, 69, 1071, 154, 141,
+	103, 175, 88, 153, 108, 157, 447, 468, 0, 402,
+	379, 408, 380, 400, 425, 94, 428, 398, 455, 434,
+	467, 114, 475, 116, 439, 0, 159, 125, 0, 0,
+	427, 457, 0, 429, 451, 421, 446, 390, 438, 470,
+	413, 443, 471, 0, 0, 0, 217, 0, 973, 974,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 441,
+	464, 411, 442, 444, 378, 440, 0, 383, 386, 476,
+	459, 405, 96, 133, 1183, 0, 0, 0, 0, 0,
+	0, 426, 430, 448, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 403, 0, 437, 0, 381, 0,
+	0, 0, 0, 0, 387, 384, 0, 0, 424, 0,
+	0, 0, 0, 389, 0, 404, 449, 0, 377, 101,
+	452, 458, 0, 420, 182, 462, 418, 417, 466, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 456, 401, 409, 89, 406, 149, 136, 174, 436,
+	137, 148, 117, 167, 143, 463, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 382, 0,
+	160, 177, 195, 82, 397, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 393, 396, 391, 392, 432, 433, 472, 473,
+	474, 450, 388, 0, 394, 395, 0, 454, 460, 461,
+	410, 196, 435, 70, 77, 115, 478, 144, 98, 219,
+	178, 465, 407, 423, 453, 0, 422, 469, 399, 414,
+	477, 415, 416, 445, 385, 431, 134, 412, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 447, 468, 0, 402, 379, 408, 380, 400, 425,
+	94, 428, 398, 455, 434, 467, 114, 475, 116, 439,
+	0, 159, 125, 0, 0, 427, 457, 0, 429, 451,
+	421, 446, 390, 438, 470, 413, 443, 471, 0, 0,
+	0, 217, 0, 973, 974, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 441, 464, 411, 442, 444, 378,
+	440, 0, 383, 386, 476, 459, 405, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 426, 430, 448, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 403,
+	0, 437, 0, 381, 0, 0, 0, 0, 0, 387,
+	384, 0, 0, 424, 0, 0, 0, 0, 389, 0,
+	404, 449, 0, 377, 101, 452, 458, 0, 420, 182,
+	462, 418, 417, 466, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 456, 401, 409, 89,
+	406, 149, 136, 174, 436, 137, 148, 117, 167, 143,
+	463, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 382, 0, 160, 177, 195, 82, 397,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 393, 396, 391,
+	392, 432, 433, 472, 473, 474, 450, 388, 0, 394,
+	395, 0, 454, 460, 461, 410, 196, 435, 70, 77,
+	115, 478, 144, 98, 219, 178, 465, 407, 423, 453,
+	0, 422, 469, 399, 414, 477, 415, 416, 445, 385,
+	431, 134, 412, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 447, 468, 0, 402,
+	379, 408, 380, 400, 425, 94, 428, 398, 455, 434,
+	467, 114, 475, 116, 439, 0, 159, 125, 0, 0,
+	427, 457, 0, 429, 451, 421, 446, 390, 438, 470,
+	413, 443, 471, 56, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 441,
+	464, 411, 442, 444, 378, 440, 0, 383, 386, 476,
+	459, 405, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 426, 430, 448, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 403, 0, 437, 0, 381, 0,
+	0, 0, 0, 0, 387, 384, 0, 0, 424, 0,
+	0, 0, 0, 389, 0, 404, 449, 0, 377, 101,
+	452, 458, 0, 420, 182, 462, 418, 417, 466, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 456, 401, 409, 89, 406, 149, 136, 174, 436,
+	137, 148, 117, 167, 143, 463, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 382, 0,
+	160, 177, 195, 82, 397, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 393, 396, 391, 392, 432, 433, 472, 473,
+	474, 450, 388, 0, 394, 395, 0, 454, 460, 461,
+	410, 196, 435, 70, 77, 115, 478, 144, 98, 219,
+	178, 465, 407, 423, 453, 0, 422, 469, 399, 414,
+	477, 415, 416, 445, 385, 431, 134, 412, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 447, 468, 0, 402, 379, 408, 380, 400, 425,
+	94, 428, 398, 455, 434, 467, 114, 475, 116, 439,
+	0, 159, 125, 0, 0, 427, 457, 0, 429, 451,
+	421, 446, 390, 438, 470, 413, 443, 471, 0, 0,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 441, 464, 411, 442, 444, 378,
+	440, 0, 383, 386, 476, 459, 405, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 426, 430, 448, 419,
+	0, 0, 0, 0, 0, 0, 0, 1255, 0, 403,
+	0, 437, 0, 381, 0, 0, 0, 0, 0, 387,
+	384, 0, 0, 424, 0, 0, 0, 0, 389, 0,
+	404, 449, 0, 377, 101, 452, 458, 0, 420, 182,
+	462, 418, 417, 466, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 456, 401, 409, 89,
+	406, 149, 136, 174, 436, 137, 148, 117, 167, 143,
+	463, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 382, 0, 160, 177, 195, 82, 397,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 393, 396, 391,
+	392, 432, 433, 472, 473, 474, 450, 388, 0, 394,
+	395, 0, 454, 460, 461, 410, 196, 435, 70, 77,
+	115, 478, 144, 98, 219, 178, 465, 407, 423, 453,
+	0, 422, 469, 399, 414, 477, 415, 416, 445, 385,
+	431, 134, 412, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 447, 468, 0, 402,
+	379, 408, 380, 400, 425, 94, 428, 398, 455, 434,
+	467, 114, 475, 116, 439, 0, 159, 125, 0, 0,
+	427, 457, 0, 429, 451, 421, 446, 390, 438, 470,
+	413, 443, 471, 0, 0, 0, 67, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 441,
+	464, 411, 442, 444, 378, 440, 0, 383, 386, 476,
+	459, 405, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 426, 430, 448, 419, 0, 0, 0, 0, 0,
+	0, 0, 957, 0, 403, 0, 437, 0, 381, 0,
+	0, 0, 0, 0, 387, 384, 0, 0, 424, 0,
+	0, 0, 0, 389, 0, 404, 449, 0, 377, 101,
+	452, 458, 0, 420, 182, 462, 418, 417, 466, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 456, 401, 409, 89, 406, 149, 136, 174, 436,
+	137, 148, 117, 167, 143, 463, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 382, 0,
+	160, 177, 195, 82, 397, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 393, 396, 391, 392, 432, 433, 472, 473,
+	474, 450, 388, 0, 394, 395, 0, 454, 460, 461,
+	410, 196, 435, 70, 77, 115, 478, 144, 98, 219,
+	178, 465, 407, 423, 453, 0, 422, 469, 399, 414,
+	477, 415, 416, 445, 385, 431, 134, 412, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 447, 468, 0, 402, 379, 408, 380, 400, 425,
+	94, 428, 398, 455, 434, 467, 114, 475, 116, 439,
+	0, 159, 125, 0, 0, 427, 457, 0, 429, 451,
+	421, 446, 390, 438, 470, 413, 443, 471, 0, 0,
+	0, 294, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 441, 464, 411, 442, 444, 378,
+	440, 0, 383, 386, 476, 459, 405, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 426, 430, 448, 419,
+	0, 0, 0, 0, 0, 0, 0, 830, 0, 403,
+	0, 437, 0, 381, 0, 0, 0, 0, 0, 387,
+	384, 0, 0, 424, 0, 0, 0, 0, 389, 0,
+	404, 449, 0, 377, 101, 452, 458, 0, 420, 182,
+	462, 418, 417, 466, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 456, 401, 409, 89,
+	406, 149, 136, 174, 436, 137, 148, 117, 167, 143,
+	463, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 382, 0, 160, 177, 195, 82, 397,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 393, 396, 391,
+	392, 432, 433, 472, 473, 474, 450, 388, 0, 394,
+	395, 0, 454, 460, 461, 410, 196, 435, 70, 77,
+	115, 478, 144, 98, 219, 178, 465, 407, 423, 453,
+	0, 422, 469, 399, 414, 477, 415, 416, 445, 385,
+	431, 134, 412, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 447, 468, 0, 402,
+	379, 408, 380, 400, 425, 94, 428, 398, 455, 434,
+	467, 114, 475, 116, 439, 0, 159, 125, 0, 0,
+	427, 457, 0, 429, 451, 421, 446, 390, 438, 470,
+	413, 443, 471, 0, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 441,
+	464, 411, 442, 444, 378, 440, 0, 383, 386, 476,
+	459, 405, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 426, 430, 448, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 403, 0, 437, 0, 381, 0,
+	0, 0, 0, 0, 387, 384, 0, 0, 424, 0,
+	0, 0, 0, 389, 0, 404, 449, 0, 377, 101,
+	452, 458, 0, 420, 182, 462, 418, 417, 466, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 456, 401, 409, 89, 406, 149, 136, 174, 436,
+	137, 148, 117, 167, 143, 463, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 382, 0,
+	160, 177, 195, 82, 397, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 393, 396, 391, 392, 432, 433, 472, 473,
+	474, 450, 388, 0, 394, 395, 0, 454, 460, 461,
+	410, 196, 435, 70, 77, 115, 478, 144, 98, 219,
+	178, 465, 407, 423, 453, 0, 422, 469, 399, 414,
+	477, 415, 416, 445, 385, 431, 134, 412, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 447, 468, 0, 402, 379, 408, 380, 400, 425,
+	94, 428, 398, 455, 434, 467, 114, 475, 116, 439,
+	0, 159, 125, 0, 0, 427, 457, 0, 429, 451,
+	421, 446, 390, 438, 470, 413, 443, 471, 0, 0,
+	0, 294, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 441, 464, 411, 442, 444, 378,
+	440, 0, 383, 386, 476, 459, 405, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 426, 430, 448, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 403,
+	0, 437, 0, 381, 0, 0, 0, 0, 0, 387,
+	384, 0, 0, 424, 0, 0, 0, 0, 389, 0,
+	404, 449, 0, 377, 101, 452, 458, 0, 420, 182,
+	462, 418, 417, 466, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 456, 401, 409, 89,
+	406, 149, 136, 174, 436, 137, 148, 117, 167, 143,
+	463, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 382, 0, 160, 177, 195, 82, 397,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 393, 396, 391,
+	392, 432, 433, 472, 473, 474, 450, 388, 0, 394,
+	395, 0, 454, 460, 461, 410, 196, 435, 70, 77,
+	115, 478, 144, 98, 219, 178, 465, 407, 423, 453,
+	0, 422, 469, 399, 414, 477, 415, 416, 445, 385,
+	431, 134, 412, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 447, 468, 0, 402,
+	379, 408, 380, 400, 425, 94, 428, 398, 455, 434,
+	467, 114, 475, 116, 439, 0, 159, 125, 0, 0,
+	427, 457, 0, 429, 451, 421, 446, 390, 438, 470,
+	413, 443, 471, 0, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 441,
+	464, 411, 442, 444, 378, 440, 0, 383, 386, 476,
+	459, 405, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 426, 430, 448, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 403, 0, 437, 0, 381, 0,
+	0, 0, 0, 0, 387, 384, 0, 0, 424, 0,
+	0, 0, 0, 389, 0, 404, 449, 0, 377, 101,
+	452, 458, 0, 420, 182, 462, 418, 417, 466, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 456, 401, 409, 89, 406, 149, 136, 174, 436,
+	137, 148, 117, 167, 143, 463, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 375, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 382, 0,
+	160, 177, 195, 82, 397, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 376,
+	374, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 393, 396, 391, 392, 432, 433, 472, 473,
+	474, 450, 388, 0, 394, 395, 0, 454, 460, 461,
+	410, 196, 435, 70, 77, 115, 478, 144, 98, 219,
+	178, 465, 407, 423, 453, 0, 422, 469, 399, 414,
+	477, 415, 416, 445, 385, 431, 134, 412, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 447, 468, 0, 402, 379, 408, 380, 400, 425,
+	94, 428, 398, 455, 434, 467, 114, 475, 116, 439,
+	0, 159, 125, 0, 0, 427, 457, 0, 429, 451,
+	421, 446, 390, 438, 470, 413, 443, 471, 0, 0,
+	0, 67, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 441, 464, 411, 442, 444, 378,
+	440, 0, 383, 386, 476, 459, 405, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 426, 430, 448, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 403,
+	0, 437, 0, 381, 0, 0, 0, 0, 0, 387,
+	384, 0, 0, 424, 0, 0, 0, 0, 389, 0,
+	404, 449, 0, 377, 101, 452, 458, 0, 420, 182,
+	462, 418, 417, 466, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 456, 401, 409, 89,
+	406, 149, 136, 174, 436, 137, 148, 117, 167, 143,
+	463, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 382, 0, 160, 177, 195, 82, 397,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 393, 396, 391,
+	392, 432, 433, 472, 473, 474, 450, 388, 0, 394,
+	395, 0, 454, 460, 461, 410, 196, 435, 70, 77,
+	115, 478, 144, 98, 219, 178, 465, 407, 423, 453,
+	0, 422, 469, 399, 414, 477, 415, 416, 445, 385,
+	431, 134, 412, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 447, 468, 0, 402,
+	379, 408, 380, 400, 425, 94, 428, 398, 455, 434,
+	467, 114, 475, 116, 439, 0, 159, 125, 0, 0,
+	427, 457, 0, 429, 451, 421, 446, 390, 438, 470,
+	413, 443, 471, 0, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 441,
+	464, 411, 442, 444, 378, 440, 0, 383, 386, 476,
+	459, 405, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 426, 430, 448, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 403, 0, 437, 0, 381, 0,
+	0, 0, 0, 0, 387, 384, 0, 0, 424, 0,
+	0, 0, 0, 389, 0, 404, 449, 0, 377, 101,
+	452, 458, 0, 420, 182, 462, 418, 417, 466, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 456, 401, 409, 89, 406, 149, 136, 174, 436,
+	137, 148, 117, 167, 143, 463, 183, 184, 164, 181,
+	191, 72, 163, 696, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 375, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 382, 0,
+	160, 177, 195, 82, 397, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 376,
+	374, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 393, 396, 391, 392, 432, 433, 472, 473,
+	474, 450, 388, 0, 394, 395, 0, 454, 460, 461,
+	410, 196, 435, 70, 77, 115, 478, 144, 98, 219,
+	178, 465, 407, 423, 453, 0, 422, 469, 399, 414,
+	477, 415, 416, 445, 385, 431, 134, 412, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 447, 468, 0, 402, 379, 408, 380, 400, 425,
+	94, 428, 398, 455, 434, 467, 114, 475, 116, 439,
+	0, 159, 125, 0, 0, 427, 457, 0, 429, 451,
+	421, 446, 390, 438, 470, 413, 443, 471, 0, 0,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 441, 464, 411, 442, 444, 378,
+	440, 0, 383, 386, 476, 459, 405, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 426, 430, 448, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 403,
+	0, 437, 0, 381, 0, 0, 0, 0, 0, 387,
+	384, 0, 0, 424, 0, 0, 0, 0, 389, 0,
+	404, 449, 0, 377, 101, 452, 458, 0, 420, 182,
+	462, 418, 417, 466, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 456, 401, 409, 89,
+	406, 149, 136, 174, 436, 137, 148, 117, 167, 143,
+	463, 183, 184, 164, 181, 191, 72, 163, 366, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 375, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 382, 0, 160, 177, 195, 82, 397,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 376, 374, 369, 368, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 393, 396, 391,
+	392, 432, 433, 472, 473, 474, 450, 388, 0, 394,
+	395, 0, 454, 460, 461, 410, 196, 435, 70, 77,
+	115, 478, 144, 98, 219, 178, 25, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 0,
+	192, 92, 87, 69, 0, 154, 141, 103, 175, 88,
+	153, 108, 157, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 94, 0, 293, 0, 0, 0, 114, 340,
+	116, 0, 0, 159, 125, 0, 0, 0, 0, 0,
+	331, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	56, 0, 550, 294, 319, 318, 321, 322, 323, 324,
+	0, 0, 84, 320, 314, 316, 325, 326, 327, 0,
+	0, 0, 292, 307, 0, 339, 0, 0, 0, 96,
+	133, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 304, 305, 0,
+	0, 0, 0, 354, 0, 0, 306, 0, 0, 0,
+	0, 0, 301, 302, 303, 308, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 182, 0, 0, 352, 0, 142, 0, 162, 104,
+	113, 71, 78, 0, 102, 131, 147, 151, 0, 0,
+	0, 89, 0, 149, 136, 174, 0, 137, 148, 117,
+	167, 143, 0, 183, 184, 164, 181, 191, 72, 163,
+	173, 85, 152, 74, 171, 161, 123, 109, 110, 73,
+	0, 146, 93, 99, 91, 132, 168, 169, 90, 194,
+	79, 180, 76, 80, 179, 130, 166, 172, 124, 121,
+	75, 170, 122, 120, 112, 97, 105, 139, 119, 140,
+	106, 127, 126, 128, 0, 0, 0, 160, 177, 195,
+	82, 0, 155, 165, 185, 186, 187, 188, 189, 190,
+	0, 0, 83, 100, 95, 138, 129, 81, 107, 156,
+	111, 118, 145, 193, 135, 150, 86, 176, 158, 342,
+	353, 348, 349, 346, 347, 345, 344, 343, 355, 333,
+	334, 335, 336, 338, 0, 350, 351, 341, 196, 337,
+	70, 77, 115, 23, 144, 98, 219, 178, 0, 313,
+	0, 134, 315, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 0, 0, 0, 0,
+	0, 317, 0, 0, 0, 94, 0, 293, 0, 0,
+	0, 114, 340, 116, 0, 0, 159, 125, 0, 0,
+	0, 0, 0, 331, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 56, 0, 0, 294, 319, 318, 321,
+	322, 323, 324, 0, 0, 84, 320, 314, 316, 325,
+	326, 327, 0, 0, 0, 292, 307, 0, 339, 0,
+	0, 0, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	304, 305, 0, 0, 0, 0, 354, 0, 0, 306,
+	0, 0, 0, 0, 0, 301, 302, 303, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 1413, 1414, 0, 182, 0, 0, 352, 0, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 0, 0, 0, 89, 0, 149, 136, 174, 0,
+	137, 148, 117, 167, 143, 0, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 0, 0,
+	160, 177, 195, 82, 0, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 342, 353, 348, 349, 346, 347, 345, 344,
+	343, 355, 333, 334, 335, 336, 338, 0, 350, 351,
+	341, 196, 337, 70, 77, 115, 0, 144, 98, 219,
+	178, 0, 313, 0, 134, 315, 192, 92, 87, 69,
+	0, 154, 141, 103, 175, 88, 153, 108, 157, 0,
+	0, 0, 0, 0, 317, 0, 0, 0, 94, 0,
+	293, 0, 0, 0, 114, 340, 116, 0, 0, 159,
+	125, 0, 0, 0, 0, 0, 331, 332, 0, 0,
+	0, 0, 0, 0, 964, 0, 56, 0, 0, 294,
+	319, 318, 321, 322, 323, 324, 0, 0, 84, 320,
+	314, 316, 325, 326, 327, 965, 0, 0, 292, 307,
+	0, 339, 0, 0, 0, 96, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 304, 305, 0, 0, 0, 0, 354,
+	0, 0, 306, 0, 0, 0, 0, 0, 301, 302,
+	303, 308, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 182, 0, 0,
+	352, 0, 142, 0, 162, 104, 113, 71, 78, 0,
+	102, 131, 147, 151, 0, 0, 0, 89, 0, 149,
+	136, 174, 0, 137, 148, 117, 167, 143, 0, 183,
+	184, 164, 181, 191, 72, 163, 173, 85, 152, 74,
+	171, 161, 123, 109, 110, 73, 0, 146, 93, 99,
+	91, 132, 168, 169, 90, 194, 79, 180, 76, 80,
+	179, 130, 166, 172, 124, 121, 75, 170, 122, 120,
+	112, 97, 105, 139, 119, 140, 106, 127, 126, 128,
+	0, 0, 0, 160, 177, 195, 82, 0, 155, 165,
+	185, 186, 187, 188, 189, 190, 0, 0, 83, 100,
+	95, 138, 129, 81, 107, 156, 111, 118, 145, 193,
+	135, 150, 86, 176, 158, 342, 353, 348, 349, 346,
+	347, 345, 344, 343, 355, 333, 334, 335, 336, 338,
+	0, 350, 351, 341, 196, 337, 70, 77, 115, 25,
+	144, 98, 219, 178, 0, 313, 0, 0, 315, 0,
+	0, 134, 0, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 0, 0, 0, 0,
+	0, 317, 0, 0, 0, 94, 0, 293, 0, 0,
+	0, 114, 340, 116, 0, 0, 159, 125, 0, 0,
+	0, 0, 0, 331, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 56, 0, 0, 294, 319, 318, 321,
+	322, 323, 324, 0, 0, 84, 320, 314, 316, 325,
+	326, 327, 0, 0, 0, 292, 307, 0, 339, 0,
+	0, 0, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	304, 305, 0, 0, 0, 0, 354, 0, 0, 306,
+	0, 0, 0, 0, 0, 301, 302, 303, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 182, 0, 0, 352, 0, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 0, 0, 0, 89, 0, 149, 136, 174, 0,
+	137, 148, 117, 167, 143, 0, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 0, 0,
+	160, 177, 195, 82, 0, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 342, 353, 348, 349, 346, 347, 345, 344,
+	343, 355, 333, 334, 335, 336, 338, 0, 350, 351,
+	341, 196, 337, 70, 77, 115, 23, 144, 98, 219,
+	178, 0, 313, 0, 134, 315, 192, 92, 87, 69,
+	0, 154, 141, 103, 175, 88, 153, 108, 157, 0,
+	0, 0, 884, 0, 317, 0, 0, 0, 94, 0,
+	293, 0, 0, 0, 114, 340, 116, 0, 0, 159,
+	125, 0, 0, 0, 0, 0, 331, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 56, 0, 0, 294,
+	319, 318, 321, 322, 323, 324, 0, 0, 84, 320,
+	314, 316, 325, 326, 327, 0, 0, 0, 292, 307,
+	0, 339, 0, 0, 0, 96, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 304, 305, 287, 0, 0, 0, 354,
+	0, 0, 306, 0, 0, 0, 0, 0, 301, 302,
+	303, 308, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 182, 0, 0,
+	352, 0, 142, 0, 162, 104, 113, 71, 78, 0,
+	102, 131, 147, 151, 0, 0, 0, 89, 0, 149,
+	136, 174, 0, 137, 148, 117, 167, 143, 0, 183,
+	184, 164, 181, 191, 72, 163, 173, 85, 152, 74,
+	171, 161, 123, 109, 110, 73, 0, 146, 93, 99,
+	91, 132, 168, 169, 90, 194, 79, 180, 76, 80,
+	179, 130, 166, 172, 124, 121, 75, 170, 122, 120,
+	112, 97, 105, 139, 119, 140, 106, 127, 126, 128,
+	0, 0, 0, 160, 177, 195, 82, 0, 155, 165,
+	185, 186, 187, 188, 189, 190, 0, 0, 83, 100,
+	95, 138, 129, 81, 107, 156, 111, 118, 145, 193,
+	135, 150, 86, 176, 158, 342, 353, 348, 349, 346,
+	347, 345, 344, 343, 355, 333, 334, 335, 336, 338,
+	0, 350, 351, 341, 196, 337, 70, 77, 115, 0,
+	144, 98, 219, 178, 0, 313, 0, 134, 315, 192,
+	92, 87, 69, 0, 154, 141, 103, 175, 88, 153,
+	108, 157, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 94, 0, 293, 0, 0, 0, 114, 340, 116,
+	0, 0, 159, 125, 0, 0, 0, 0, 0, 331,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 56,
+	0, 0, 294, 319, 318, 321, 322, 323, 324, 0,
+	0, 84, 320, 314, 316, 325, 326, 327, 0, 0,
+	0, 292, 307, 0, 339, 0, 0, 0, 96, 133,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 304, 305, 287, 0,
+	0, 0, 354, 0, 0, 306, 0, 0, 0, 0,
+	0, 301, 302, 303, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	182, 0, 0, 352, 0, 142, 0, 162, 104, 113,
+	71, 78, 0, 102, 131, 147, 151, 0, 0, 0,
+	89, 0, 149, 136, 174, 0, 137, 148, 117, 167,
+	143, 0, 183, 184, 164, 181, 191, 72, 163, 173,
+	85, 152, 74, 171, 161, 123, 109, 110, 73, 0,
+	146, 93, 99, 91, 132, 168, 169, 90, 194, 79,
+	180, 76, 80, 179, 130, 166, 172, 124, 121, 75,
+	170, 122, 120, 112, 97, 105, 139, 119, 140, 106,
+	127, 126, 128, 0, 0, 0, 160, 177, 195, 82,
+	0, 155, 165, 185, 186, 187, 188, 189, 190, 0,
+	0, 83, 100, 95, 138, 129, 81, 107, 156, 111,
+	118, 145, 193, 135, 150, 86, 176, 158, 342, 353,
+	348, 349, 346, 347, 345, 344, 343, 355, 333, 334,
+	335, 336, 338, 0, 350, 351, 341, 196, 337, 70,
+	77, 115, 0, 144, 98, 219, 178, 0, 313, 0,
+	134, 315, 192, 92, 87, 69, 0, 154, 141, 103,
+	175, 88, 153, 108, 157, 0, 0, 0, 0, 0,
+	317, 0, 0, 0, 94, 0, 293, 0, 0, 0,
+	114, 340, 116, 0, 0, 159, 125, 0, 0, 0,
+	0, 0, 331, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 56, 0, 550, 294, 319, 318, 321, 322,
+	323, 324, 0, 0, 84, 320, 314, 316, 325, 326,
+	327, 0, 0, 0, 292, 307, 0, 339, 0, 0,
+	0, 96, 133, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
+	305, 0, 0, 0, 0, 354, 0, 0, 306, 0,
+	0, 0, 0, 0, 301, 302, 303, 308, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 182, 0, 0, 352, 0, 142, 0,
+	162, 104, 113, 71, 78, 0, 102, 131, 147, 151,
+	0, 0, 0, 89, 0, 149, 136, 174, 0, 137,
+	148, 117, 167, 143, 0, 183, 184, 164, 181, 191,
+	72, 163, 173, 85, 152, 74, 171, 161, 123, 109,
+	110, 73, 0, 146, 93, 99, 91, 132, 168, 169,
+	90, 194, 79, 180, 76, 80, 179, 130, 166, 172,
+	124, 121, 75, 170, 122, 120, 112, 97, 105, 139,
+	119, 140, 106, 127, 126, 128, 0, 0, 0, 160,
+	177, 195, 82, 0, 155, 165, 185, 186, 187, 188,
+	189, 190, 0, 0, 83, 100, 95, 138, 129, 81,
+	107, 156, 111, 118, 145, 193, 135, 150, 86, 176,
+	158, 342, 353, 348, 349, 346, 347, 345, 344, 343,
+	355, 333, 334, 335, 336, 338, 0, 350, 351, 341,
+	196, 337, 70, 77, 115, 0, 144, 98, 219, 178,
+	0, 313, 0, 134, 315, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 0, 317, 0, 0, 0, 94, 0, 293,
+	0, 0, 0, 114, 340, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 331, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 56, 0, 0, 294, 319,
+	903, 321, 322, 323, 324, 0, 0, 84, 320, 314,
+	316, 325, 326, 327, 0, 0, 0, 292, 307, 0,
+	339, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 304, 305, 287, 0, 0, 0, 354, 0,
+	0, 306, 0, 0, 0, 0, 0, 301, 302, 303,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 352,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 342, 353, 348, 349, 346, 347,
+	345, 344, 343, 355, 333, 334, 335, 336, 338, 0,
+	350, 351, 341, 196, 337, 70, 77, 115, 0, 144,
+	98, 219, 178, 0, 313, 0, 134, 315, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 0, 0, 0, 0, 0, 317, 0, 0, 0,
+	94, 0, 293, 0, 0, 0, 114, 340, 116, 0,
+	0, 159, 125, 0, 0, 0, 0, 0, 331, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 56, 0,
+	0, 294, 319, 900, 321, 322, 323, 324, 0, 0,
+	84, 320, 314, 316, 325, 326, 327, 0, 0, 0,
+	292, 307, 0, 339, 0, 0, 0, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 304, 305, 287, 0, 0,
+	0, 354, 0, 0, 306, 0, 0, 0, 0, 0,
+	301, 302, 303, 308, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 182,
+	0, 0, 352, 0, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 0, 0, 0, 89,
+	0, 149, 136, 174, 0, 137, 148, 117, 167, 143,
+	0, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 0, 0, 160, 177, 195, 82, 0,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 342, 353, 348,
+	349, 346, 347, 345, 344, 343, 355, 333, 334, 335,
+	336, 338, 0, 350, 351, 341, 196, 337, 70, 77,
+	115, 0, 144, 98, 219, 178, 0, 313, 0, 134,
+	315, 192, 92, 87, 69, 0, 154, 141, 103, 175,
+	88, 153, 108, 157, 0, 0, 0, 0, 0, 317,
+	0, 0, 0, 94, 0, 293, 0, 0, 0, 114,
+	340, 116, 0, 0, 159, 125, 0, 0, 0, 0,
+	0, 331, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 56, 0, 0, 294, 319, 318, 321, 322, 323,
+	324, 0, 0, 84, 320, 314, 316, 325, 326, 327,
+	0, 0, 0, 292, 307, 0, 339, 0, 0, 0,
+	96, 133, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 304, 305,
+	0, 0, 0, 0, 354, 0, 0, 306, 0, 0,
+	0, 0, 0, 301, 302, 303, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 182, 0, 0, 352, 0, 142, 0, 162,
+	104, 113, 71, 78, 0, 102, 131, 147, 151, 0,
+	0, 0, 89, 0, 149, 136, 174, 0, 137, 148,
+	117, 167, 143, 0, 183, 184, 164, 181, 191, 72,
+	163, 173, 85, 152, 74, 171, 161, 123, 109, 110,
+	73, 0, 146, 93, 99, 91, 132, 168, 169, 90,
+	194, 79, 180, 76, 80, 179, 130, 166, 172, 124,
+	121, 75, 170, 122, 120, 112, 97, 105, 139, 119,
+	140, 106, 127, 126, 128, 0, 0, 0, 160, 177,
+	195, 82, 0, 155, 165, 185, 186, 187, 188, 189,
+	190, 0, 0, 83, 100, 95, 138, 129, 81, 107,
+	156, 111, 118, 145, 193, 135, 150, 86, 176, 158,
+	342, 353, 348, 349, 346, 347, 345, 344, 343, 355,
+	333, 334, 335, 336, 338, 0, 350, 351, 341, 196,
+	337, 70, 77, 115, 0, 144, 98, 219, 178, 0,
+	313, 0, 134, 315, 192, 92, 87, 69, 0, 154,
+	141, 103, 175, 88, 153, 1542, 157, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 94, 0, 293, 0,
+	0, 0, 114, 340, 116, 0, 0, 159, 125, 0,
+	0, 0, 0, 0, 331, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 56, 0, 0, 294, 319, 318,
+	321, 322, 323, 324, 0, 0, 84, 320, 314, 316,
+	325, 326, 327, 0, 0, 0, 292, 307, 0, 339,
+	0, 0, 0, 96, 133, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 304, 305, 0, 0, 0, 0, 354, 0, 0,
+	306, 0, 0, 0, 0, 0, 301, 302, 303, 308,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 182, 0, 0, 352, 0,
+	142, 0, 162, 104, 113, 71, 78, 0, 102, 131,
+	147, 151, 0, 0, 0, 89, 0, 149, 136, 174,
+	0, 137, 148, 117, 167, 143, 0, 183, 184, 164,
+	181, 191, 72, 163, 173, 85, 152, 74, 171, 161,
+	123, 109, 110, 73, 0, 146, 93, 99, 91, 132,
+	168, 169, 90, 194, 79, 180, 76, 80, 179, 130,
+	166, 172, 124, 121, 75, 170, 122, 120, 112, 97,
+	105, 139, 119, 140, 106, 127, 126, 128, 0, 0,
+	0, 160, 177, 195, 82, 0, 155, 165, 185, 186,
+	187, 188, 189, 190, 0, 0, 83, 100, 95, 138,
+	129, 81, 107, 156, 111, 118, 145, 193, 135, 150,
+	86, 176, 158, 342, 353, 348, 349, 346, 347, 345,
+	344, 343, 355, 333, 334, 335, 336, 338, 0, 350,
+	351, 341, 196, 337, 70, 77, 115, 0, 144, 98,
+	219, 178, 0, 313, 0, 134, 315, 192, 92, 87,
+	69, 0, 154, 141, 103, 175, 88, 153, 108, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	0, 0, 0, 0, 0, 114, 340, 116, 0, 0,
+	159, 125, 0, 0, 0, 0, 0, 331, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 56, 0, 0,
+	294, 319, 318, 321, 322, 323, 324, 0, 0, 84,
+	320, 314, 316, 325, 326, 327, 0, 0, 0, 0,
+	307, 0, 339, 0, 0, 0, 96, 133, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 304, 305, 0, 0, 0, 0,
+	354, 0, 0, 306, 0, 0, 0, 0, 0, 301,
+	302, 303, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 182, 0,
+	0, 352, 0, 142, 0, 162, 104, 113, 71, 78,
+	0, 102, 131, 147, 151, 0, 0, 0, 89, 0,
+	149, 136, 174, 1635, 137, 148, 117, 167, 143, 0,
+	183, 184, 164, 181, 191, 72, 163, 173, 85, 152,
+	74, 171, 161, 123, 109, 110, 73, 0, 146, 93,
+	99, 91, 132, 168, 169, 90, 194, 79, 180, 76,
+	80, 179, 130, 166, 172, 124, 121, 75, 170, 122,
+	120, 112, 97, 105, 139, 119, 140, 106, 127, 126,
+	128, 0, 0, 0, 160, 177, 195, 82, 0, 155,
+	165, 185, 186, 187, 188, 189, 190, 0, 0, 83,
+	100, 95, 138, 129, 81, 107, 156, 111, 118, 145,
+	193, 135, 150, 86, 176, 158, 342, 353, 348, 349,
+	346, 347, 345, 344, 343, 355, 333, 334, 335, 336,
+	338, 0, 350, 351, 341, 196, 337, 70, 77, 115,
+	0, 144, 98, 219, 178, 0, 313, 0, 134, 315,
+	192, 92, 87, 69, 0, 154, 141, 103, 175, 88,
+	153, 108, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 94, 0, 0, 0, 0, 0, 114, 340,
+	116, 0, 0, 159, 125, 0, 0, 0, 0, 0,
+	331, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	56, 0, 0, 294, 319, 318, 321, 322, 323, 324,
+	0, 0, 84, 320, 314, 316, 325, 326, 327, 0,
+	0, 0, 0, 307, 0, 339, 0, 0, 0, 96,
+	133, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 304, 305, 0,
+	0, 0, 0, 354, 0, 0, 306, 0, 0, 0,
+	0, 0, 301, 302, 303, 308, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 182, 0, 0, 352, 0, 142, 0, 162, 104,
+	113, 71, 78, 0, 102, 131, 147, 151, 0, 0,
+	0, 89, 0, 149, 136, 174, 0, 137, 148, 117,
+	167, 143, 0, 183, 184, 164, 181, 191, 72, 163,
+	173, 85, 152, 74, 171, 161, 123, 109, 110, 73,
+	0, 146, 93, 99, 91, 132, 168, 169, 90, 194,
+	79, 180, 76, 80, 179, 130, 166, 172, 124, 121,
+	75, 170, 122, 120, 112, 97, 105, 139, 119, 140,
+	106, 127, 126, 128, 0, 0, 0, 160, 177, 195,
+	82, 0, 155, 165, 185, 186, 187, 188, 189, 190,
+	0, 0, 83, 100, 95, 138, 129, 81, 107, 156,
+	111, 118, 145, 193, 135, 150, 86, 176, 158, 342,
+	353, 348, 349, 346, 347, 345, 344, 343, 355, 333,
+	334, 335, 336, 338, 0, 350, 351, 341, 196, 337,
+	70, 77, 115, 0, 144, 98, 219, 178, 0, 313,
+	1263, 134, 315, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 0,
+	0, 114, 340, 116, 0, 0, 159, 125, 0, 0,
+	0, 0, 0, 331, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 56, 0, 550, 294, 319, 318, 321,
+	322, 323, 324, 0, 0, 84, 320, 314, 316, 325,
+	326, 327, 0, 0, 0, 0, 307, 0, 339, 0,
+	0, 0, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	304, 305, 0, 0, 0, 0, 354, 0, 0, 306,
+	0, 0, 0, 0, 0, 301, 302, 303, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 182, 0, 0, 352, 0, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 0, 0, 0, 89, 0, 149, 136, 174, 0,
+	137, 148, 117, 167, 143, 0, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 0, 0,
+	160, 177, 195, 82, 0, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 342, 353, 348, 349, 346, 347, 345, 344,
+	343, 355, 333, 334, 335, 336, 338, 0, 350, 351,
+	341, 196, 337, 70, 77, 115, 0, 144, 98, 219,
+	178, 0, 313, 0, 134, 315, 192, 92, 87, 69,
+	0, 154, 141, 103, 175, 88, 153, 108, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 94, 0,
+	0, 0, 0, 0, 114, 340, 116, 0, 0, 159,
+	125, 0, 0, 0, 0, 0, 331, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 56, 0, 0, 294,
+	319, 318, 321, 322, 323, 324, 0, 0, 84, 320,
+	314, 316, 325, 326, 327, 0, 0, 0, 0, 307,
+	0, 339, 0, 0, 0, 96, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 304, 305, 0, 0, 0, 0, 354,
+	0, 0, 306, 0, 0, 0, 0, 0, 301, 302,
+	303, 308, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 182, 0, 0,
+	352, 0, 142, 0, 162, 104, 113, 71, 78, 0,
+	102, 131, 147, 151, 0, 0, 0, 89, 0, 149,
+	136, 174, 0, 137, 148, 117, 167, 143, 0, 183,
+	184, 164, 181, 191, 72, 163, 173, 85, 152, 74,
+	171, 161, 123, 109, 110, 73, 0, 146, 93, 99,
+	91, 132, 168, 169, 90, 194, 79, 180, 76, 80,
+	179, 130, 166, 172, 124, 121, 75, 170, 122, 120,
+	112, 97, 105, 139, 119, 140, 106, 127, 126, 128,
+	0, 0, 0, 160, 177, 195, 82, 0, 155, 165,
+	185, 186, 187, 188, 189, 190, 0, 0, 83, 100,
+	95, 138, 129, 81, 107, 156, 111, 118, 145, 193,
+	135, 150, 86, 176, 158, 342, 353, 348, 349, 346,
+	347, 345, 344, 343, 355, 333, 334, 335, 336, 338,
+	0, 350, 351, 341, 196, 337, 70, 77, 115, 0,
+	144, 98, 219, 178, 0, 313, 0, 866, 315, 134,
+	0, 192, 92, 87, 69, 0, 154, 141, 103, 175,
+	88, 153, 108, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 0, 0, 114,
+	340, 116, 0, 0, 159, 125, 0, 0, 0, 0,
+	0, 331, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 56, 0, 0, 294, 319, 318, 321, 322, 323,
+	324, 0, 0, 84, 320, 314, 316, 325, 326, 327,
+	0, 0, 0, 0, 307, 0, 339, 0, 0, 0,
+	96, 133, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 304, 305,
+	0, 0, 0, 0, 354, 0, 0, 306, 0, 0,
+	0, 0, 0, 301, 302, 303, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 182, 0, 0, 352, 0, 142, 0, 162,
+	104, 113, 71, 78, 0, 102, 131, 147, 151, 0,
+	0, 0, 89, 0, 149, 136, 174, 0, 137, 148,
+	117, 167, 143, 0, 183, 184, 164, 181, 191, 72,
+	163, 173, 85, 152, 74, 171, 161, 123, 109, 110,
+	73, 0, 146, 93, 99, 91, 132, 168, 169, 90,
+	194, 79, 180, 76, 80, 179, 130, 166, 172, 124,
+	121, 75, 170, 122, 120, 112, 97, 105, 139, 119,
+	140, 106, 127, 126, 128, 0, 0, 0, 160, 177,
+	195, 82, 0, 155, 165, 185, 186, 187, 188, 189,
+	190, 0, 0, 83, 100, 95, 138, 129, 81, 107,
+	156, 111, 118, 145, 193, 135, 150, 86, 176, 158,
+	342, 353, 348, 349, 346, 347, 345, 344, 343, 355,
+	333, 334, 335, 336, 338, 0, 350, 351, 341, 196,
+	337, 70, 77, 115, 0, 144, 98, 219, 178, 0,
+	313, 0, 134, 315, 192, 92, 87, 69, 0, 154,
+	141, 103, 175, 88, 153, 108, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 0, 0, 0,
+	0, 0, 114, 0, 116, 0, 0, 159, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 0,
+	0, 0, 0, 0, 606, 0, 84, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 133, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 593, 592, 602,
+	603, 595, 596, 597, 598, 599, 600, 601, 594, 608,
+	0, 0, 0, 0, 0, 604, 0, 0, 0, 0,
+	0, 0, 607, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 182, 0, 0, 0, 0,
+	142, 0, 162, 104, 113, 71, 78, 0, 102, 131,
+	147, 151, 0, 0, 0, 89, 0, 149, 136, 174,
+	0, 137, 148, 117, 167, 143, 0, 183, 184, 164,
+	181, 191, 72, 163, 173, 85, 152, 74, 171, 161,
+	123, 109, 110, 73, 0, 146, 93, 99, 91, 132,
+	168, 169, 90, 194, 79, 180, 76, 80, 179, 130,
+	166, 172, 124, 121, 75, 170, 122, 120, 112, 97,
+	105, 139, 119, 140, 106, 127, 126, 128, 0, 0,
+	0, 160, 177, 195, 82, 0, 155, 165, 185, 186,
+	187, 188, 189, 190, 0, 0, 83, 100, 95, 138,
+	129, 81, 107, 156, 111, 118, 145, 193, 135, 150,
+	86, 176, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 70, 77, 115, 0, 144, 98,
+	219, 178, 134, 605, 192, 92, 87, 69, 0, 154,
+	141, 103, 175, 88, 153, 108, 157, 0, 0, 0,
+	0, 577, 0, 0, 0, 0, 94, 0, 0, 0,
+	0, 0, 114, 0, 116, 0, 0, 159, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 579,
+	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 0, 0, 574, 573, 0, 0, 0, 0,
+	0, 0, 0, 96, 133, 0, 0, 0, 0, 0,
+	0, 0, 575, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 182, 0, 0, 0, 0,
+	142, 0, 162, 104, 113, 71, 78, 0, 102, 131,
+	147, 151, 0, 0, 0, 89, 0, 149, 136, 174,
+	0, 137, 148, 117, 167, 143, 0, 183, 184, 164,
+	181, 191, 72, 163, 173, 85, 152, 74, 171, 161,
+	123, 109, 110, 73, 0, 146, 93, 99, 91, 132,
+	168, 169, 90, 194, 79, 180, 76, 80, 179, 130,
+	166, 172, 124, 121, 75, 170, 122, 120, 112, 97,
+	105, 139, 119, 140, 106, 127, 126, 128, 0, 0,
+	0, 160, 177, 195, 82, 0, 155, 165, 185, 186,
+	187, 188, 189, 190, 0, 0, 83, 100, 95, 138,
+	129, 81, 107, 156, 111, 118, 145, 193, 135, 150,
+	86, 176, 158, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 196, 0, 70, 77, 115, 94, 144, 98,
+	219, 178, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 213, 214, 0, 0, 210, 0, 0, 0,
+	215, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 0, 212, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 25, 0, 196, 0, 70, 77, 115, 0, 144,
+	98, 219, 178, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 0, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 56, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 70, 77, 115, 23, 144,
+	98, 219, 178, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 0, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 868, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 871,
+	872, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	869, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 25, 0, 196, 0, 70, 77, 115, 0, 144,
+	98, 219, 178, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 0, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 56, 0, 0, 683, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 70, 77, 115, 23, 144,
+	98, 219, 178, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 949, 0, 0, 0, 0, 94, 0, 0,
+	0, 0, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 67, 0,
+	66, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 70, 77, 115, 0, 144,
+	98, 219, 178, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 949, 0, 0, 0, 0, 94, 0, 0,
+	0, 0, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 67, 0,
+	66, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 947, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 134, 0, 192, 92, 87, 69,
+	0, 154, 141, 103, 175, 88, 153, 108, 157, 0,
+	0, 0, 0, 196, 0, 70, 77, 115, 94, 144,
+	98, 219, 178, 0, 114, 0, 116, 0, 0, 159,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	0, 0, 813, 0, 0, 814, 0, 0, 84, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 142, 0, 162, 104, 113, 71, 78, 0,
+	102, 131, 147, 151, 0, 0, 0, 89, 0, 149,
+	136, 174, 0, 137, 148, 117, 167, 143, 0, 183,
+	184, 164, 181, 191, 72, 163, 173, 85, 152, 74,
+	171, 161, 123, 109, 110, 73, 0, 146, 93, 99,
+	91, 132, 168, 169, 90, 194, 79, 180, 76, 80,
+	179, 130, 166, 172, 124, 121, 75, 170, 122, 120,
+	112, 97, 105, 139, 119, 140, 106, 127, 126, 128,
+	0, 0, 0, 160, 177, 195, 82, 0, 155, 165,
+	185, 186, 187, 188, 189, 190, 0, 0, 83, 100,
+	95, 138, 129, 81, 107, 156, 111, 118, 145, 193,
+	135, 150, 86, 176, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 70, 77, 115, 0,
+	144, 98, 219, 178, 134, 0, 192, 92, 87, 69,
+	0, 154, 141, 103, 175, 88, 153, 108, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 94, 0,
+	705, 0, 0, 0, 114, 0, 116, 0, 0, 159,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	0, 704, 0, 0, 0, 0, 0, 0, 84, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 142, 0, 162, 104, 113, 71, 78, 0,
+	102, 131, 147, 151, 0, 0, 0, 89, 0, 149,
+	136, 174, 0, 137, 148, 117, 167, 143, 0, 183,
+	184, 164, 181, 191, 72, 163, 173, 85, 152, 74,
+	171, 161, 123, 109, 110, 73, 0, 146, 93, 99,
+	91, 132, 168, 169, 90, 194, 79, 180, 76, 80,
+	179, 130, 166, 172, 124, 121, 75, 170, 122, 120,
+	112, 97, 105, 139, 119, 140, 106, 127, 126, 128,
+	0, 0, 0, 160, 177, 195, 82, 0, 155, 165,
+	185, 186, 187, 188, 189, 190, 0, 0, 83, 100,
+	95, 138, 129, 81, 107, 156, 111, 118, 145, 193,
+	135, 150, 86, 176, 158, 134, 0, 192, 92, 87,
+	69, 0, 154, 141, 103, 175, 88, 153, 108, 157,
+	0, 0, 0, 0, 196, 0, 70, 77, 115, 94,
+	144, 98, 219, 178, 0, 114, 0, 116, 0, 0,
+	159, 125, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 56, 0, 0,
+	683, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 96, 133, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 142, 0, 162, 104, 113, 71, 78,
+	0, 102, 131, 147, 151, 0, 0, 0, 89, 0,
+	149, 136, 174, 0, 137, 148, 117, 167, 143, 0,
+	183, 184, 164, 181, 191, 72, 163, 173, 85, 152,
+	74, 171, 161, 123, 109, 110, 73, 0, 146, 93,
+	99, 91, 132, 168, 169, 90, 194, 79, 180, 76,
+	80, 179, 130, 166, 172, 124, 121, 75, 170, 122,
+	120, 112, 97, 105, 139, 119, 140, 106, 127, 126,
+	128, 0, 0, 0, 160, 177, 195, 82, 0, 155,
+	165, 185, 186, 187, 188, 189, 190, 0, 0, 83,
+	100, 95, 138, 129, 81, 107, 156, 111, 118, 145,
+	193, 135, 150, 86, 176, 158, 134, 0, 192, 92,
+	87, 69, 0, 154, 141, 103, 175, 88, 153, 108,
+	157, 0, 0, 0, 0, 196, 0, 70, 77, 115,
+	94, 144, 98, 219, 178, 0, 114, 0, 116, 0,
+	0, 159, 125, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 67, 0, 66, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 133, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 182,
+	0, 0, 0, 0, 142, 0, 162, 104, 113, 71,
+	78, 0, 102, 131, 147, 151, 0, 0, 0, 89,
+	0, 149, 136, 174, 0, 137, 148, 117, 167, 143,
+	0, 183, 184, 164, 181, 191, 72, 163, 173, 85,
+	152, 74, 171, 161, 123, 109, 110, 73, 0, 146,
+	93, 99, 91, 132, 168, 169, 90, 194, 79, 180,
+	76, 80, 179, 130, 166, 172, 124, 121, 75, 170,
+	122, 120, 112, 97, 105, 139, 119, 140, 106, 127,
+	126, 128, 0, 0, 0, 160, 177, 195, 82, 0,
+	155, 165, 185, 186, 187, 188, 189, 190, 0, 0,
+	83, 100, 95, 138, 129, 81, 107, 156, 111, 118,
+	145, 193, 135, 150, 86, 176, 158, 134, 0, 192,
+	92, 87, 69, 0, 154, 141, 103, 175, 88, 153,
+	108, 157, 0, 0, 0, 0, 196, 0, 70, 77,
+	115, 94, 144, 98, 219, 178, 0, 114, 0, 116,
+	0, 0, 159, 125, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 631, 0, 0, 0, 0, 0,
+	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 133,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 142, 0, 162, 104, 113,
+	71, 78, 0, 102, 131, 147, 151, 0, 0, 0,
+	89, 0, 149, 136, 174, 0, 137, 148, 117, 167,
+	143, 0, 183, 184, 164, 181, 191, 72, 163, 173,
+	85, 152, 74, 171, 161, 123, 109, 110, 73, 0,
+	146, 93, 99, 91, 132, 168, 169, 90, 194, 79,
+	180, 76, 80, 179, 130, 166, 172, 124, 121, 75,
+	170, 122, 120, 112, 97, 105, 139, 119, 140, 106,
+	127, 126, 128, 0, 0, 0, 160, 177, 195, 82,
+	0, 155, 165, 185, 186, 187, 188, 189, 190, 0,
+	0, 83, 100, 95, 138, 129, 81, 107, 156, 111,
+	118, 145, 193, 135, 150, 86, 176, 158, 134, 0,
+	192, 92, 87, 69, 0, 154, 141, 103, 175, 88,
+	153, 108, 157, 0, 0, 0, 0, 196, 0, 70,
+	77, 115, 94, 144, 98, 219, 178, 0, 114, 0,
+	116, 0, 0, 159, 125, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 217, 0, 579, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	133, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 65, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 0, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 68, 75, 110, 91,
-	138, 95, 168, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 182, 0, 0, 0, 0, 142, 0, 162, 104,
+	113, 71, 78, 0, 102, 131, 147, 151, 0, 0,
+	0, 89, 0, 149, 136, 174, 0, 137, 148, 117,
+	167, 143, 0, 183, 184, 164, 181, 191, 72, 163,
+	173, 85, 152, 74, 171, 161, 123, 109, 110, 73,
+	0, 146, 93, 99, 91, 132, 168, 169, 90, 194,
+	79, 180, 76, 80, 179, 130, 166, 172, 124, 121,
+	75, 170, 122, 120, 112, 97, 105, 139, 119, 140,
+	106, 127, 126, 128, 0, 0, 0, 160, 177, 195,
+	82, 0, 155, 165, 185, 186, 187, 188, 189, 190,
+	0, 0, 83, 100, 95, 138, 129, 81, 107, 156,
+	111, 118, 145, 193, 135, 150, 86, 176, 158, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	70, 77, 115, 0, 144, 98, 219, 178, 134, 0,
+	192, 92, 87, 69, 0, 154, 141, 103, 175, 88,
+	153, 108, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 674, 94, 0, 0, 0, 0, 0, 114, 0,
+	116, 0, 0, 159, 125, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 67, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	133, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 230, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 75, 110, 0, 138, 95,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 225, 0, 0,
-	0, 0, 0, 0, 0, 234, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 182, 0, 0, 0, 0, 142, 0, 162, 104,
+	113, 71, 78, 0, 102, 131, 147, 151, 0, 0,
+	0, 89, 0, 149, 136, 174, 0, 137, 148, 117,
+	167, 143, 0, 183, 184, 164, 181, 191, 72, 163,
+	173, 85, 152, 74, 171, 161, 123, 109, 110, 73,
+	0, 146, 93, 99, 91, 132, 168, 169, 90, 194,
+	79, 180, 76, 80, 179, 130, 166, 172, 124, 121,
+	75, 170, 122, 120, 112, 97, 105, 139, 119, 140,
+	106, 127, 126, 128, 0, 0, 0, 160, 177, 195,
+	82, 0, 155, 165, 185, 186, 187, 188, 189, 190,
+	0, 0, 83, 100, 95, 138, 129, 81, 107, 156,
+	111, 118, 145, 193, 135, 150, 86, 176, 158, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	70, 77, 115, 358, 144, 98, 219, 178, 0, 0,
+	134, 0, 192, 92, 87, 69, 0, 154, 141, 103,
+	175, 88, 153, 108, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 94, 0, 0, 0, 0, 0,
+	114, 0, 116, 0, 0, 159, 125, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 236, 226, 227,
-	0, 237, 238, 239, 241, 0, 240, 246, 0, 0,
-	0, 228, 231, 0, 224, 245, 244,
+	0, 0, 0, 0, 0, 67, 0, 0, 0, 0,
+	0, 0, 0, 0, 84, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 133, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 182, 0, 0, 0, 0, 142, 0,
+	162, 104, 113, 71, 78, 0, 102, 131, 147, 151,
+	0, 0, 0, 89, 0, 149, 136, 174, 0, 137,
+	148, 117, 167, 143, 0, 183, 184, 164, 181, 191,
+	72, 163, 173, 85, 152, 74, 171, 161, 123, 109,
+	110, 73, 0, 146, 93, 99, 91, 132, 168, 169,
+	90, 194, 79, 180, 76, 80, 179, 130, 166, 172,
+	124, 121, 75, 170, 122, 120, 112, 97, 105, 139,
+	119, 140, 106, 127, 126, 128, 0, 0, 0, 160,
+	177, 195, 82, 0, 155, 165, 185, 186, 187, 188,
+	189, 190, 0, 0, 83, 100, 95, 138, 129, 81,
+	107, 156, 111, 118, 145, 193, 135, 150, 86, 176,
+	158, 134, 0, 192, 92, 87, 69, 0, 154, 141,
+	103, 175, 88, 153, 108, 157, 0, 0, 0, 0,
+	196, 0, 70, 77, 115, 94, 144, 98, 219, 178,
+	0, 114, 0, 116, 0, 0, 159, 125, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 67, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 133, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 230, 0, 0, 182, 0, 0, 0, 0, 142,
+	0, 162, 104, 113, 71, 78, 0, 102, 131, 147,
+	151, 0, 0, 0, 89, 0, 149, 136, 174, 0,
+	137, 148, 117, 167, 143, 0, 183, 184, 164, 181,
+	191, 72, 163, 173, 85, 152, 74, 171, 161, 123,
+	109, 110, 73, 0, 146, 93, 99, 91, 132, 168,
+	169, 90, 194, 79, 180, 76, 80, 179, 130, 166,
+	172, 124, 121, 75, 170, 122, 120, 112, 97, 105,
+	139, 119, 140, 106, 127, 126, 128, 0, 0, 0,
+	160, 177, 195, 82, 0, 155, 165, 185, 186, 187,
+	188, 189, 190, 0, 0, 83, 100, 95, 138, 129,
+	81, 107, 156, 111, 118, 145, 193, 135, 150, 86,
+	176, 158, 134, 0, 192, 92, 87, 69, 0, 154,
+	141, 103, 175, 88, 153, 108, 157, 0, 0, 0,
+	0, 196, 0, 70, 77, 115, 94, 144, 98, 219,
+	178, 0, 114, 0, 116, 0, 0, 159, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 67, 0, 66,
+	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 133, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 182, 0, 0, 0, 0,
+	142, 0, 162, 104, 113, 71, 78, 0, 102, 131,
+	147, 151, 0, 0, 0, 89, 0, 149, 136, 174,
+	0, 137, 148, 117, 167, 143, 0, 183, 184, 164,
+	181, 191, 72, 163, 173, 85, 152, 74, 171, 161,
+	123, 109, 110, 73, 0, 146, 93, 99, 91, 132,
+	168, 169, 90, 194, 79, 180, 76, 80, 179, 130,
+	166, 172, 124, 121, 75, 170, 122, 120, 112, 97,
+	105, 139, 119, 140, 106, 127, 126, 128, 0, 0,
+	0, 160, 177, 195, 82, 0, 155, 165, 185, 186,
+	187, 188, 189, 190, 0, 0, 83, 100, 95, 138,
+	129, 81, 107, 156, 111, 118, 145, 193, 135, 150,
+	86, 176, 158, 134, 0, 192, 92, 87, 69, 0,
+	154, 141, 103, 175, 88, 153, 108, 157, 0, 0,
+	0, 0, 196, 0, 70, 77, 115, 94, 144, 98,
+	62, 178, 0, 114, 0, 116, 0, 0, 159, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 133, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 142, 0, 162, 104, 113, 71, 78, 0, 102,
+	131, 147, 151, 0, 0, 0, 89, 0, 149, 136,
+	174, 0, 137, 148, 117, 167, 143, 0, 183, 184,
+	164, 181, 191, 72, 163, 173, 85, 152, 74, 171,
+	161, 123, 109, 110, 73, 0, 146, 93, 99, 91,
+	132, 168, 169, 90, 194, 79, 180, 76, 80, 179,
+	130, 166, 172, 124, 121, 75, 170, 122, 120, 112,
+	97, 105, 139, 119, 140, 106, 127, 126, 128, 0,
+	0, 0, 160, 177, 195, 82, 0, 155, 165, 185,
+	186, 187, 188, 189, 190, 0, 0, 83, 100, 95,
+	138, 129, 81, 107, 156, 111, 118, 145, 193, 135,
+	150, 86, 176, 158, 134, 0, 192, 92, 87, 69,
+	0, 154, 141, 103, 175, 88, 153, 108, 157, 0,
+	0, 0, 0, 196, 0, 70, 77, 115, 94, 144,
+	98, 219, 178, 0, 114, 0, 116, 0, 0, 159,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 67,
+	0, 0, 0, 0, 0, 0, 0, 0, 84, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 182, 0, 0,
+	0, 0, 142, 0, 162, 104, 113, 71, 78, 0,
+	102, 131, 147, 151, 0, 0, 0, 89, 0, 149,
+	136, 174, 0, 137, 148, 117, 167, 143, 0, 183,
+	184, 164, 181, 191, 72, 163, 173, 85, 152, 74,
+	171, 161, 123, 109, 110, 73, 0, 146, 93, 99,
+	91, 132, 168, 169, 90, 194, 79, 180, 76, 80,
+	179, 130, 166, 172, 124, 121, 75, 170, 122, 120,
+	112, 97, 105, 139, 119, 140, 106, 127, 126, 128,
+	0, 0, 0, 160, 177, 195, 82, 0, 155, 165,
+	185, 186, 187, 188, 189, 190, 0, 0, 83, 100,
+	95, 138, 129, 81, 107, 156, 111, 118, 145, 193,
+	135, 150, 86, 176, 158, 134, 0, 192, 92, 87,
+	69, 0, 154, 141, 103, 175, 88, 153, 108, 157,
+	0, 0, 0, 0, 196, 0, 70, 77, 115, 94,
+	144, 98, 219, 178, 0, 114, 0, 116, 0, 0,
+	159, 125, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	294, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 96, 133, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 142, 0, 162, 104, 113, 71, 78,
+	0, 102, 131, 147, 151, 0, 0, 0, 89, 0,
+	149, 136, 174, 0, 137, 148, 117, 167, 143, 0,
+	183, 184, 164, 181, 191, 72, 163, 173, 85, 152,
+	74, 171, 161, 123, 109, 110, 73, 0, 146, 93,
+	99, 91, 132, 168, 169, 90, 194, 79, 180, 76,
+	80, 179, 130, 166, 172, 124, 121, 75, 170, 122,
+	120, 112, 97, 105, 139, 119, 140, 106, 127, 126,
+	128, 0, 0, 0, 160, 177, 195, 82, 0, 155,
+	165, 185, 186, 187, 188, 189, 190, 0, 0, 83,
+	100, 95, 138, 129, 81, 107, 156, 111, 118, 145,
+	193, 135, 150, 86, 176, 158, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 70, 77, 115,
+	0, 144, 98, 219, 178,
 }
 
-var yyPact = [...]int{
-	1745, -1000, -200, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 910, 12205, 939, -1000, -1000, -1000, -1000, -1000,
-	-1000, 280, 9931, -14, 150, 51, 13214, 149, 14159, 13710,
-	-1000, -4, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -103,
-	-105, -1000, 94, -1000, -1000, -1000, -1000, -1000, 894, 907,
-	683, -1000, 868, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 707, 867, 795, -1000,
-	7828, 113, 113, 12966, 6237, -1000, -1000, 373, 13710, 142,
-	13710, -162, 109, 109, 109, -1000, -1000, -1000, -1000, 147,
-	13710, 382, -1000, 13710, 101, 544, 101, 101, 101, 13710,
-	-1000, 196, 13710, 522, 3735, 297, 3735, 3735, -1000, 3735,
-	3735, -1000, 3735, 2, 3735, -2, 929, -1000, -1000, -1000,
-	-1000, -43, -1000, 3735, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 547, 840, 8623,
-	8623, 94, 12205, 685, 910, -1000, 94, -1000, -1000, -1000,
-	826, -1000, -1000, 379, 942, -1000, 2822, 195, 52, -1000,
-	8623, 685, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9418,
-	9418, 9418, 9418, 9418, 9418, 9418, 9418, -1000, -1000, -1000,
-	-1000, 685, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 685, -1000, 7033, 685, 685, 685, 685, 685,
-	685, 685, 685, 8623, 685, 685, 685, 685, 685, 685,
-	685, 685, 685, 685, 685, 685, 685, 685, 685, 12718,
-	11957, 13710, 682, 645, -1000, -1000, 194, 674, 5959, -130,
-	-1000, -1000, -1000, 287, 11709, -1000, -1000, -1000, 828, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 611, 13710, -1000, 2501,
-	-1000, 515, 3735, 118, 512, 342, 509, 13710, 13710, 3735,
-	25, 74, 145, 13710, 679, 116, 13710, 858, 754, 13710,
-	507, 504, -1000, 5681, -1000, 3735, -1000, -1000, -1000, 3735,
-	3735, 3735, 13710, 3735, 3735, -1000, -1000, -1000, -1000, -1000,
-	3735, 3735, -1000, 940, 340, -1000, -1000, -1000, -1000, 8623,
-	-1000, 750, -1000, -1000, -1000, -1000, -1000, -1000, 956, 238,
-	437, 1937, 191, 678, -1000, 444, -1000, -1000, 94, 894,
-	547, 795, 11457, 765, -1000, -1000, 13710, -1000, 8623, 8623,
-	521, -1000, 12453, -1000, -1000, 4569, -1000, 9418, 416, 346,
-	9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418,
-	9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 9418, 478,
-	9418, 10961, 13462, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	230, -1000, 497, 53, 53, 53, 53, 53, 53, 53,
-	9683, -1000, 94, 7298, 547, 607, 496, 7033, 7828, 7828,
-	8623, 8623, 8358, 8093, 7828, 871, 335, 496, 13958, -1000,
-	-1000, 9153, -1000, -1000, -1000, -1000, -1000, 547, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 13462, 13462, 7828, 7828, 7828,
-	7828, 61, 13710, -1000, 635, 997, -1000, -1000, -1000, 861,
-	10448, 685, 11209, 61, 628, 11957, 13710, -1000, -1000, 11957,
-	13710, 4291, 5403, 674, -130, 642, -1000, -126, -137, 6767,
-	206, -1000, -1000, -1000, -1000, 3457, 313, 602, 464, -92,
-	-1000, -1000, -1000, 690, -1000, 690, 690, 690, 690, -61,
-	-61, -61, -61, -1000, -1000, -1000, -1000, -1000, 708, 706,
-	-1000, 690, 690, 690, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 699, 699, 699, 697, 697, 728, -1000, 13710,
-	3735, 855, 3735, -1000, 417, -1000, 13462, 13462, 13710, 13710,
-	157, 13710, 13710, 654, -1000, 13710, 3735, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 13710, 355, 13710, 13710, 496, 13710, -1000, 807, 8623,
-	8623, 5125, 8623, -1000, -1000, -1000, 547, 840, -1000, 871,
-	916, -1000, 820, 818, 7828, -1000, -1000, 230, 277, -1000,
-	-1000, 524, -1000, -1000, -1000, -1000, 184, 685, -1000, 3040,
-	-1000, -1000, -1000, -1000, 416, 9418, 9418, 9418, 3011, 3040,
-	3040, 3040, 3040, 3040, 9919, 2657, 2348, 53, 72, 72,
-	11, 11, 11, 11, 11, 881, 881, -1000, -1000, -1000,
-	163, -1000, -1000, -1000, -1000, -1000, -1000, 547, -1000, 547,
-	7828, 651, -1000, -1000, 8623, -1000, 547, 594, 594, 402,
-	452, 937, 936, 594, 935, 934, 594, 594, 7828, 411,
-	-1000, 8623, 547, -1000, 181, -1000, 1741, 649, 644, 594,
-	547, 594, 594, 73, 685, -1000, 13958, 11957, 777, 11957,
-	11957, 11957, -1000, -1000, -1000, 785, 783, 792, 767, 13710,
-	-1000, 600, 10448, 13462, 205, 685, -1000, 12205, 928, 11957,
-	630, -1000, 630, -1000, 180, -1000, -1000, 642, -130, -140,
-	-1000, -1000, -1000, -1000, 496, -1000, 501, 641, 3179, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 694, 492, -1000, 846,
-	227, 252, 489, 845, -1000, -1000, -1000, 832, -1000, 362,
-	-96, -1000, -1000, 442, -61, -61, -1000, -1000, 206, 827,
-	206, 206, 206, 466, 466, -1000, -1000, -1000, -1000, 439,
-	-1000, -1000, -1000, 438, -1000, 745, 13462, 3735, -1000, -1000,
-	-1000, -1000, 653, 653, 226, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 50, 713, -1000, -1000,
-	-1000, 22, 21, 115, -1000, 3735, -1000, 340, -1000, 462,
-	8623, -1000, -1000, -1000, 804, 496, 496, 174, -1000, -1000,
-	-1000, 13710, -1000, -1000, -1000, -1000, 671, -1000, -1000, -1000,
-	4013, 7828, -1000, 3011, 3040, 2702, -1000, 9418, 9418, -1000,
-	-1000, -1000, 594, 7828, 496, -1000, -1000, -1000, 10961, 478,
-	10961, 9418, 9418, -1000, 9418, 9418, -1000, -175, 650, 329,
-	-1000, 8623, 441, -1000, 5125, -1000, 9418, 9418, -1000, -1000,
-	-1000, -1000, 744, 13958, 685, -1000, 10196, 13462, 646, -1000,
-	281, 997, 11957, -1000, 790, 788, 738, 969, -1000, -1000,
-	769, -1000, 768, -1000, -1000, -1000, -1000, -1000, 547, 638,
-	-1000, 233, -1000, 141, 126, 124, 13462, -1000, 910, 8623,
-	630, -1000, -1000, 216, -1000, -1000, -145, -146, -1000, -1000,
-	-1000, 3457, -1000, 3457, 13462, 82, -1000, 489, 489, -1000,
-	-1000, -1000, 692, 737, 9418, -1000, -1000, -1000, 571, 206,
-	206, -1000, 260, -1000, -1000, -1000, 584, -1000, 578, 637,
-	574, 13710, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13710, -1000,
-	-1000, -1000, -1000, -1000, 13462, -188, 482, 13462, 13462, 13710,
-	-1000, 355, -1000, 496, -1000, 4847, -1000, 928, 11957, -1000,
-	-1000, 547, -1000, 9418, 3040, 3040, -1000, -1000, 547, 547,
-	547, 2633, 2449, 2196, 2099, 685, -170, -1000, 496, 8623,
-	-1000, 1828, 1579, -1000, 829, 616, 629, -1000, -1000, 7563,
-	547, 570, 168, 568, -1000, 910, 13958, 8623, 735, -1000,
-	-1000, -1000, 8623, -1000, 8623, 691, -1000, -1000, 861, 13462,
-	6502, 685, 685, 685, 568, 894, 496, -1000, -1000, -1000,
-	-1000, 3179, -1000, 563, -1000, 690, -1000, -1000, -1000, 13462,
-	-87, 955, 3040, -1000, -1000, -1000, -1000, -1000, -61, 461,
-	-61, 435, -1000, 424, 3735, -1000, -1000, -1000, -1000, 849,
-	-1000, 4847, -1000, -1000, 688, -1000, -1000, -1000, 919, 634,
-	-1000, 3040, -1000, -1000, -1000, 9418, 9418, 9418, 9418, 9418,
-	547, 455, 496, 9418, 9418, 844, -1000, 685, -1000, -1000,
-	79, 13462, 13462, -1000, 13462, 894, -1000, 496, -1000, -1000,
-	496, 496, 13462, 13710, -1000, -1000, 496, 685, 685, 13462,
-	13462, 13462, 10713, -1000, 258, 13462, -1000, 561, -1000, 210,
-	-1000, -57, 206, -1000, 206, 564, 556, -1000, 685, 632,
-	-1000, 271, 13462, 915, 904, 1741, 1741, 1741, 1741, 1435,
-	-1000, -1000, 1741, 1741, 946, -1000, 685, -1000, 94, 164,
-	-1000, -1000, -1000, 559, -1000, 11957, 13958, 553, 553, 553,
-	205, 258, -1000, 473, 257, 454, -1000, 64, 13462, 367,
-	842, -1000, 835, -1000, -1000, -1000, -1000, -1000, 48, 4847,
-	3457, 551, 27, 8623, 8623, -1000, -1000, -1000, -1000, 547,
-	44, -191, -1000, -1000, 13958, 629, 547, 13462, -1000, 856,
-	547, -1000, -1000, -1000, -1000, -1000, -1000, 396, -1000, -1000,
-	13710, -1000, -1000, 450, -1000, -1000, 543, -1000, 13462, -1000,
-	-1000, 713, -1000, 752, 496, 627, -1000, 803, -186, -194,
-	618, -1000, -1000, -1000, -1000, -1000, 687, -1000, -1000, 48,
-	817, -188, 614, -1000, 395, 872, 8623, -1000, 800, -1000,
-	13462, -1000, 45, -1000, 752, -1000, 261, 8623, 496, -189,
-	527, 41, -1000, 960, 496, -192, 733, 685, -1000, -195,
-	731, -1000, 933, 8888, -1000, -1000, 945, 266, 266, 1741,
-	547, -1000, -1000, -1000, 92, 401, -1000, -1000, -1000, -1000,
-	-1000, -1000,
+var yyPact = [...]int16{
+	2121, -32768, -205, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 1069, 16511, 1069, -32768, -32768, -32768, -32768, -32768,
+	-32768, 311, 12512, 67, 143, -23, 16260, 142, 3014, 17013,
+	-32768, 19, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -95,
+	-107, -32768, 1124, -32768, -32768, 115, -32768, -32768, -32768, 1060,
+	1066, 821, 14945, -32768, 998, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 1060, 932, -32768,
+	8866, 100, 100, 16009, 7155, -32768, -32768, 274, 17013, 135,
+	17013, -167, 109, 109, 109, -32768, -32768, -32768, -32768, -32768,
+	141, 17013, 405, -32768, 17013, 97, 625, 97, 97, 97,
+	17013, -32768, 197, 17013, 623, 4500, 336, 4500, 4500, -32768,
+	4500, 4500, -32768, 4500, 39, 4500, -40, 1088, -32768, -32768,
+	-32768, -32768, -47, -32768, 4500, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 853, 853,
+	1027, 1022, 1002, 639, 1006, 9998, 9998, 115, 14945, 821,
+	823, 1006, 962, -32768, -32768, 398, 1104, 1121, 12261, 196,
+	51, -32768, 9998, 823, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 11698, 11698, 11698, 11698, 11698, 11698, 11698, 11698, -32768,
+	-32768, -32768, -32768, 9998, -32768, 15196, -32768, 823, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 823, -32768,
+	8300, 823, 823, 823, 823, 823, 823, 823, 823, 9998,
+	823, 823, 823, 823, 823, 823, 823, 823, 823, 823,
+	823, 823, 823, 823, 823, 823, 15727, 14694, 17013, 817,
+	814, -32768, -32768, 194, 816, 6860, -113, -32768, -32768, -32768,
+	314, 14443, -32768, -32768, -32768, 970, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 742,
+	17013, -32768, 2407, -32768, 605, 4500, 129, 597, 335, 580,
+	17013, 17013, 4500, 43, 81, 140, 17013, 820, 120, 17013,
+	995, 878, 17013, 576, 569, -32768, 6565, -32768, 4500, -32768,
+	-32768, -32768, 4500, 4500, 4500, 17013, 4500, 4500, -32768, -32768,
+	-32768, -32768, -32768, 4500, 4500, -32768, 1103, 341, -32768, -32768,
+	-32768, -32768, 9998, -32768, 877, -32768, -32768, -32768, 1119, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 1112, 231, 708, 1691, 192, 819, -32768, 634,
+	-32768, -32768, 115, 115, -32768, 14163, 901, -32768, -32768, 17013,
+	-168, 823, -32768, 9998, 9998, 720, -32768, 15447, -32768, -32768,
+	5385, -32768, 11698, 521, 472, 11698, 11698, 11698, 11698, 11698,
+	11698, 11698, 11698, 11698, 11698, 11698, 11698, 11698, 11698, 11698,
+	11698, 11698, 11698, 11698, 534, 11413, 13072, 16762, 1, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, 252, -32768, 556, 52,
+	52, 52, 52, 52, 52, 52, 11981, 20, 478, 16,
+	-32768, -211, -213, -32768, 115, 8583, 639, 738, 8300, 8866,
+	8866, 9998, 9998, 9715, 9432, 8866, 1008, 327, 478, 17264,
+	16762, -32768, -32768, 11130, -32768, -32768, -32768, -32768, -32768, 639,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 16762, 16762, 8866,
+	8866, 8866, 8866, 68, 17013, -32768, 818, 990, 1032, -32768,
+	-32768, 997, 13352, 823, 13912, 68, 750, 14694, 17013, -32768,
+	-32768, 14694, 17013, 5090, 6270, 816, -113, 786, -32768, -132,
+	-129, 8013, 203, -32768, -32768, -32768, -32768, 4205, 429, 683,
+	463, -57, -32768, -32768, -32768, 843, -32768, 843, 843, 843,
+	843, -14, -14, -14, -14, -32768, -32768, -32768, -32768, -32768,
+	860, 856, -32768, 843, 843, 843, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, 854, 854, 854, 851, 851, 863,
+	-32768, 17013, 4500, 993, 4500, -32768, 526, -32768, 16762, 16762,
+	17013, 17013, 150, 17013, 17013, 802, -32768, 17013, 4500, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, 17013, 368, 17013, 17013, 478, 17013, 932,
+	-32768, 947, 9998, 9998, 5975, 9998, -32768, -32768, -32768, -32768,
+	639, 1080, -32768, 963, 961, 8866, -32768, -32768, -32768, 823,
+	16762, 252, 390, -32768, 1102, 659, -32768, -32768, -32768, -32768,
+	1121, 190, 823, -32768, 3020, -32768, -32768, -32768, -32768, 521,
+	11698, 11698, 11698, 2891, 3020, 3020, 3020, 3020, 3020, 2953,
+	1475, 2154, 66, 516, 516, 62, 62, 62, 62, 62,
+	137, 137, -32768, -32768, -32768, 273, 11698, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -180, 639, -32768, 9998, -32768, -32768,
+	15196, 9998, 9998, 639, 8866, 801, -32768, -32768, -32768, 229,
+	156, -32768, -32768, 639, 630, -32768, 630, 434, 609, 1101,
+	1100, 630, 1099, 1098, 630, 630, 8866, 378, -32768, 9998,
+	639, -32768, 188, 1097, -32768, 1555, 797, 795, 630, 639,
+	791, 630, 630, 199, 823, -32768, 17264, 14694, 894, 14694,
+	14694, 14694, -32768, -32768, -32768, 923, 904, 928, 945, 823,
+	823, 17013, -32768, 732, 13352, 16762, 211, 823, -32768, 14945,
+	1084, 14694, 751, -32768, 751, -32768, 186, -32768, -32768, 786,
+	-113, -70, -32768, -32768, -32768, -32768, 478, -32768, 537, 774,
+	3910, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 847, 552,
+	-32768, 981, 284, 272, 550, 980, -32768, -32768, -32768, 974,
+	-32768, 340, -89, -32768, -32768, 484, -14, -14, -32768, -32768,
+	203, 969, 203, 203, 203, 522, 522, -32768, -32768, -32768,
+	-32768, 476, -32768, -32768, -32768, 447, -32768, 876, 16762, 4500,
+	-32768, -32768, -32768, -32768, 807, 807, 448, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 63, 862,
+	-32768, -32768, -32768, 38, 31, 118, -32768, 4500, -32768, 341,
+	-32768, 515, 9998, -32768, -32768, -32768, 1008, 941, 478, 478,
+	185, -32768, -32768, 17013, -32768, -32768, -32768, -32768, 781, 8866,
+	616, -32768, 11698, 1096, -32768, -32768, -32768, -168, 4795, 8866,
+	-32768, 2891, 3020, 2766, -32768, 11698, 11698, -32768, 10847, 413,
+	11698, -32768, 478, -32768, 478, 478, 1049, 630, 8866, 9998,
+	9998, -32768, 8866, -32768, -32768, 13072, 534, 13072, 11698, 11698,
+	-32768, 11698, 11698, -32768, -183, 811, 319, -32768, 9998, 449,
+	-32768, 5975, 9998, -32768, 11698, 11698, -32768, -32768, -32768, -32768,
+	870, 17264, 823, -32768, 12792, 16762, 799, -32768, 313, 990,
+	14694, -32768, 926, 921, 886, 1029, 1032, -32768, 912, -32768,
+	903, -32768, -32768, -32768, 8866, 16762, -32768, -32768, 639, 773,
+	-32768, 225, -32768, 133, 132, 131, 16762, -32768, 1069, 9998,
+	751, -32768, -32768, 216, -32768, -32768, -147, -154, -32768, -32768,
+	-32768, 4205, -32768, 4205, 16762, 85, -32768, 550, 550, -32768,
+	-32768, -32768, 846, 869, 11698, -32768, -32768, -32768, 649, 203,
+	203, -32768, 293, -32768, -32768, -32768, 718, -32768, 711, 772,
+	709, 17013, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 17013, -32768,
+	-32768, -32768, -32768, -32768, 16762, -190, 545, 16762, 16762, 17013,
+	-32768, 368, -32768, 478, -32768, 5680, -32768, 1084, 14694, 630,
+	-32768, 16762, 3020, 11698, -32768, 1121, -32768, 639, -32768, 11698,
+	3020, 3020, 344, -32768, -32768, 52, 823, -32768, -32768, 478,
+	478, -32768, 639, 639, 639, 2691, 2664, 2632, 2033, 823,
+	-175, -32768, 478, 9998, -32768, 486, 1901, 784, -32768, 987,
+	667, 761, -32768, -32768, 9149, 639, 678, 181, 662, -32768,
+	1069, 17264, 9998, 808, -32768, -32768, -32768, 9998, -32768, 9998,
+	844, -32768, -32768, 746, 1056, 997, 16762, 7730, 823, 823,
+	823, 662, 1060, 478, -32768, -32768, -32768, -32768, 3910, -32768,
+	658, -32768, 843, -32768, -32768, -32768, 16762, -51, 1111, 3020,
+	-32768, -32768, -32768, -32768, -32768, -14, 514, -14, 444, -32768,
+	419, 4500, -32768, -32768, -32768, -32768, 991, -32768, 5680, -32768,
+	-32768, 842, -32768, -32768, -32768, 1082, 768, -32768, -32768, 3020,
+	-168, -32768, 3020, -32768, 60, -32768, -32768, -32768, 11698, 11698,
+	11698, 11698, 11698, 639, 496, 478, -32768, 11698, 11698, 979,
+	-32768, 823, -32768, -32768, 145, 16762, 16762, -32768, 16762, 1060,
+	-32768, 478, -32768, -32768, 478, 478, 16762, 17264, 16762, 17013,
+	-32768, -32768, 478, 823, 823, 16762, 16762, 16762, 13632, -32768,
+	247, 16762, -32768, 648, 222, -32768, 99, 203, -32768, 203,
+	646, 644, -32768, 823, 767, -32768, 302, 16762, 1079, 1063,
+	-32768, 639, 1069, 1062, 1555, 1555, 1555, 1555, 187, -32768,
+	-32768, 1555, 1555, 1109, -32768, 823, -32768, 115, 161, -32768,
+	-32768, -32768, 643, 228, 227, -32768, 14694, 17264, 616, 616,
+	616, 211, 247, -32768, 530, 281, 489, -32768, 80, 388,
+	978, -32768, 976, -32768, -32768, -32768, -32768, -32768, 57, 5680,
+	4205, 637, 45, 9998, 10281, -32768, 1047, 9998, -32768, -32768,
+	-32768, -32768, 639, 40, -194, -32768, -32768, 17264, 761, 639,
+	16762, -32768, 823, 823, 788, 639, -32768, -32768, -32768, -32768,
+	-32768, -32768, 395, -32768, -32768, 17013, -32768, 473, -32768, -32768,
+	635, -32768, 16762, -32768, -32768, 862, -32768, 872, 478, 758,
+	-32768, 478, 1035, -32768, 647, 749, -32768, 940, -188, -198,
+	726, -32768, -32768, 8866, 16762, -32768, -32768, -32768, 840, -32768,
+	-32768, 57, 960, -190, 687, -32768, 417, 1053, 9998, 10281,
+	823, -32768, 585, 1044, 1024, 1042, -32768, 939, -32768, 630,
+	616, 16762, -32768, 53, -32768, 872, -32768, 316, 9998, 478,
+	-32768, 9998, 364, -32768, -32768, -32768, -32768, -32768, -192, 639,
+	639, 591, 50, -32768, 1116, 478, 562, -32768, 478, 7447,
+	585, -196, 13632, 13632, 868, 823, -32768, -32768, 9998, -32768,
+	-32768, -200, -32768, -32768, 865, -32768, 1092, 10564, -32768, -32768,
+	-32768, 1108, 334, 334, 1555, 639, -32768, -32768, -32768, 89,
+	475, -32768, -32768, -32768, -32768, -32768, -32768,
 }
 
-var yyPgo = [...]int{
-	0, 1199, 50, 173, 1196, 1193, 1192, 106, 1191, 1190,
-	1189, 1188, 1187, 1186, 1185, 1184, 1182, 1181, 1180, 1179,
-	1170, 1168, 1167, 1164, 1163, 1162, 1161, 1157, 1156, 219,
-	1154, 1153, 1150, 74, 1148, 75, 1147, 1146, 53, 222,
-	54, 49, 376, 1142, 31, 27, 52, 1138, 1135, 1134,
-	23, 1130, 25, 1128, 1127, 76, 1125, 1124, 60, 1122,
-	1120, 112, 1119, 71, 1118, 12, 35, 1117, 1116, 1112,
-	1111, 1110, 881, 1109, 1108, 17, 1106, 1103, 97, 1101,
-	62, 7, 15, 13, 21, 1100, 29, 6, 1099, 63,
-	1098, 1097, 1096, 1095, 42, 1091, 57, 1090, 34, 65,
-	1088, 1086, 4, 1085, 16, 82, 46, 28, 5, 77,
-	69, 1084, 30, 72, 61, 1083, 1082, 170, 1080, 1079,
-	55, 1078, 1077, 32, 224, 139, 1075, 1070, 1065, 1064,
-	44, 0, 1230, 236, 73, 1062, 1061, 1056, 1775, 48,
-	19, 22, 26, 47, 278, 39, 1055, 1054, 45, 1050,
-	1048, 1042, 1040, 1039, 1038, 1037, 84, 1035, 1034, 1033,
-	36, 20, 1032, 1030, 70, 64, 1028, 1025, 1022, 58,
-	68, 1014, 1013, 59, 43, 1012, 1010, 1008, 1006, 1005,
-	41, 9, 996, 18, 995, 10, 985, 984, 38, 983,
-	14, 982, 8, 979, 11, 975, 2, 56, 3, 973,
-	1, 970, 969, 66, 783, 78, 968, 81,
+var yyPgo = [...]int16{
+	0, 1403, 61, 166, 162, 133, 129, 125, 951, 1398,
+	1394, 1393, 1392, 1391, 1390, 1389, 1388, 1387, 1386, 1385,
+	1384, 1383, 1382, 1381, 1379, 1377, 1376, 1375, 1372, 92,
+	1369, 1368, 1366, 1365, 84, 1364, 78, 1361, 1360, 56,
+	14, 54, 29, 44, 53, 1358, 1355, 1354, 420, 1350,
+	55, 27, 50, 1349, 1344, 1343, 30, 1339, 34, 1336,
+	1334, 83, 1332, 1331, 71, 1328, 1324, 65, 1322, 88,
+	1321, 20, 49, 1320, 1316, 1315, 1314, 1313, 1839, 1312,
+	1310, 22, 1309, 1308, 109, 1307, 74, 12, 18, 21,
+	23, 46, 1306, 75, 47, 17, 1301, 68, 1267, 1265,
+	1264, 1263, 1261, 8, 3, 1260, 39, 1259, 1258, 1257,
+	1253, 4, 76, 1251, 28, 126, 1250, 1248, 6, 1247,
+	26, 43, 87, 38, 35, 10, 86, 80, 1245, 31,
+	81, 70, 1244, 1243, 213, 1234, 1233, 59, 1232, 1230,
+	58, 282, 219, 1228, 1225, 1221, 1219, 52, 0, 2355,
+	72, 85, 1217, 1216, 1214, 1435, 67, 73, 5, 25,
+	95, 105, 51, 1213, 1211, 42, 1210, 1209, 1204, 1203,
+	1202, 1201, 1200, 69, 1199, 1198, 1195, 32, 41, 1193,
+	1190, 77, 79, 1187, 1180, 1177, 60, 82, 1172, 1171,
+	66, 48, 1170, 1168, 1166, 1165, 1164, 45, 13, 1163,
+	19, 1162, 16, 1161, 1160, 36, 1159, 11, 1157, 15,
+	1146, 7, 1144, 9, 57, 1, 1140, 2, 1139, 1137,
+	937, 451, 89, 1133, 99,
 }
 
-var yyR1 = [...]int{
-	0, 201, 202, 202, 1, 1, 1, 1, 1, 1,
+var yyR1 = [...]uint8{
+	0, 218, 219, 219, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 6, 6,
-	8, 8, 7, 9, 3, 4, 5, 5, 10, 10,
-	32, 32, 11, 12, 12, 12, 12, 205, 205, 55,
-	55, 56, 56, 105, 105, 13, 13, 13, 13, 110,
-	110, 114, 114, 114, 115, 115, 115, 115, 146, 146,
-	14, 14, 14, 14, 14, 14, 14, 196, 196, 195,
-	194, 194, 193, 193, 192, 20, 176, 178, 178, 177,
-	177, 177, 177, 170, 149, 149, 149, 149, 152, 152,
-	150, 150, 150, 150, 150, 150, 150, 150, 150, 151,
-	151, 151, 151, 151, 153, 153, 153, 153, 153, 154,
-	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 155, 155, 155, 155, 155, 155,
-	155, 155, 169, 169, 156, 156, 164, 164, 165, 165,
-	165, 162, 162, 163, 163, 166, 166, 166, 158, 158,
-	159, 159, 167, 167, 160, 160, 160, 161, 161, 161,
-	168, 168, 168, 168, 168, 157, 157, 171, 171, 186,
-	186, 185, 185, 185, 175, 175, 182, 182, 182, 182,
-	182, 173, 173, 174, 174, 184, 184, 183, 172, 172,
-	188, 188, 188, 188, 199, 200, 198, 198, 198, 198,
-	198, 179, 179, 179, 180, 180, 180, 181, 181, 181,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 197, 197, 197, 197, 197, 197, 197,
-	197, 197, 197, 197, 191, 189, 189, 190, 190, 16,
-	21, 21, 17, 17, 17, 17, 17, 18, 18, 22,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 6,
+	6, 8, 8, 7, 9, 3, 4, 4, 5, 5,
+	5, 10, 10, 33, 33, 11, 12, 12, 12, 12,
+	222, 222, 61, 61, 62, 62, 122, 122, 13, 13,
+	13, 13, 127, 127, 131, 131, 131, 132, 132, 132,
+	132, 163, 163, 14, 14, 14, 14, 14, 14, 14,
+	213, 213, 212, 211, 211, 210, 210, 209, 20, 193,
+	195, 195, 194, 194, 194, 194, 187, 166, 166, 166,
+	166, 169, 169, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 168, 168, 168, 168, 168, 170, 170, 170,
+	170, 170, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 172, 172, 172,
+	172, 172, 172, 172, 172, 186, 186, 173, 173, 181,
+	181, 182, 182, 182, 179, 179, 180, 180, 183, 183,
+	183, 175, 175, 176, 176, 184, 184, 177, 177, 177,
+	178, 178, 178, 185, 185, 185, 185, 185, 174, 174,
+	188, 188, 203, 203, 202, 202, 202, 192, 192, 199,
+	199, 199, 199, 199, 190, 190, 191, 191, 201, 201,
+	200, 189, 189, 205, 205, 205, 205, 216, 217, 215,
+	215, 215, 215, 215, 196, 196, 196, 197, 197, 197,
+	198, 198, 198, 15, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 208, 206, 206,
+	207, 207, 16, 21, 21, 17, 17, 17, 17, 17,
+	18, 18, 22, 23, 23, 23, 23, 23, 23, 23,
 	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
 	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 121, 121, 119, 119, 122, 122, 120, 120, 120,
-	123, 123, 123, 147, 147, 147, 24, 24, 26, 26,
-	27, 28, 25, 25, 25, 25, 25, 25, 25, 19,
-	206, 29, 30, 30, 31, 31, 31, 35, 35, 35,
-	33, 33, 34, 34, 40, 40, 39, 39, 41, 41,
-	41, 41, 41, 135, 135, 135, 134, 134, 43, 43,
-	44, 44, 45, 45, 46, 46, 46, 46, 46, 64,
-	64, 49, 49, 48, 48, 50, 51, 51, 51, 104,
-	104, 106, 106, 47, 47, 47, 47, 52, 52, 53,
-	53, 54, 54, 142, 142, 141, 141, 141, 187, 187,
-	187, 140, 140, 57, 57, 57, 59, 58, 58, 58,
-	58, 58, 60, 60, 62, 62, 61, 61, 63, 65,
-	65, 65, 65, 66, 66, 42, 42, 42, 42, 42,
-	42, 42, 118, 118, 68, 68, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	79, 79, 79, 79, 79, 79, 69, 69, 69, 69,
-	69, 69, 69, 38, 38, 80, 80, 80, 86, 81,
-	81, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 76, 76, 76, 74, 74, 74,
-	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 75, 207, 207, 78, 77,
-	77, 77, 77, 77, 77, 36, 36, 36, 36, 36,
-	145, 145, 148, 148, 148, 148, 90, 90, 37, 37,
-	88, 88, 89, 91, 91, 87, 87, 87, 71, 71,
-	71, 71, 71, 71, 71, 71, 73, 73, 73, 92,
-	92, 93, 93, 94, 94, 95, 95, 96, 97, 97,
-	97, 98, 98, 98, 98, 99, 99, 99, 100, 100,
-	101, 101, 102, 102, 102, 102, 70, 70, 70, 70,
-	70, 70, 103, 103, 103, 103, 107, 107, 82, 82,
-	84, 84, 83, 85, 108, 108, 112, 109, 109, 113,
-	113, 113, 113, 111, 111, 111, 137, 137, 137, 116,
-	116, 124, 124, 125, 125, 117, 117, 126, 126, 126,
-	126, 126, 126, 126, 126, 126, 126, 127, 127, 127,
-	128, 128, 129, 129, 129, 136, 136, 132, 132, 133,
-	133, 138, 138, 139, 139, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	203, 204, 143, 144, 144, 144,
+	23, 23, 23, 23, 138, 138, 136, 136, 139, 139,
+	137, 137, 137, 140, 140, 140, 164, 164, 164, 24,
+	24, 26, 26, 27, 28, 25, 25, 25, 25, 25,
+	25, 25, 19, 223, 29, 30, 30, 31, 31, 31,
+	31, 31, 31, 32, 32, 32, 36, 36, 36, 34,
+	34, 35, 35, 41, 41, 40, 40, 43, 43, 43,
+	43, 43, 121, 121, 42, 42, 152, 152, 152, 151,
+	151, 49, 49, 50, 50, 51, 51, 52, 52, 52,
+	52, 52, 52, 52, 70, 70, 55, 55, 54, 54,
+	56, 57, 57, 57, 120, 120, 123, 123, 53, 53,
+	53, 53, 58, 58, 59, 59, 60, 60, 159, 159,
+	158, 158, 158, 204, 204, 204, 157, 157, 63, 63,
+	63, 65, 64, 64, 64, 64, 64, 66, 66, 68,
+	68, 67, 67, 69, 71, 71, 71, 71, 72, 72,
+	48, 48, 48, 48, 48, 48, 48, 48, 48, 135,
+	135, 74, 74, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 85, 85, 85,
+	85, 85, 85, 75, 75, 75, 75, 75, 75, 75,
+	39, 39, 86, 86, 86, 94, 87, 87, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 92,
+	92, 93, 93, 82, 82, 82, 82, 46, 46, 45,
+	45, 44, 44, 47, 47, 108, 109, 109, 110, 110,
+	110, 111, 111, 111, 111, 111, 80, 80, 80, 80,
+	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
+	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
+	81, 81, 81, 81, 81, 81, 224, 224, 84, 83,
+	83, 83, 83, 83, 83, 37, 37, 37, 37, 37,
+	162, 162, 165, 165, 165, 165, 165, 98, 98, 38,
+	38, 96, 96, 97, 99, 99, 95, 95, 95, 77,
+	77, 77, 77, 77, 77, 77, 77, 79, 79, 79,
+	100, 100, 101, 101, 103, 103, 102, 102, 104, 104,
+	105, 105, 106, 106, 107, 107, 112, 113, 113, 113,
+	114, 114, 114, 114, 115, 115, 115, 116, 116, 117,
+	117, 118, 118, 118, 118, 76, 76, 76, 76, 76,
+	76, 119, 119, 119, 119, 124, 124, 88, 88, 90,
+	90, 89, 91, 125, 125, 129, 126, 126, 130, 130,
+	130, 130, 128, 128, 128, 154, 154, 154, 133, 133,
+	141, 141, 142, 142, 134, 134, 143, 143, 143, 143,
+	143, 143, 143, 143, 143, 143, 144, 144, 144, 145,
+	145, 146, 146, 146, 153, 153, 149, 149, 150, 150,
+	155, 155, 156, 156, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 220, 221, 160, 161, 161,
+	161,
 }
 
-var yyR2 = [...]int{
+var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 4, 6, 7, 0, 1,
-	1, 3, 5, 5, 11, 3, 1, 3, 7, 8,
-	1, 1, 9, 8, 7, 6, 6, 1, 1, 1,
-	3, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 2, 8, 4, 6, 5, 5, 0, 2, 1,
-	0, 2, 1, 3, 3, 4, 4, 2, 4, 1,
-	3, 3, 3, 8, 3, 1, 1, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 2, 2, 2, 1, 4,
-	4, 2, 2, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
-	5, 0, 1, 0, 1, 0, 1, 2, 0, 2,
-	0, 3, 0, 1, 0, 3, 3, 0, 2, 2,
-	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
-	2, 2, 3, 2, 0, 1, 2, 3, 3, 2,
-	2, 1, 1, 0, 1, 1, 3, 2, 3, 1,
-	10, 11, 11, 12, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
-	6, 7, 7, 7, 7, 4, 5, 7, 5, 5,
-	5, 12, 7, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 3, 4, 4, 4, 4, 4, 4, 3, 3,
-	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
-	2, 4, 2, 2, 2, 2, 3, 3, 5, 2,
-	3, 1, 1, 0, 1, 1, 1, 0, 2, 2,
-	0, 2, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 3, 3, 2,
-	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
-	0, 1, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 2, 0, 1, 2, 1, 1, 0, 2,
-	1, 3, 1, 1, 1, 3, 1, 3, 6, 3,
-	7, 0, 1, 1, 3, 3, 1, 4, 4, 1,
-	3, 1, 3, 5, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 0, 1,
-	1, 1, 1, 1, 2, 2, 1, 2, 3, 2,
-	3, 2, 2, 2, 2, 1, 1, 3, 3, 0,
-	5, 5, 5, 0, 2, 1, 3, 3, 2, 3,
-	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
-	5, 3, 3, 3, 3, 3, 4, 5, 6, 2,
-	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 0, 2, 1, 1, 1, 3, 1,
-	3, 1, 1, 1, 1, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 3, 3, 4, 5, 6, 4, 4, 6,
-	6, 6, 8, 8, 8, 8, 9, 7, 5, 4,
+	1, 1, 1, 0, 4, 4, 5, 4, 7, 0,
+	1, 1, 3, 5, 5, 11, 3, 3, 1, 3,
+	1, 7, 8, 1, 1, 9, 8, 7, 6, 6,
+	1, 1, 1, 3, 1, 3, 0, 4, 3, 4,
+	5, 4, 1, 3, 3, 2, 2, 2, 2, 2,
+	1, 1, 1, 2, 2, 8, 4, 6, 5, 5,
+	0, 2, 1, 0, 2, 1, 3, 3, 4, 4,
+	2, 4, 1, 3, 3, 3, 8, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 1, 4, 4, 2, 2, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
+	5, 0, 3, 5, 0, 1, 0, 1, 0, 1,
+	2, 0, 2, 0, 3, 0, 1, 0, 3, 3,
+	0, 2, 2, 0, 2, 1, 2, 1, 0, 2,
+	5, 4, 1, 2, 2, 3, 2, 0, 1, 2,
+	3, 3, 2, 2, 1, 1, 0, 1, 1, 3,
+	2, 3, 1, 10, 11, 11, 12, 3, 3, 1,
+	1, 2, 2, 2, 0, 1, 3, 1, 2, 3,
+	1, 1, 1, 6, 7, 7, 7, 7, 4, 5,
+	7, 5, 5, 5, 12, 7, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 7, 1, 3,
+	8, 8, 3, 3, 5, 4, 6, 5, 4, 4,
+	3, 2, 3, 4, 3, 4, 4, 4, 4, 4,
+	4, 3, 3, 2, 3, 3, 2, 3, 4, 3,
+	7, 5, 4, 2, 4, 2, 2, 2, 2, 3,
+	3, 5, 2, 3, 1, 1, 0, 1, 1, 1,
+	0, 2, 2, 0, 2, 2, 0, 1, 1, 2,
+	1, 1, 2, 1, 1, 2, 2, 2, 2, 2,
+	3, 3, 2, 0, 2, 0, 2, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 3, 3, 2, 5,
+	7, 2, 0, 4, 0, 4, 0, 1, 2, 1,
+	1, 0, 2, 1, 3, 1, 1, 1, 3, 1,
+	3, 6, 12, 12, 3, 7, 0, 1, 1, 3,
+	3, 1, 4, 4, 1, 3, 1, 3, 5, 4,
+	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
+	0, 1, 2, 0, 1, 1, 1, 1, 1, 2,
+	2, 1, 2, 3, 2, 3, 2, 2, 2, 2,
+	1, 1, 3, 3, 0, 5, 5, 5, 0, 2,
+	1, 3, 3, 2, 3, 5, 6, 1, 2, 0,
+	3, 1, 1, 3, 3, 4, 4, 5, 3, 3,
+	3, 3, 3, 4, 5, 6, 2, 1, 2, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 3, 1, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
+	2, 2, 2, 3, 1, 1, 1, 1, 4, 6,
+	5, 5, 3, 1, 3, 1, 3, 3, 5, 1,
+	3, 3, 3, 4, 5, 6, 8, 0, 1, 1,
+	3, 1, 1, 3, 3, 3, 0, 3, 0, 2,
+	5, 2, 2, 2, 2, 2, 4, 4, 6, 6,
+	6, 8, 8, 8, 8, 9, 7, 5, 4, 6,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
 	2, 2, 2, 2, 8, 8, 0, 2, 3, 4,
 	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 4, 0, 2,
-	1, 3, 2, 4, 3, 2, 2, 1, 3, 5,
-	4, 6, 1, 3, 3, 5, 0, 5, 1, 3,
-	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
-	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
-	1, 0, 2, 0, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 1, 2, 4, 0, 2, 1, 3, 5, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	0, 3, 1, 3, 1, 5, 1, 3, 1, 2,
+	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
+	0, 2, 4, 4, 0, 2, 4, 0, 2, 1,
+	3, 2, 4, 3, 2, 2, 1, 3, 5, 4,
+	6, 1, 3, 3, 5, 0, 5, 1, 3, 1,
+	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 3, 1, 2, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 3, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	1, 0, 1, 1, 0, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2560,345 +2981,377 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
+	1,
 }
 
-var yyChk = [...]int{
-	-1000, -201, -1, -2, -9, -10, -11, -12, -13, -14,
+var yyChk = [...]int16{
+	-32768, -218, -1, -2, -9, -10, -11, -12, -13, -14,
 	-15, -16, -17, -18, -22, -23, -24, -26, -27, -28,
-	-25, -19, -3, 279, -4, 6, 7, -32, 9, 10,
-	34, -20, 128, 129, 131, 130, 163, 132, 156, 55,
-	176, 177, 179, 180, 29, 157, 158, 161, 162, 35,
-	36, 134, -203, 8, 266, 59, -202, 283, -94, 15,
-	-8, -7, -140, -138, 64, 62, -131, 23, 276, 149,
-	176, 187, 181, 208, 200, 277, 150, 198, 201, 245,
-	228, 240, 71, 179, 254, 22, 159, 196, 192, 21,
-	190, 31, 242, 88, 213, 281, 191, 241, 134, 152,
-	147, 214, 218, 246, 185, 186, 248, 212, 148, 37,
-	278, 39, 167, 249, 216, 211, 207, 210, 184, 206,
-	43, 220, 219, 221, 244, 203, 153, 193, 89, 18,
-	252, 162, 165, 243, 215, 217, 144, 169, 280, 250,
-	189, 154, 166, 161, 253, 155, 180, 230, 247, 256,
-	42, 225, 183, 146, 177, 173, 231, 204, 168, 194,
-	195, 209, 182, 205, 178, 163, 255, 226, 282, 202,
-	199, 174, 139, 171, 172, 232, 233, 234, 235, 236,
-	237, 175, 20, 251, 197, 227, -31, 5, -29, -206,
-	-29, -29, -29, -29, -29, -176, -178, 59, 98, -129,
-	139, 79, 258, 135, 136, 143, -132, 62, -131, -117,
-	139, 235, 141, 136, 136, 138, 139, 258, 135, 136,
-	-61, -138, 136, 120, 245, 128, 229, 230, 242, 138,
-	37, 243, 169, -147, 136, -119, 228, 232, 233, 234,
-	237, 235, 175, 62, 247, 246, 238, -138, 178, -143,
-	-143, -143, -143, -143, 231, 231, -143, -2, -98, 17,
-	16, -6, 60, 26, -5, -3, -203, 6, 24, 25,
-	-35, 44, 45, -30, -41, 108, -42, -138, -72, -67,
-	81, 33, 62, -131, -71, -68, -87, -85, -86, 120,
-	121, 122, 106, 107, 114, 82, 123, -76, -74, -75,
-	-77, 27, 64, 63, 72, 65, 66, 67, 68, 75,
-	76, 77, -132, -83, -203, 49, 50, 267, 268, 269,
-	270, 275, 271, 84, 38, 257, 265, 264, 263, 261,
-	262, 259, 260, 273, 274, 142, 258, 112, 266, -117,
-	-117, 11, -55, -56, -61, -63, -138, -109, -146, 178,
-	-113, 247, 246, -133, -111, -132, -130, 245, 201, 244,
-	133, 80, 26, 28, 223, 83, 120, 16, 84, 119,
-	267, 128, 53, 259, 260, 257, 269, 270, 258, 229,
-	33, 10, 29, 157, 25, 110, 130, 87, 160, 27,
-	158, 77, 19, 56, 11, 13, 14, 142, 141, 100,
-	138, 51, 8, 123, 30, 97, 46, 32, 49, 98,
-	17, 261, 262, 35, 275, 164, 112, 54, 40, 81,
-	75, 78, 57, 79, 15, 52, 99, 131, 266, 50,
-	135, 6, 272, 34, 156, 47, 136, 86, 273, 274,
-	140, 170, 76, 5, 143, 36, 9, 55, 58, 263,
-	264, 265, 38, 85, 12, 279, -177, 98, -170, 62,
-	-61, 138, -61, 266, -125, 142, -125, -125, 136, -61,
-	128, 130, 133, 57, -21, -61, -124, 142, 62, -124,
-	-124, -124, -61, 124, -61, 62, -144, -203, -133, 258,
-	62, 169, 136, 170, 139, -144, -144, -144, -144, -144,
-	173, 174, -144, -122, -121, 240, 241, 231, 239, 12,
-	231, 172, -144, -143, -143, -204, 61, -99, 19, 35,
-	-42, -72, -138, -95, -96, -42, -2, -7, -203, -94,
-	-2, -29, 40, -33, 25, 70, 11, -135, 80, 79,
-	97, -134, 26, -132, 64, 124, 125, -69, 100, 81,
-	98, 114, 116, 115, 117, 99, 83, 103, 102, 113,
-	106, 107, 108, 109, 110, 111, 112, 104, 105, 119,
-	284, 69, 126, 90, 91, 92, 93, 94, 95, 96,
-	-42, -118, -203, -72, -72, -72, -72, -72, -72, -72,
-	-72, -86, -203, -203, -2, -81, -42, -203, -203, -203,
-	-203, -203, -203, -203, -203, -203, -90, -42, -203, -207,
-	-78, -203, -207, -78, -207, -78, -207, -203, -207, -78,
-	-207, -78, -207, -207, -78, -203, -203, -203, -203, -203,
-	-203, -62, 30, -61, -44, -45, -46, -47, -64, -86,
-	-203, 62, -61, -61, -55, -205, 60, 11, 58, -205,
-	60, 124, 60, -109, 178, -110, -114, 248, 250, 90,
-	-137, -132, 64, 33, 34, 61, 60, -61, -149, -152,
-	-154, -153, -155, -150, -151, 198, 199, 120, 202, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 34,
-	159, 194, 195, 196, 197, 214, 215, 216, 217, 218,
-	219, 220, 221, 181, 200, 277, 182, 183, 184, 185,
-	186, 187, 189, 190, 191, 192, 193, 62, -144, 139,
-	62, 81, 62, -61, -61, -144, 171, 171, 136, 136,
-	-61, 60, 140, -55, 27, 57, -61, 62, 62, -139,
-	-138, -130, -144, -144, -144, -144, -61, -144, -144, -144,
-	-144, 11, -120, 11, 100, -42, 57, 9, 100, 60,
-	18, 124, 60, -97, 28, 29, -2, -98, -204, -35,
-	-73, -132, 65, 68, -34, 47, -61, -42, -42, -79,
-	75, 81, 76, 77, -134, 108, -139, -133, -130, -72,
-	-80, -83, -86, 69, 100, 98, 99, 83, -72, -72,
-	-72, -72, -72, -72, -72, -72, -72, -72, -72, -72,
-	-72, -72, -72, -72, -72, -72, -72, -145, 62, 64,
-	-72, -148, 62, -131, 73, 74, -132, 62, -132, -40,
-	25, -39, -41, -204, 60, -204, -2, -39, -39, -42,
-	-42, -87, 64, -39, -87, 64, -39, -39, -33, -88,
-	-89, 85, -87, -132, -138, -204, -72, -132, -132, -39,
-	-40, -39, -39, -105, 165, -61, 34, 60, -187, -59,
-	-58, -60, 48, 7, 47, 49, 50, 52, 54, -142,
-	26, -44, -203, -203, -141, 165, -140, 26, -105, 58,
-	-44, -61, -44, -63, -138, 108, -113, -110, 60, 249,
-	251, 252, 57, 78, -42, -161, 119, -179, -180, -181,
-	-133, 64, 65, -170, -171, -172, -182, 151, -188, 144,
-	146, 143, -173, 152, 138, 32, 61, -166, 75, 81,
-	-162, 226, -156, 59, -156, -156, -156, -156, -160, 201,
-	-160, -160, -160, 59, 59, -156, -156, -156, -164, 59,
-	-164, -164, -165, 59, -165, -136, 58, -61, -144, 27,
-	-144, -126, 133, 130, 131, -191, 129, 223, 201, 71,
-	33, 15, 267, 165, 282, 62, 166, -132, -132, -61,
-	-61, 133, 130, -61, -61, -61, -144, -61, -123, 98,
-	12, -138, -138, -61, 42, -42, -42, -139, -96, -204,
-	-99, -116, 19, 11, 38, 38, -39, 75, 76, 77,
-	124, -203, -80, -72, -72, -72, -38, 160, 80, 285,
-	-204, -204, -39, 60, -42, -204, -204, -204, 60, 58,
-	26, 11, 11, -204, 11, 11, -204, -204, -39, -91,
-	-89, 87, -42, -204, 124, -204, 60, 60, -204, -204,
-	-204, -204, -70, 34, 38, -2, -203, -203, -108, -112,
-	-87, -45, -57, 46, 51, 53, -46, -45, -46, 46,
-	52, 46, 52, 46, 46, -58, -138, -204, -49, -48,
-	-50, -132, -65, 55, 141, 56, -203, -140, -66, 12,
-	-44, -66, -66, 124, -114, -115, 253, 250, 256, 62,
-	64, 60, -181, 90, 59, 62, 32, -173, -173, -174,
-	62, -174, 32, -158, 33, 75, -163, 227, 65, -160,
-	-160, -161, 34, -161, -161, -161, -169, 64, -169, 65,
-	65, 57, -132, -144, -143, -197, 145, 151, 152, 147,
-	62, 138, 32, 144, 146, 165, 143, -197, -127, -128,
-	140, 26, 138, 32, 165, -196, 58, 171, 171, 140,
-	-144, -120, 64, -42, 43, 124, -61, -43, 11, 108,
-	-133, -40, -38, 80, -72, -72, -204, -41, -148, -145,
-	-148, -72, -72, -72, -72, 276, -94, 88, -42, 86,
-	-133, -72, -72, -107, 57, -108, -82, -84, -83, -203,
-	-2, -103, -132, -106, -132, -66, 60, 90, -46, 46,
-	46, -54, 57, -52, 57, 58, 46, 46, -204, 60,
-	101, 138, 138, 138, -106, -94, -42, -66, 250, 254,
-	255, -180, -181, -184, -183, -132, -188, -174, -174, 59,
-	-159, 57, -72, 61, -161, -161, 62, 120, 61, 60,
-	61, 60, 61, 60, -61, -143, -143, -61, -143, -132,
-	-194, 279, -195, 62, -132, -132, -61, -123, -66, -44,
-	-204, -72, -204, -204, -204, 19, 19, 19, 19, -203,
-	-37, 272, -42, 60, 60, 31, -107, 60, -204, -204,
-	-204, 60, 124, -204, 60, -94, -112, -42, -53, -52,
-	-42, -42, 59, -142, -50, -51, -42, 136, 137, -203,
-	-203, -203, -204, -98, 61, 60, -156, -104, -132, -167,
-	223, 9, -160, 64, -160, 65, 65, -144, 30, -193,
-	-192, -133, 59, -92, 13, -72, -72, -72, -72, -72,
-	-204, 64, -72, -72, 32, -84, 38, -2, -203, -132,
-	-132, -132, -98, -104, -138, -203, -203, -104, -104, -104,
-	-141, -186, -185, 58, 148, 71, -183, 61, 60, -168,
-	144, 32, 143, -75, -161, -161, 61, 61, -203, 60,
-	90, -104, -93, 14, 16, -204, -204, -204, -204, -36,
-	100, 279, -204, -204, 9, -82, -2, 124, 61, -45,
-	-87, -204, -204, -204, -65, -185, 62, -175, 90, 64,
-	154, -132, -157, 71, 32, 32, -189, -190, 165, -192,
-	-181, 61, -100, 170, -42, -81, -204, 277, 54, 280,
-	-108, -204, -132, -204, -204, 65, -61, 64, -204, 60,
-	-132, -196, -101, -102, 57, 23, 22, 43, 278, 281,
-	59, -190, 38, -194, 60, 20, 88, 21, -42, 43,
-	-104, 167, -102, 89, -42, 279, 61, 168, 7, 280,
-	-199, -200, 57, -203, 281, -200, 57, 10, 9, -72,
-	164, -198, 155, 150, 153, 34, -198, -204, -204, 149,
-	33, 75,
+	-25, -19, -3, 296, -4, 9, 10, -33, 12, 13,
+	48, -20, 143, 144, 146, 145, 178, 147, 171, 69,
+	191, 192, 194, 195, 43, 172, 173, 176, 177, 49,
+	50, 149, -5, 11, 281, -220, 73, -219, 301, -106,
+	18, -8, 299, -7, -157, -155, 78, 76, -148, 26,
+	293, 164, 191, 202, 196, 223, 215, 294, 165, 213,
+	216, 260, 243, 255, 85, 194, 269, 25, 32, 174,
+	211, 207, 24, 205, 45, 257, 102, 228, 298, 206,
+	256, 149, 167, 30, 162, 229, 233, 261, 34, 200,
+	201, 263, 227, 163, 51, 295, 53, 182, 264, 231,
+	226, 222, 225, 199, 221, 57, 235, 234, 236, 259,
+	218, 168, 208, 103, 21, 267, 177, 180, 258, 230,
+	232, 29, 159, 184, 297, 265, 204, 169, 181, 176,
+	268, 170, 195, 33, 28, 245, 262, 35, 271, 56,
+	240, 198, 161, 192, 188, 246, 219, 183, 209, 210,
+	224, 197, 220, 193, 178, 31, 270, 241, 300, 217,
+	214, 189, 154, 186, 187, 247, 248, 249, 250, 251,
+	252, 190, 23, 266, 212, 242, 291, -106, -29, -223,
+	-29, -29, -29, -29, -29, -193, -195, 73, 112, -146,
+	154, 93, 273, 150, 151, 158, -149, 76, -148, 299,
+	-134, 154, 250, 156, 151, 151, 153, 154, 273, 150,
+	151, -67, -155, 151, 135, 260, 143, 244, 245, 257,
+	153, 51, 258, 184, -164, 151, -136, 243, 247, 248,
+	249, 252, 250, 190, 76, 262, 261, 253, -155, 193,
+	-160, -160, -160, -160, -160, 246, 246, -160, -31, -32,
+	6, 7, 8, -2, -114, 20, 19, -6, 74, -8,
+	40, -114, -36, 58, 59, -30, -43, 122, -48, -155,
+	-78, -73, 95, 47, 76, -148, -77, -74, -95, -91,
+	-94, 135, 136, 137, 120, 121, 129, 96, 138, -82,
+	-80, -81, -83, 302, 87, 305, 88, 41, 78, 77,
+	86, 79, 80, 81, 82, 89, 90, 91, -149, -89,
+	-220, 63, 64, 282, 283, 284, 285, 292, 286, 98,
+	52, 290, 272, 280, 279, 278, 276, 277, 274, 275,
+	288, 289, 157, 273, 126, 281, -134, -134, 14, -61,
+	-62, -67, -69, -155, -126, -163, 193, -130, 262, 261,
+	-150, -128, -149, -147, 260, 216, 259, 148, 94, 40,
+	42, 128, 238, 97, 135, 19, 98, 134, 282, 143,
+	67, 274, 275, 272, 284, 285, 273, 244, 47, 13,
+	43, 172, 39, 124, 145, 101, 175, 7, 41, 173,
+	290, 91, 22, 70, 14, 16, 17, 157, 156, 114,
+	153, 65, 11, 8, 138, 44, 111, 60, 46, 63,
+	112, 20, 276, 277, 49, 292, 179, 126, 68, 54,
+	95, 89, 92, 71, 93, 18, 66, 36, 113, 146,
+	281, 64, 150, 9, 287, 48, 171, 61, 151, 100,
+	288, 289, 155, 185, 90, 6, 158, 50, 37, 12,
+	69, 72, 278, 279, 280, 52, 99, 15, 296, -194,
+	112, -187, 76, -67, 153, -67, 281, -142, 157, -142,
+	-142, 151, -67, 143, 145, 148, 71, -21, -67, -141,
+	157, 76, -141, -141, -141, -67, 139, -67, 76, -161,
+	-220, -150, 273, 76, 184, 151, 185, 154, -161, -161,
+	-161, -161, -161, 188, 189, -161, -139, -138, 255, 256,
+	246, 254, 15, 246, 187, -161, -160, -160, -5, -3,
+	-4, 9, -5, 38, 39, 38, 39, 38, 39, -221,
+	75, -115, 22, 49, -48, -78, -155, -107, -112, -48,
+	-2, -7, -6, -220, -115, 54, -34, 39, 84, 14,
+	-121, 7, -152, 94, 93, 111, -151, 40, -149, 78,
+	139, 140, -75, 114, 95, 112, 129, 131, 130, 132,
+	113, 97, 117, 116, 127, 120, 121, 122, 123, 124,
+	125, 126, 118, 119, 134, 302, 83, 141, 128, 104,
+	105, 106, 107, 108, 109, 110, -48, -135, -220, -78,
+	-78, -78, -78, -78, -78, -78, -78, -87, -48, -92,
+	-93, 78, -149, -94, -220, -220, -2, -87, -220, -220,
+	-220, -220, -220, -220, -220, -220, -220, -98, -48, -220,
+	-220, -224, -84, -220, -224, -84, -224, -84, -224, -220,
+	-224, -84, -224, -84, -224, -224, -84, -220, -220, -220,
+	-220, -220, -220, -68, 44, -67, -50, -51, -52, -53,
+	-70, -94, -220, 76, -67, -67, -61, -222, 74, 14,
+	72, -222, 74, 139, 74, -126, 193, -127, -131, 263,
+	265, 104, -154, -149, 78, 47, 48, 75, 74, -67,
+	-166, -169, -171, -170, -172, -167, -168, 213, 214, 135,
+	217, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	228, 48, 174, 209, 210, 211, 212, 229, 230, 231,
+	232, 233, 234, 235, 236, 196, 215, 294, 197, 198,
+	199, 200, 201, 202, 204, 205, 206, 207, 208, 76,
+	-161, 154, 76, 95, 76, -67, -67, -161, 186, 186,
+	151, 151, -67, 74, 155, -61, 41, 71, -67, 76,
+	76, -156, -155, -147, -161, -161, -161, -161, -67, -161,
+	-161, -161, -161, 14, -137, 14, 114, -48, 71, -29,
+	12, 114, 74, 21, 139, 74, -113, 42, 43, -2,
+	-2, -79, -149, 79, 82, -35, 61, -67, -42, 281,
+	-220, -48, -48, -85, 39, 95, 89, 90, 91, -151,
+	122, -156, -150, -147, -78, -86, -89, -94, 83, 114,
+	112, 113, 97, -78, -78, -78, -78, -78, -78, -78,
+	-78, -78, -78, -78, -78, -78, -78, -78, -78, -78,
+	-78, -78, -162, 76, 78, -78, 304, -165, 76, 138,
+	-148, 87, 88, -149, 209, 76, -149, 74, 303, 306,
+	74, 304, 304, -46, 39, -45, -44, -43, -47, -149,
+	-91, -221, -221, -2, -40, -43, -40, -48, -48, -95,
+	78, -40, -95, 78, -40, -40, -34, -96, -97, 99,
+	-95, -149, -155, -149, -221, -78, -149, -149, -40, -41,
+	-40, -40, -40, -122, 180, -67, 48, 74, -204, -65,
+	-64, -66, 62, 10, 61, 63, 64, 66, 68, 36,
+	37, -159, 40, -50, -220, -220, -158, 180, -157, 40,
+	-122, 72, -50, -67, -50, -69, -155, 122, -130, -127,
+	74, 264, 266, 267, 71, 92, -48, -178, 134, -196,
+	-197, -198, -150, 78, 79, -187, -188, -189, -199, 166,
+	-205, 159, 161, 158, -190, 167, 153, 46, 75, -183,
+	89, 95, -179, 241, -173, 73, -173, -173, -173, -173,
+	-177, 216, -177, -177, -177, 73, 73, -173, -173, -173,
+	-181, 73, -181, -181, -182, 73, -182, -153, 72, -67,
+	-161, 41, -161, -143, 148, 145, 146, -208, 144, 238,
+	216, 85, 47, 18, 282, 180, 300, 76, 181, -149,
+	-149, -67, -67, 148, 145, -67, -67, -67, -161, -67,
+	-140, 112, 15, -155, -155, -67, -36, 56, -48, -48,
+	-156, -112, -221, -133, 22, 14, 52, 52, -40, -220,
+	-120, -149, 14, 39, 89, 90, 91, -121, 139, -220,
+	-86, -78, -78, -78, -39, 175, 94, 303, 304, -78,
+	291, -221, -48, -93, -48, -48, -221, -40, 74, 141,
+	141, -221, 74, -221, -221, 74, 72, 40, 14, 14,
+	-221, 14, 14, -221, -221, -40, -99, -97, 101, -48,
+	-221, 139, 14, -221, 74, 74, -221, -221, -221, -221,
+	-76, 48, 52, -2, -220, -220, -125, -129, -95, -51,
+	-63, 60, 65, 67, -52, -51, -52, 60, 66, 60,
+	66, 60, 60, -64, -220, -220, -155, -221, -55, -54,
+	-56, -149, -71, 69, 156, 70, -220, -157, -72, 15,
+	-50, -72, -72, 139, -131, -132, 268, 265, 271, 76,
+	78, 74, -198, 104, 73, 76, 46, -190, -190, -191,
+	76, -191, 46, -175, 47, 89, -180, 242, 79, -177,
+	-177, -178, 48, -178, -178, -178, -186, 78, -186, 79,
+	79, 71, -149, -161, -160, -214, 160, 166, 167, 162,
+	76, 153, 46, 159, 161, 180, 158, -214, -144, -145,
+	155, 40, 153, 46, 180, -213, 72, 186, 186, 155,
+	-161, -137, 78, -48, 57, 139, -67, -49, 14, -40,
+	-221, 74, -78, 14, -42, 122, -150, -41, -39, 94,
+	-78, -78, -78, 303, 303, -78, 27, -221, -44, -48,
+	-48, -43, -165, -162, -165, -78, -78, -78, -78, 293,
+	-106, 102, -48, 100, -150, -48, -78, -78, -124, 71,
+	-125, -88, -90, -89, -220, -2, -119, -149, -123, -149,
+	-72, 74, 104, -52, 60, 60, -60, 71, -58, 71,
+	72, 60, 60, -40, -149, -221, 74, 115, 153, 153,
+	153, -123, -106, -48, -72, 265, 269, 270, -197, -198,
+	-201, -200, -149, -205, -191, -191, 73, -176, 71, -78,
+	75, -178, -178, 76, 135, 75, 74, 75, 74, 75,
+	74, -67, -160, -160, -67, -160, -149, -211, 296, -212,
+	76, -149, -149, -67, -140, -72, -50, -221, -149, -78,
+	-121, -221, -78, 303, -220, -221, -221, -221, 22, 22,
+	22, 22, -220, -38, 287, -48, -221, 74, 74, 45,
+	-124, 74, -221, -221, -221, 74, 139, -221, 74, -106,
+	-129, -48, -59, -58, -48, -48, 73, 22, 22, -159,
+	-56, -57, -48, 151, 152, -220, -220, -220, -221, -114,
+	75, 74, -173, -120, -184, 238, 12, -177, 78, -177,
+	79, 79, -161, 44, -210, -209, -150, 73, -100, 16,
+	-42, -108, -109, 180, -78, -78, -78, -78, -78, -221,
+	78, -78, -78, 46, -90, 52, -2, -220, -149, -149,
+	-149, -114, -120, -95, -149, -155, -220, -220, -120, -120,
+	-120, -158, -203, -202, 72, 163, 85, -200, 75, -185,
+	159, 46, 158, -81, -178, -178, 75, 75, -220, 74,
+	104, -120, -105, 17, 19, -221, -106, 19, -221, -221,
+	-221, -221, -37, 114, 296, -221, -221, 12, -88, -2,
+	139, 75, 114, 114, -51, -95, -221, -221, -221, -71,
+	-202, 76, -192, 104, 78, 169, -174, 85, 46, 46,
+	-206, -207, 180, -209, -198, 75, -116, 185, -48, -101,
+	-103, -48, 34, -110, 28, -87, -221, 294, 68, 297,
+	-125, -221, -149, -220, -220, -221, -221, 79, -67, 78,
+	-221, 74, -149, -213, -117, -118, 71, 26, 25, 74,
+	35, -111, 97, 31, 32, 79, 57, 295, 298, -40,
+	-120, 73, -207, 52, -211, 74, 23, 102, 24, -48,
+	-103, -220, -111, 29, 30, 33, 29, 30, 57, -221,
+	-221, -120, 182, -118, 103, -48, -102, -104, -48, -220,
+	94, 296, -221, -221, 75, 183, 10, -221, 74, -221,
+	-111, 297, -158, -158, -216, -217, 71, -220, -104, 298,
+	-217, 71, 13, 12, -78, 179, -215, 170, 165, 168,
+	48, -215, -221, -221, 164, 47, 89,
 }
 
-var yyDef = [...]int{
+var yyDef = [...]int16{
 	23, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 573, 0, 0, 320, 320, 320, 320, 320,
-	320, 0, 652, 635, 0, 0, 0, 0, -2, 307,
-	308, 0, 310, 311, 882, 882, 882, 882, 882, 0,
-	0, 882, 0, 40, 41, 880, 1, 3, 581, 0,
-	28, 30, 0, 391, 392, 661, 662, 761, 762, 763,
-	764, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	774, 775, 776, 777, 778, 779, 780, 781, 782, 783,
-	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 819, 820, 821, 822, 823,
-	824, 825, 826, 827, 828, 829, 830, 831, 832, 833,
-	834, 835, 836, 837, 838, 839, 840, 841, 842, 843,
-	844, 845, 846, 847, 848, 849, 850, 851, 852, 853,
-	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
-	864, 865, 866, 867, 868, 869, 870, 871, 872, 873,
-	874, 875, 876, 877, 878, 879, 0, 324, 327, 322,
-	0, 635, 635, 0, 0, 70, 71, 0, 0, 0,
-	866, 0, 633, 633, 633, 653, 654, 657, 658, 0,
-	0, 0, 636, 0, 631, 0, 631, 631, 631, 0,
-	258, 406, 0, 0, 883, 0, 883, 883, 270, 883,
-	883, 273, 883, 0, 883, 0, 280, 282, 283, 284,
-	285, 0, 289, 883, 304, 305, 294, 306, 309, 312,
-	313, 314, 315, 316, 882, 882, 319, 0, 585, 0,
-	0, 0, 29, 0, 573, 36, 0, 320, 325, 326,
-	330, 328, 329, 321, 0, 338, 343, 0, 420, 415,
-	0, 422, -2, -2, 461, 462, 463, 464, 465, 0,
-	0, 0, 0, 0, 0, 0, 0, 487, 488, 489,
-	490, 0, 558, 559, 560, 561, 562, 563, 564, 565,
-	424, 425, 555, 613, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 546, 0, 526, 526, 526, 526, 526,
-	526, 526, 526, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 49, 51, 406, 55, 0, 858,
-	617, -2, -2, 0, 0, 659, 660, -2, 772, -2,
-	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
-	675, 676, 677, 678, 679, 680, 681, 682, 683, 684,
-	685, 686, 687, 688, 689, 690, 691, 692, 693, 694,
-	695, 696, 697, 698, 699, 700, 701, 702, 703, 704,
-	705, 706, 707, 708, 709, 710, 711, 712, 713, 714,
-	715, 716, 717, 718, 719, 720, 721, 722, 723, 724,
-	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
-	735, 736, 737, 738, 739, 740, 741, 742, 743, 744,
-	745, 746, 747, 748, 749, 750, 751, 752, 753, 754,
-	755, 756, 757, 758, 759, 760, 0, 0, 89, 0,
-	87, 0, 883, 0, 0, 0, 0, 0, 0, 883,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 257, 0, 259, 883, 261, 884, 885, 883,
-	883, 883, 0, 883, 883, 268, 269, 271, 272, 274,
-	883, 883, 276, 0, 297, 295, 296, 291, 292, 0,
-	286, 287, 290, 317, 318, 35, 881, 24, 0, 0,
-	582, 420, 0, 574, 575, 578, 25, 31, 0, 581,
-	0, 327, 0, 332, 331, 323, 0, 339, 0, 0,
-	0, 344, 0, 346, 347, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 446, 447, 448, 449, 450, 451, 452,
-	418, 421, 0, 479, 480, 481, 482, 483, 484, 485,
-	0, 439, 0, 334, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 0, 330, 0, 547, 0, 510,
-	518, 0, 511, 519, 512, 520, 513, 0, 514, 521,
-	515, 522, 516, 517, 523, 0, 0, 0, 334, 0,
-	0, 53, 0, 405, 0, -2, 352, 353, 354, -2,
-	0, 661, 385, -2, 0, 0, 0, 47, 48, 0,
-	0, 0, 0, 56, 858, 58, 59, 0, 0, 0,
-	167, 626, 627, 628, 624, 211, 0, 0, 155, 151,
-	95, 96, 97, 144, 99, 144, 144, 144, 144, 164,
-	164, 164, 164, 127, 128, 129, 130, 131, 0, 0,
-	114, 144, 144, 144, 118, 134, 135, 136, 137, 138,
-	139, 140, 141, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 146, 146, 146, 148, 148, 655, 73, 0,
-	883, 0, 883, 85, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 252, 632, 0, 883, 255, 256, 407,
-	663, 664, 260, 262, 263, 264, 265, 266, 267, 275,
-	279, 0, 300, 0, 0, 281, 0, 586, 0, 0,
-	0, 0, 0, 577, 579, 580, 0, 585, 37, 330,
-	0, 566, 0, 0, 0, 333, 33, 416, 417, 419,
-	440, 0, 442, 444, 345, 340, 0, 556, -2, 426,
-	427, 455, 456, 457, 0, 0, 0, 0, 453, 431,
-	432, 433, 434, 435, 0, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 540, 541,
-	0, 492, 542, 543, 544, 545, 493, 0, 486, 0,
-	0, 335, 336, 458, 0, 612, 0, 0, 0, 0,
-	0, 463, 558, 0, 463, 558, 0, 0, 0, 553,
-	550, 0, 0, 555, 0, 527, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 404, 0, 0, 0, 0,
-	0, 0, 389, 390, 396, 0, 0, 0, 0, 0,
-	384, 0, 0, 361, 409, 826, 386, 0, 413, 0,
-	413, 50, 413, 52, 0, 408, 618, 57, 0, 0,
-	62, 63, 619, 620, 621, 622, 0, 86, 212, 214,
-	217, 218, 219, 90, 91, 92, 0, 0, 199, 0,
-	0, 193, 193, 0, 191, 192, 88, 158, 156, 0,
-	153, 152, 98, 0, 164, 164, 121, 122, 167, 0,
-	167, 167, 167, 0, 0, 115, 116, 117, 109, 0,
-	110, 111, 112, 0, 113, 0, 0, 883, 75, 634,
-	76, 882, 0, 0, 647, 226, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 0, 77, 228, 230,
-	229, 0, 0, 0, 250, 883, 254, 297, 278, 0,
-	0, 298, 299, 288, 0, 583, 584, 0, 576, 32,
-	26, 0, 629, 630, 567, 568, 348, 441, 443, 445,
-	0, 334, 428, 453, 436, 0, 429, 0, 0, 491,
-	423, 494, 0, 0, 460, -2, 497, 498, 0, 0,
-	0, 0, 0, 533, 0, 0, 534, 0, 573, 0,
-	551, 0, 0, 509, 0, 528, 0, 0, 529, 530,
-	531, 532, 606, 0, 0, 597, 0, 0, 413, 614,
-	0, -2, 0, 393, 0, 0, 381, 388, 376, 397,
-	0, 399, 0, 401, 402, 403, 355, 357, 0, 362,
-	363, 0, 359, 0, 0, 0, 0, 387, 573, 0,
-	413, 45, 46, 0, 60, 61, 0, 0, 67, 168,
-	169, 0, 215, 0, 0, 0, 186, 193, 193, 189,
-	194, 190, 0, 160, 0, 157, 94, 154, 0, 167,
-	167, 123, 0, 124, 125, 126, 0, 142, 0, 0,
-	0, 0, 656, 74, 220, 882, 233, 234, 235, 236,
-	237, 238, 239, 240, 241, 242, 243, 882, 0, 882,
-	648, 649, 650, 651, 0, 80, 0, 0, 0, 0,
-	253, 300, 301, 302, 587, 0, 27, 413, 0, 341,
-	557, 0, 430, 0, 454, 437, 495, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 548, 508, 554, 0,
-	556, 0, 0, 38, 0, 606, 596, 608, 610, 0,
-	0, 0, 602, 0, 371, 573, 0, 0, 379, 394,
-	395, 374, 0, 375, 0, 0, 398, 400, 383, 0,
-	0, 0, 0, 0, 0, 581, 414, 44, 64, 65,
-	66, 213, 216, 0, 195, 144, 198, 187, 188, 0,
-	162, 0, 159, 145, 119, 120, 165, 166, 164, 0,
-	164, 0, 149, 0, 883, 221, 222, 223, 224, 0,
-	227, 0, 78, 79, 0, 232, 251, 277, 569, 349,
-	496, 438, 499, 501, 500, 0, 0, 0, 0, 0,
-	0, 0, 552, 0, 0, 0, 39, 0, 611, -2,
-	0, 0, 0, 54, 0, 581, 615, 616, 373, 380,
-	382, 377, 0, 0, 364, 365, 366, 0, 0, 0,
-	0, 0, 385, 43, 178, 0, 197, 0, 369, 170,
-	163, 0, 167, 143, 167, 0, 0, 72, 0, 81,
-	82, 0, 0, 571, 0, 0, 0, 0, 0, 535,
-	507, 549, 0, 0, 0, 609, 0, 600, 0, 604,
-	603, 372, 42, 0, 358, 0, 0, 0, 0, 0,
-	409, 177, 179, 0, 184, 0, 196, 0, 0, 175,
-	0, 172, 174, 161, 132, 133, 147, 150, 0, 0,
-	0, 0, 588, 0, 0, 502, 504, 503, 505, 0,
-	0, 0, 524, 525, 0, 599, 0, 0, 378, 388,
-	0, 410, 411, 412, 360, 180, 181, 0, 185, 183,
-	0, 370, 93, 0, 171, 173, 0, 245, 0, 83,
-	84, 77, 34, 0, 572, 570, 506, 0, 0, 0,
-	607, -2, 605, 367, 368, 182, 0, 176, 244, 0,
-	0, 80, 589, 590, 0, 0, 0, 536, 0, 539,
-	0, 246, 0, 231, 0, 592, 0, 0, 595, 537,
-	0, 0, 591, 0, 594, 0, 200, 0, 593, 0,
-	201, 202, 0, 0, 538, 203, 0, 0, 0, 0,
-	0, 204, 206, 207, 0, 0, 205, 247, 248, 208,
-	209, 210,
+	21, 22, -2, 0, -2, 323, 323, 323, 323, 323,
+	323, 0, 711, 694, 0, 0, 0, 0, -2, 310,
+	311, 0, 313, 314, 957, 957, 957, 957, 957, 0,
+	0, 957, 0, 43, 44, 0, 955, 1, 3, 640,
+	0, 29, 904, 31, 0, 406, 407, 720, 721, 826,
+	827, 828, 829, 830, 831, 832, 833, 834, 835, 836,
+	837, 838, 839, 840, 841, 842, 843, 844, 845, 846,
+	847, 848, 849, 850, 851, 852, 853, 854, 855, 856,
+	857, 858, 859, 860, 861, 862, 863, 864, 865, 866,
+	867, 868, 869, 870, 871, 872, 873, 874, 875, 876,
+	877, 878, 879, 880, 881, 882, 883, 884, 885, 886,
+	887, 888, 889, 890, 891, 892, 893, 894, 895, 896,
+	897, 898, 899, 900, 901, 902, 903, 905, 906, 907,
+	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 950, 951, 952, 953, 954, 640, 336, 325,
+	0, 694, 694, 0, 0, 73, 74, 0, 0, 0,
+	940, 0, 692, 692, 692, 712, 713, 716, 717, 904,
+	0, 0, 0, 695, 0, 690, 0, 690, 690, 690,
+	0, 261, 421, 0, 0, 958, 0, 958, 958, 273,
+	958, 958, 276, 958, 0, 958, 0, 283, 285, 286,
+	287, 288, 0, 292, 958, 307, 308, 297, 309, 312,
+	315, 316, 317, 318, 319, 957, 957, 322, 0, 0,
+	327, 330, 333, 0, 644, 0, 0, 0, 30, 29,
+	0, 644, 339, 337, 338, 324, 0, 352, 356, 0,
+	437, 430, 0, 439, -2, -2, 478, 479, 480, 481,
+	482, 0, 0, 0, 0, 0, 0, 0, 0, 504,
+	505, 506, 507, 0, 513, 0, 515, 0, 609, 610,
+	611, 612, 613, 614, 615, 616, 441, 442, 606, 672,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 597,
+	0, 0, 576, 576, 576, 576, 576, 576, 576, 576,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 52, 54, 421, 58, 0, 931, 676, -2, -2,
+	0, 0, 718, 719, -2, 837, -2, 724, 725, 726,
+	727, 728, 729, 730, 731, 732, 733, 734, 735, 736,
+	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 748, 749, 750, 751, 752, 753, 754, 755, 756,
+	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
+	767, 768, 769, 770, 771, 772, 773, 774, 775, 776,
+	777, 778, 779, 780, 781, 782, 783, 784, 785, 786,
+	787, 788, 789, 790, 791, 792, 793, 794, 795, 796,
+	797, 798, 799, 800, 801, 802, 803, 804, 805, 806,
+	807, 808, 809, 810, 811, 812, 813, 814, 815, 816,
+	817, 818, 819, 820, 821, 822, 823, 824, 825, 0,
+	0, 92, 0, 90, 0, 958, 0, 0, 0, 0,
+	0, 0, 958, 0, 0, 0, 0, 252, 0, 0,
+	0, 0, 0, 0, 0, 260, 0, 262, 958, 264,
+	959, 960, 958, 958, 958, 0, 958, 958, 271, 272,
+	274, 275, 277, 958, 958, 279, 0, 300, 298, 299,
+	294, 295, 0, 289, 290, 293, 320, 321, 36, 38,
+	40, 323, 37, 328, 329, 331, 332, 334, 335, 39,
+	956, 24, 0, 0, 641, 437, 0, 633, 634, 637,
+	25, 32, 0, 0, 27, 0, 341, 340, 326, 0,
+	354, 0, 348, 0, 0, 0, 357, 0, 359, 360,
+	0, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 463,
+	464, 465, 466, 467, 468, 469, 433, 438, 0, 496,
+	497, 498, 499, 500, 501, 502, 0, 0, 476, 0,
+	519, 0, 0, 456, 0, 527, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 339, 0, 598, 0,
+	0, 560, 568, 0, 561, 569, 562, 570, 563, 0,
+	564, 571, 565, 572, 566, 567, 573, 0, 0, 0,
+	343, 0, 0, 56, 0, 420, 0, -2, 365, 366,
+	367, -2, 0, 720, 400, -2, 0, 0, 0, 50,
+	51, 0, 0, 0, 0, 59, 931, 61, 62, 0,
+	0, 0, 170, 685, 686, 687, 683, 214, 0, 0,
+	158, 154, 98, 99, 100, 147, 102, 147, 147, 147,
+	147, 167, 167, 167, 167, 130, 131, 132, 133, 134,
+	0, 0, 117, 147, 147, 147, 121, 137, 138, 139,
+	140, 141, 142, 143, 144, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 149, 149, 149, 151, 151, 714,
+	76, 0, 958, 0, 958, 88, 0, 228, 0, 0,
+	0, 0, 0, 0, 0, 255, 691, 0, 958, 258,
+	259, 422, 722, 723, 263, 265, 266, 267, 268, 269,
+	270, 278, 282, 0, 303, 0, 0, 284, 0, 336,
+	645, 0, 0, 0, 0, 0, 636, 638, 639, 26,
+	0, 0, 617, 0, 0, 0, 342, 34, 347, 0,
+	0, 431, 432, 434, 0, 0, 457, 459, 461, 358,
+	352, 0, 607, -2, 443, 444, 472, 473, 474, 0,
+	0, 0, 0, 470, 448, 449, 450, 451, 452, 0,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 590, 591, 0, 0, 516, 592, 593,
+	594, 595, 596, 517, 0, 0, 503, 0, 512, 514,
+	0, 0, 0, 0, 0, 528, 529, 531, 532, 606,
+	481, 475, 671, 0, 0, 345, 0, 0, 0, 480,
+	609, 0, 480, 609, 0, 0, 0, 604, 601, 0,
+	0, 606, 0, 0, 577, 0, 0, 0, 0, 0,
+	344, 0, 0, 0, 0, 419, 0, 0, 0, 0,
+	0, 0, 404, 405, 411, 0, 0, 0, 0, 0,
+	0, 0, 399, 0, 0, 376, 424, 894, 401, 0,
+	428, 0, 428, 53, 428, 55, 0, 423, 677, 60,
+	0, 0, 65, 66, 678, 679, 680, 681, 0, 89,
+	215, 217, 220, 221, 222, 93, 94, 95, 0, 0,
+	202, 0, 0, 196, 196, 0, 194, 195, 91, 161,
+	159, 0, 156, 155, 101, 0, 167, 167, 124, 125,
+	170, 0, 170, 170, 170, 0, 0, 118, 119, 120,
+	112, 0, 113, 114, 115, 0, 116, 0, 0, 958,
+	78, 693, 79, 957, 0, 0, 706, 229, 696, 697,
+	698, 699, 700, 701, 702, 703, 704, 705, 0, 80,
+	231, 233, 232, 0, 0, 0, 253, 958, 257, 300,
+	281, 0, 0, 301, 302, 291, 339, 0, 642, 643,
+	0, 635, 33, 0, 688, 689, 618, 619, 361, 0,
+	0, 384, 0, 0, 458, 460, 462, 354, 0, 343,
+	445, 470, 453, 0, 446, 0, 0, 508, 0, 0,
+	0, 440, 477, 520, 521, 522, 523, 0, 0, 0,
+	0, -2, 0, 546, 547, 0, 0, 0, 0, 0,
+	583, 0, 0, 584, 0, 632, 0, 602, 0, 0,
+	558, 0, 0, 578, 0, 0, 579, 580, 581, 582,
+	665, 0, 0, 656, 0, 0, 428, 673, 0, -2,
+	0, 408, 0, 0, 396, 403, 391, 412, 0, 414,
+	0, 416, 417, 418, 0, 0, 368, 370, 0, 377,
+	378, 0, 374, 0, 0, 0, 0, 402, 632, 0,
+	428, 48, 49, 0, 63, 64, 0, 0, 70, 171,
+	172, 0, 218, 0, 0, 0, 189, 196, 196, 192,
+	197, 193, 0, 163, 0, 160, 97, 157, 0, 170,
+	170, 126, 0, 127, 128, 129, 0, 145, 0, 0,
+	0, 0, 715, 77, 223, 957, 236, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 957, 0, 957,
+	707, 708, 709, 710, 0, 83, 0, 0, 0, 0,
+	256, 303, 304, 305, 646, 0, 28, 428, 0, 0,
+	353, 0, 435, 0, 349, 352, 608, 0, 447, 0,
+	471, 454, 0, 510, 511, 518, 0, 524, 530, 533,
+	534, 346, 0, 0, 0, 0, 0, 0, 0, 0,
+	599, 557, 605, 0, 607, 0, 0, 0, 41, 0,
+	665, 655, 667, 669, 0, 0, 0, 661, 0, 386,
+	632, 0, 0, 394, 409, 410, 389, 0, 390, 0,
+	0, 413, 415, 0, 0, 398, 0, 0, 0, 0,
+	0, 0, 640, 429, 47, 67, 68, 69, 216, 219,
+	0, 198, 147, 201, 190, 191, 0, 165, 0, 162,
+	148, 122, 123, 168, 169, 167, 0, 167, 0, 152,
+	0, 958, 224, 225, 226, 227, 0, 230, 0, 81,
+	82, 0, 235, 254, 280, 620, 362, 355, 385, 436,
+	354, 525, 455, 509, 536, 548, 550, 549, 0, 0,
+	0, 0, 0, 0, 0, 603, 559, 0, 0, 0,
+	42, 0, 670, -2, 0, 0, 0, 57, 0, 640,
+	674, 675, 388, 395, 397, 392, 0, 0, 0, 0,
+	379, 380, 381, 0, 0, 0, 0, 0, 400, 46,
+	181, 0, 200, 0, 173, 166, 0, 170, 146, 170,
+	0, 0, 75, 0, 84, 85, 0, 0, 630, 0,
+	350, 0, 632, 0, 0, 0, 0, 0, 585, 556,
+	600, 0, 0, 0, 668, 0, 659, 0, 663, 662,
+	387, 45, 0, 0, 0, 371, 0, 0, 0, 0,
+	0, 424, 180, 182, 0, 187, 0, 199, 0, 178,
+	0, 175, 177, 164, 135, 136, 150, 153, 0, 0,
+	0, 0, 647, 0, 0, 526, 538, 0, 551, 553,
+	552, 554, 0, 0, 0, 574, 575, 0, 658, 0,
+	0, 393, 0, 0, 403, 0, 425, 426, 427, 375,
+	183, 184, 0, 188, 186, 0, 96, 0, 174, 176,
+	0, 248, 0, 86, 87, 80, 35, 0, 631, 621,
+	622, 624, 865, 535, 0, 537, 555, 0, 0, 0,
+	666, -2, 664, 0, 0, 382, 383, 185, 0, 179,
+	247, 0, 0, 83, 648, 649, 0, 0, 0, 0,
+	0, 539, 0, 0, 0, 0, 586, 0, 589, 0,
+	0, 0, 249, 0, 234, 0, 651, 0, 0, 654,
+	623, 0, 0, 541, 542, 543, 544, 545, 587, 0,
+	0, 0, 0, 650, 0, 653, 0, 626, 628, 0,
+	0, 0, 400, 400, 203, 0, 652, 625, 0, 629,
+	540, 0, 372, 373, 204, 205, 0, 0, 627, 588,
+	206, 0, 0, 0, 0, 0, 207, 209, 210, 0,
+	0, 208, 250, 251, 211, 212, 213,
 }
 
-var yyTok1 = [...]int{
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 111, 103, 3,
-	59, 61, 108, 106, 60, 107, 124, 109, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 283,
-	91, 90, 92, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 96, 3, 3, 3, 125, 117, 3,
+	73, 75, 122, 120, 74, 121, 139, 123, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 304, 301,
+	105, 104, 106, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 284, 3, 285, 113, 3, 3, 3, 3, 3,
+	3, 302, 3, 303, 127, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 102, 3, 114,
+	3, 3, 3, 305, 116, 306, 129,
 }
 
-var yyTok2 = [...]int{
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 89, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 104, 105, 110, 112, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	95, 97, 98, 99, 100, 101, 102, 103, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 118, 119, 124,
+	126, 128, 130, 131, 132, 133, 134, 135, 136, 137,
+	138, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
@@ -2914,9 +3367,13 @@ var yyTok2 = [...]int{
 	269, 270, 271, 272, 273, 274,
 }
 
-var yyTok3 = [...]int{
+var yyTok3 = [...]uint16{
 	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
-	57605, 280, 57606, 281, 57607, 282, 0,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2958,7 +3415,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -2997,9 +3454,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -3009,13 +3466,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -3046,30 +3503,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -3124,7 +3581,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -3135,8 +3592,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -3149,7 +3606,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -3158,18 +3615,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -3191,10 +3648,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -3230,7 +3687,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -3241,16 +3698,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -3258,35 +3715,35 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:340
+//line sql.y:372
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:345
+//line sql.y:377
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:378
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:382
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:372
+//line sql.y:404
 		{
 			setParseTree(yylex, nil)
 		}
 	case 24:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:378
+//line sql.y:410
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3296,85 +3753,107 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:386
+//line sql.y:418
 		{
 			yyVAL.selStmt = &With{CommonTableExpressions: yyDollar[2].commonTableExpressions, Select: yyDollar[4].selStmt}
 		}
 	case 26:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:390
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:422
 		{
-			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+			yyVAL.selStmt = &With{Recursive: true, CommonTableExpressions: yyDollar[3].commonTableExpressions, Select: yyDollar[5].selStmt}
 		}
 	case 27:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:426
+		{
+			union := yyDollar[1].selStmt.(*Union)
+			union.OrderBy = yyDollar[2].orderBy
+			union.Limit = yyDollar[3].limit
+			union.Lock = yyDollar[4].str
+			yyVAL.selStmt = union
+		}
+	case 28:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:394
+//line sql.y:434
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 28:
+	case 29:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:399
+//line sql.y:439
 		{
 			yyVAL.bytes = nil
 		}
-	case 29:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:403
+//line sql.y:443
 		{
 			yyVAL.bytes = []byte(",")
 		}
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:450
 		{
 			yyVAL.commonTableExpressions = []*CommonTableExpression{yyDollar[1].commonTableExpression}
 		}
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:414
+//line sql.y:454
 		{
 			yyVAL.commonTableExpressions = append(yyDollar[1].commonTableExpressions, yyDollar[3].commonTableExpression)
 		}
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:420
+//line sql.y:460
 		{
 			yyVAL.commonTableExpression = &CommonTableExpression{Name: yyDollar[1].tableIdent, Select: yyDollar[4].selStmt}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:427
+//line sql.y:467
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:434
+//line sql.y:474
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Trigger: yyDollar[11].triggers}
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:440
+//line sql.y:480
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
 		}
-	case 36:
+	case 37:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:484
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:446
+//line sql.y:490
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 37:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:450
+//line sql.y:494
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 38:
+	case 40:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:498
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 41:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:457
+//line sql.y:505
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3386,9 +3865,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 39:
+	case 42:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:469
+//line sql.y:517
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3398,334 +3877,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 40:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:481
+//line sql.y:529
 		{
 			yyVAL.str = InsertStr
 		}
-	case 41:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:533
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 42:
+	case 45:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:491
+//line sql.y:539
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 43:
+	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:497
+//line sql.y:545
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 44:
+	case 47:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:501
+//line sql.y:549
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 45:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:553
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 46:
+	case 49:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:509
+//line sql.y:557
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 47:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:514
+//line sql.y:562
 		{
 		}
-	case 48:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:515
+//line sql.y:563
 		{
 		}
-	case 49:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:519
+//line sql.y:567
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:523
+//line sql.y:571
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:529
+//line sql.y:577
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:533
+//line sql.y:581
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 53:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:538
+//line sql.y:586
 		{
 			yyVAL.partitions = nil
 		}
-	case 54:
+	case 57:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:542
+//line sql.y:590
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:548
+//line sql.y:596
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:552
+//line sql.y:600
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:556
+//line sql.y:604
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:560
+//line sql.y:608
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:614
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:618
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:624
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:580
+//line sql.y:628
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:584
+//line sql.y:632
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:638
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:594
+//line sql.y:642
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:598
+//line sql.y:646
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:602
+//line sql.y:650
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:608
+//line sql.y:656
 		{
 			yyVAL.str = SessionStr
 		}
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:612
+//line sql.y:660
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:618
+//line sql.y:666
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:623
+//line sql.y:671
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:629
+//line sql.y:677
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:634
+//line sql.y:682
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:638
+//line sql.y:686
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:642
+//line sql.y:690
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:646
+//line sql.y:694
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:651
+//line sql.y:699
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:655
+//line sql.y:703
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:709
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:666
+//line sql.y:714
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:671
+//line sql.y:719
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:677
+//line sql.y:725
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:682
+//line sql.y:730
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:688
+//line sql.y:736
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:694
+//line sql.y:742
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:701
+//line sql.y:749
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:708
+//line sql.y:756
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:712
+//line sql.y:760
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:718
+//line sql.y:766
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:723
+//line sql.y:771
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:727
+//line sql.y:775
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:731
+//line sql.y:779
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:737
+//line sql.y:785
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3735,814 +4214,814 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:748
+//line sql.y:796
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:759
+//line sql.y:807
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:764
+//line sql.y:812
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:770
+//line sql.y:818
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:774
+//line sql.y:822
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:778
+//line sql.y:826
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:782
+//line sql.y:830
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:786
+//line sql.y:834
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 105:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:790
+//line sql.y:838
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 106:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+//line sql.y:842
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:846
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 108:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:802
+//line sql.y:850
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 109:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:856
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 110:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:814
+//line sql.y:862
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 111:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:820
+//line sql.y:868
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 112:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:826
+//line sql.y:874
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 113:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:832
+//line sql.y:880
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 114:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:840
+//line sql.y:888
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 115:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:844
+//line sql.y:892
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 116:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:848
+//line sql.y:896
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:852
+//line sql.y:900
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:856
+//line sql.y:904
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 119:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:862
+//line sql.y:910
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 120:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:866
+//line sql.y:914
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 121:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:870
+//line sql.y:918
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 122:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:922
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 123:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:878
+//line sql.y:926
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 124:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:882
+//line sql.y:930
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 125:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:886
+//line sql.y:934
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 126:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:890
+//line sql.y:938
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 127:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:942
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:946
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:950
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:954
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:958
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 135:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:914
+//line sql.y:962
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 133:
+	case 136:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:919
+//line sql.y:967
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 134:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:925
+//line sql.y:973
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:929
+//line sql.y:977
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:933
+//line sql.y:981
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:937
+//line sql.y:985
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:941
+//line sql.y:989
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:945
+//line sql.y:993
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:949
+//line sql.y:997
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:953
+//line sql.y:1001
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:1007
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 143:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:964
+//line sql.y:1012
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 144:
+	case 147:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:969
+//line sql.y:1017
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 145:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:973
+//line sql.y:1021
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 146:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:978
+//line sql.y:1026
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 147:
+	case 150:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:982
+//line sql.y:1030
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 148:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:990
+//line sql.y:1038
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 149:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:994
+//line sql.y:1042
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 150:
+	case 153:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1000
+//line sql.y:1048
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 151:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1008
+//line sql.y:1056
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1012
+//line sql.y:1060
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1017
+//line sql.y:1065
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 154:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1021
+//line sql.y:1069
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 155:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1027
+//line sql.y:1075
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 156:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1079
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 157:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1035
+//line sql.y:1083
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 158:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1040
+//line sql.y:1088
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1044
+//line sql.y:1092
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 160:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1049
+//line sql.y:1097
 		{
 			yyVAL.optVal = nil
 		}
-	case 161:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1053
+//line sql.y:1101
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 162:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1058
+//line sql.y:1106
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 163:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1062
+//line sql.y:1110
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 164:
+	case 167:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1067
+//line sql.y:1115
 		{
 			yyVAL.str = ""
 		}
-	case 165:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1071
+//line sql.y:1119
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 166:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1075
+//line sql.y:1123
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 167:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1080
+//line sql.y:1128
 		{
 			yyVAL.str = ""
 		}
-	case 168:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1084
+//line sql.y:1132
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 169:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1088
+//line sql.y:1136
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 170:
+	case 173:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1093
+//line sql.y:1141
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 171:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+//line sql.y:1145
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 172:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1101
+//line sql.y:1149
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 173:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1105
+//line sql.y:1153
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 174:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1109
+//line sql.y:1157
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 175:
+	case 178:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1114
+//line sql.y:1162
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 176:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1118
+//line sql.y:1166
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 177:
+	case 180:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1124
+//line sql.y:1172
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 178:
+	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1128
+//line sql.y:1176
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 179:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1134
+//line sql.y:1182
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 180:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1138
+//line sql.y:1186
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 181:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1144
+//line sql.y:1192
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1148
+//line sql.y:1196
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 183:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1153
+//line sql.y:1201
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 184:
+	case 187:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1159
+//line sql.y:1207
 		{
 			yyVAL.str = ""
 		}
-	case 185:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1211
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 186:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1169
+//line sql.y:1217
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 187:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1173
+//line sql.y:1221
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 188:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1177
+//line sql.y:1225
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 189:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1181
+//line sql.y:1229
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 190:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1185
+//line sql.y:1233
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 191:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1239
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 192:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1195
+//line sql.y:1243
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 193:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1200
+//line sql.y:1248
 		{
 			yyVAL.str = ""
 		}
-	case 194:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1204
+//line sql.y:1252
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 195:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1258
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 196:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1214
+//line sql.y:1262
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 197:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1268
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 198:
+	case 201:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1226
+//line sql.y:1274
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 199:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1278
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 200:
+	case 203:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1237
+//line sql.y:1285
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 201:
+	case 204:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1241
+//line sql.y:1289
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 202:
+	case 205:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1245
+//line sql.y:1293
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 203:
+	case 206:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1249
+//line sql.y:1297
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 204:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1255
+//line sql.y:1303
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 205:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1261
+//line sql.y:1309
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 206:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1315
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 207:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1319
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 208:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1275
+//line sql.y:1323
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 209:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1327
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 210:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1283
+//line sql.y:1331
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 211:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1288
+//line sql.y:1336
 		{
 			yyVAL.str = ""
 		}
-	case 212:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1292
+//line sql.y:1340
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 213:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1296
+//line sql.y:1344
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 214:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1304
+//line sql.y:1352
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 215:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1308
+//line sql.y:1356
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 216:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1312
+//line sql.y:1360
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 217:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1366
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 218:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1370
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 219:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1326
+//line sql.y:1374
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 220:
+	case 223:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1332
+//line sql.y:1380
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 221:
+	case 224:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1336
+//line sql.y:1384
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 222:
+	case 225:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1340
+//line sql.y:1388
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 223:
+	case 226:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1344
+//line sql.y:1392
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 224:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1349
+//line sql.y:1397
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 225:
+	case 228:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1354
+//line sql.y:1402
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 226:
+	case 229:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1358
+//line sql.y:1406
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 227:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1362
+//line sql.y:1410
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[5].colIdent,
@@ -4550,29 +5029,29 @@ yydefault:
 				Params: yyDollar[7].vindexParams,
 			}}
 		}
-	case 228:
+	case 231:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1370
+//line sql.y:1418
 		{
 			yyVAL.statement = &DDL{Action: DropVindexStr, VindexSpec: &VindexSpec{
 				Name: yyDollar[5].colIdent,
 			}}
 		}
-	case 229:
+	case 232:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1376
+//line sql.y:1424
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 230:
+	case 233:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1380
+//line sql.y:1428
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 234:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1384
+//line sql.y:1432
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4585,9 +5064,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 232:
+	case 235:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1397
+//line sql.y:1445
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4597,59 +5076,59 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 247:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1422
+//line sql.y:1470
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 245:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1428
+//line sql.y:1476
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 246:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1480
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 247:
+	case 250:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1438
+//line sql.y:1486
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 248:
+	case 251:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1442
+//line sql.y:1490
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 249:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1448
+//line sql.y:1496
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 250:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1454
+//line sql.y:1502
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 251:
+	case 254:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1458
+//line sql.y:1506
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 252:
+	case 255:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1466
+//line sql.y:1514
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4657,16 +5136,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 253:
+	case 256:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1474
+//line sql.y:1522
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 254:
+	case 257:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1479
+//line sql.y:1527
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4674,148 +5153,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 255:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1487
+//line sql.y:1535
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 256:
+	case 259:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1491
+//line sql.y:1539
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 257:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1497
+//line sql.y:1545
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1501
+//line sql.y:1549
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 259:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1506
+//line sql.y:1554
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 260:
+	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1512
+//line sql.y:1560
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 261:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1517
+//line sql.y:1565
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 262:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1521
+//line sql.y:1569
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 263:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1526
+//line sql.y:1574
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 267:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1530
+//line sql.y:1578
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 265:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1534
+//line sql.y:1582
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 266:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1538
+//line sql.y:1586
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 267:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1542
+//line sql.y:1590
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 268:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1546
+//line sql.y:1594
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1550
+//line sql.y:1598
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1554
+//line sql.y:1602
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1558
+//line sql.y:1606
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1562
+//line sql.y:1610
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1566
+//line sql.y:1614
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 274:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1570
+//line sql.y:1618
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1574
+//line sql.y:1622
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1578
+//line sql.y:1626
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 280:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1582
+//line sql.y:1630
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 278:
+	case 281:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1587
+//line sql.y:1635
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -4825,1212 +5304,1299 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 279:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1597
+//line sql.y:1645
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1601
+//line sql.y:1649
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1605
+//line sql.y:1653
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 282:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1611
+//line sql.y:1659
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 283:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1663
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 284:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1667
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 285:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1623
+//line sql.y:1671
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1627
+//line sql.y:1675
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1631
+//line sql.y:1679
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 291:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1635
+//line sql.y:1683
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 289:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1639
+//line sql.y:1687
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1649
+//line sql.y:1697
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 291:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:1703
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 292:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
+//line sql.y:1707
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 293:
+	case 296:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1665
+//line sql.y:1713
 		{
 			yyVAL.str = ""
 		}
-	case 294:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1669
+//line sql.y:1717
 		{
 			yyVAL.str = "full "
 		}
-	case 295:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1675
+//line sql.y:1723
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 296:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1679
+//line sql.y:1727
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 297:
+	case 300:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1685
+//line sql.y:1733
 		{
 			yyVAL.str = ""
 		}
-	case 298:
+	case 301:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1689
+//line sql.y:1737
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 299:
+	case 302:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1693
+//line sql.y:1741
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 300:
+	case 303:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1699
+//line sql.y:1747
 		{
 			yyVAL.showFilter = nil
 		}
-	case 301:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1703
+//line sql.y:1751
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1707
+//line sql.y:1755
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 303:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1713
+//line sql.y:1761
 		{
 			yyVAL.str = ""
 		}
-	case 304:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1717
+//line sql.y:1765
 		{
 			yyVAL.str = SessionStr
 		}
-	case 305:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1721
+//line sql.y:1769
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 306:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1727
+//line sql.y:1775
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 307:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1731
+//line sql.y:1779
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 308:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:1785
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 309:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1741
+//line sql.y:1789
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 310:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1795
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 311:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1753
+//line sql.y:1801
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 312:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1759
+//line sql.y:1807
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 313:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1763
+//line sql.y:1811
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 314:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1815
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 315:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1819
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1775
+//line sql.y:1823
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 317:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1779
+//line sql.y:1827
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 318:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1783
+//line sql.y:1831
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 319:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1789
+//line sql.y:1837
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 320:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1793
+//line sql.y:1841
 		{
 			setAllowComments(yylex, true)
 		}
-	case 321:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1797
+//line sql.y:1845
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 322:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1803
+//line sql.y:1851
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 323:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1807
+//line sql.y:1855
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 324:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1861
 		{
 			yyVAL.str = UnionStr
 		}
-	case 325:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1817
+//line sql.y:1865
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 326:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1821
+//line sql.y:1869
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 327:
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1873
+		{
+			yyVAL.str = ExceptStr
+		}
+	case 331:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1877
+		{
+			yyVAL.str = ExceptAllStr
+		}
+	case 332:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1881
+		{
+			yyVAL.str = ExceptDistinctStr
+		}
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1887
+		{
+			yyVAL.str = IntersectStr
+		}
+	case 334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1891
+		{
+			yyVAL.str = IntersectAllStr
+		}
+	case 335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1895
+		{
+			yyVAL.str = IntersectDistinctStr
+		}
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1826
+//line sql.y:1900
 		{
 			yyVAL.str = ""
 		}
-	case 328:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1830
+//line sql.y:1904
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 329:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1834
+//line sql.y:1908
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 330:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1839
+//line sql.y:1913
 		{
 			yyVAL.str = ""
 		}
-	case 331:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1843
+//line sql.y:1917
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 332:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1848
+//line sql.y:1922
 		{
 			yyVAL.str = ""
 		}
-	case 333:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1852
+//line sql.y:1926
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 334:
+	case 343:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1857
+//line sql.y:1931
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 335:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1861
+//line sql.y:1935
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 336:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1867
+//line sql.y:1941
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 337:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1871
+//line sql.y:1945
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 338:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1877
+	case 347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1951
 		{
-			yyVAL.selectExpr = &StarExpr{}
+			yyVAL.selectExpr = &StarExpr{Except: yyDollar[2].columns, Replace: yyDollar[3].selectExprs}
 		}
-	case 339:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1881
+//line sql.y:1955
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 340:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+	case 349:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1959
 		{
-			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}, Except: yyDollar[4].columns, Replace: yyDollar[5].selectExprs}
 		}
-	case 341:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1889
+	case 350:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1963
 		{
-			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Except: yyDollar[6].columns, Replace: yyDollar[7].selectExprs}
 		}
-	case 342:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1893
+//line sql.y:1967
 		{
 			yyVAL.selectExpr = &ObjectExplode{Object: yyDollar[1].expr}
 		}
-	case 343:
+	case 352:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1973
+		{
+			yyVAL.columns = nil
+		}
+	case 353:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1977
+		{
+			yyVAL.columns = yyDollar[3].columns
+		}
+	case 354:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1982
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 355:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1986
+		{
+			yyVAL.selectExprs = yyDollar[3].selectExprs
+		}
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1898
+//line sql.y:1991
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 344:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1902
+//line sql.y:1995
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 345:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1906
+//line sql.y:1999
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 347:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:2006
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 348:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1918
+//line sql.y:2011
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 349:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1922
+//line sql.y:2015
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 350:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:2021
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 351:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1932
+//line sql.y:2025
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 354:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1942
+//line sql.y:2035
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 355:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2039
 		{
-			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
+			yyVAL.tableExpr = &AliasedTableExpr{Position: yyDollar[1].pos, Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 356:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1950
+//line sql.y:2043
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 357:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1956
+//line sql.y:2049
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 358:
+	case 371:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1960
+//line sql.y:2053
+		{
+			yyVAL.tableExpr = &TableValuedFunction{Position: yyDollar[1].pos, Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
+		}
+	case 372:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2057
+		{
+			yyVAL.tableExpr = &PivotTableExpr{Source: yyDollar[1].tableExpr, Aggregates: yyDollar[4].selectExprs, Column: yyDollar[6].colName, Values: yyDollar[9].selectExprs, As: yyDollar[12].tableIdent}
+		}
+	case 373:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2061
 		{
-			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
+			yyVAL.tableExpr = &UnpivotTableExpr{Source: yyDollar[1].tableExpr, ValueColumn: yyDollar[4].colIdent, NameColumn: yyDollar[6].colIdent, Columns: yyDollar[9].columns, As: yyDollar[12].tableIdent}
 		}
-	case 359:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:2067
 		{
-			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+			yyVAL.aliasedTableName = &AliasedTableExpr{Position: yyDollar[1].pos, Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 360:
+	case 375:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1970
+//line sql.y:2071
 		{
-			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
+			yyVAL.aliasedTableName = &AliasedTableExpr{Position: yyDollar[1].pos, Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 361:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1975
+//line sql.y:2076
 		{
 			yyVAL.tableValuedFunctionArguments = nil
 		}
-	case 362:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1979
+//line sql.y:2080
 		{
 			yyVAL.tableValuedFunctionArguments = yyDollar[1].tableValuedFunctionArguments
 		}
-	case 363:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1985
+//line sql.y:2086
 		{
 			yyVAL.tableValuedFunctionArguments = TableValuedFunctionArguments{yyDollar[1].tableValuedFunctionArgument}
 		}
-	case 364:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1989
+//line sql.y:2090
 		{
 			yyVAL.tableValuedFunctionArguments = append(yyVAL.tableValuedFunctionArguments, yyDollar[3].tableValuedFunctionArgument)
 		}
-	case 365:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1995
+//line sql.y:2096
 		{
 			yyVAL.tableValuedFunctionArgument = &TableValuedFunctionArgument{Name: yyDollar[1].colIdent, Value: yyDollar[3].tableValuedFunctionArgumentValue}
 		}
-	case 366:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2001
+//line sql.y:2102
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &ExprTableValuedFunctionArgumentValue{Expr: yyDollar[1].expr}
 		}
-	case 367:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2005
+//line sql.y:2106
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &TableDescriptorTableValuedFunctionArgumentValue{Table: yyDollar[3].tableExpr}
 		}
-	case 368:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2009
+//line sql.y:2110
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &FieldDescriptorTableValuedFunctionArgumentValue{Field: yyDollar[3].colName}
 		}
-	case 369:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2116
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 370:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2019
+//line sql.y:2120
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 371:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2025
+//line sql.y:2126
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 372:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2029
+//line sql.y:2130
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 373:
+	case 388:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2042
+//line sql.y:2143
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
+			yyVAL.tableExpr = &JoinTableExpr{Position: yyDollar[3].pos, LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
 		}
-	case 374:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2046
+//line sql.y:2147
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyVAL.tableExpr = &JoinTableExpr{Position: yyDollar[2].pos, LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 375:
+	case 390:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2050
+//line sql.y:2151
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyVAL.tableExpr = &JoinTableExpr{Position: yyDollar[2].pos, LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 376:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2155
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+			yyVAL.tableExpr = &JoinTableExpr{Position: yyDollar[2].pos, LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 377:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2060
+//line sql.y:2161
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 378:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2062
+//line sql.y:2163
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 379:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2066
+//line sql.y:2167
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 380:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2068
+//line sql.y:2169
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 381:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2072
+//line sql.y:2173
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 382:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2074
+//line sql.y:2175
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 383:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2077
+//line sql.y:2178
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 384:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2079
+//line sql.y:2180
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 385:
+	case 400:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2082
+//line sql.y:2183
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 386:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2187
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 387:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2090
+//line sql.y:2191
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 388:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2095
+//line sql.y:2196
 		{
 			yyVAL.str = UndefinedJoinStrategy
 		}
-	case 389:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2097
+//line sql.y:2198
 		{
 			yyVAL.str = LookupJoinStrategy
 		}
-	case 390:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2099
+//line sql.y:2200
 		{
 			yyVAL.str = StreamJoinStrategy
 		}
-	case 392:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2104
+//line sql.y:2205
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 393:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2110
+//line sql.y:2211
 		{
 			yyVAL.str = JoinStr
 		}
-	case 394:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2114
+//line sql.y:2215
 		{
 			yyVAL.str = JoinStr
 		}
-	case 395:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2118
+//line sql.y:2219
 		{
 			yyVAL.str = JoinStr
 		}
-	case 396:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2124
+//line sql.y:2225
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 397:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2130
+//line sql.y:2231
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 398:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2134
+//line sql.y:2235
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 399:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2138
+//line sql.y:2239
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 400:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2142
+//line sql.y:2243
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 401:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2146
+//line sql.y:2247
 		{
 			yyVAL.str = OuterJoinStr
 		}
-	case 402:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2152
+//line sql.y:2253
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 403:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2156
+//line sql.y:2257
 		{
-			if yyDollar[2].str == LeftJoinStr {
+			switch yyDollar[2].str {
+			case LeftJoinStr:
 				yyVAL.str = NaturalLeftJoinStr
-			} else {
+			case RightJoinStr:
 				yyVAL.str = NaturalRightJoinStr
+			default:
+				yyVAL.str = NaturalOuterJoinStr
 			}
 		}
-	case 404:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2166
+//line sql.y:2270
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 405:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2170
+//line sql.y:2274
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 406:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2176
+//line sql.y:2280
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 407:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2180
+//line sql.y:2284
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 408:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2186
+//line sql.y:2290
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 409:
+	case 424:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2191
+//line sql.y:2295
 		{
 			yyVAL.indexHints = nil
 		}
-	case 410:
+	case 425:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2195
+//line sql.y:2299
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 411:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2199
+//line sql.y:2303
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 412:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2203
+//line sql.y:2307
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 413:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2208
+//line sql.y:2312
 		{
 			yyVAL.expr = nil
 		}
-	case 414:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2212
+//line sql.y:2316
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 415:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2218
+//line sql.y:2322
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 416:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2222
+//line sql.y:2326
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 417:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2226
+//line sql.y:2330
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 418:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2230
+//line sql.y:2334
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 419:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2234
+//line sql.y:2338
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 420:
+	case 435:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2342
+		{
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: IsDistinctFromStr, Right: yyDollar[5].expr}
+		}
+	case 436:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2346
+		{
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: IsNotDistinctFromStr, Right: yyDollar[6].expr}
+		}
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2238
+//line sql.y:2350
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 421:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2242
+//line sql.y:2354
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 422:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2248
+//line sql.y:2360
 		{
 			yyVAL.str = ""
 		}
-	case 423:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2252
+//line sql.y:2364
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 424:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2258
+//line sql.y:2370
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 425:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2262
+//line sql.y:2374
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 426:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2268
+//line sql.y:2380
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 427:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2272
+//line sql.y:2384
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 428:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2276
+//line sql.y:2388
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 429:
+	case 446:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2280
+//line sql.y:2392
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 430:
+	case 447:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2284
+//line sql.y:2396
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 431:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2288
+//line sql.y:2400
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: LikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 432:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2292
+//line sql.y:2404
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: LikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 433:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2296
+//line sql.y:2408
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: NotLikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 434:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2300
+//line sql.y:2412
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: NotLikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 435:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2304
+//line sql.y:2416
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 436:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2308
+//line sql.y:2420
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+			yyVAL.expr = &ComparisonExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 437:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2312
+//line sql.y:2424
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 438:
+	case 455:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2316
+//line sql.y:2428
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 439:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2320
+//line sql.y:2432
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 440:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2326
+//line sql.y:2438
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 441:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2330
+//line sql.y:2442
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 442:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2334
+//line sql.y:2446
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 443:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2338
+//line sql.y:2450
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 444:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2342
+//line sql.y:2454
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 445:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2346
+//line sql.y:2458
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 446:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2352
+//line sql.y:2464
 		{
 			yyVAL.str = EqualStr
 		}
-	case 447:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2356
+//line sql.y:2468
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 448:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2360
+//line sql.y:2472
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 449:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2364
+//line sql.y:2476
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 450:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2368
+//line sql.y:2480
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 451:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2372
+//line sql.y:2484
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 452:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2376
+//line sql.y:2488
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 453:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2381
+//line sql.y:2493
 		{
 			yyVAL.expr = nil
 		}
-	case 454:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2385
+//line sql.y:2497
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 455:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2391
+//line sql.y:2503
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 456:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2395
+//line sql.y:2507
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 457:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2399
+//line sql.y:2511
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 458:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2405
+//line sql.y:2517
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 459:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2411
+//line sql.y:2523
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 460:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2415
+//line sql.y:2527
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 461:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2421
+//line sql.y:2533
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 462:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2425
+//line sql.y:2537
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 463:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2429
+//line sql.y:2541
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 464:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2433
+//line sql.y:2545
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 465:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2437
+//line sql.y:2549
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 466:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2441
+//line sql.y:2553
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 467:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2445
+//line sql.y:2557
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2449
+//line sql.y:2561
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 469:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2453
+//line sql.y:2565
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 470:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2569
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 471:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2573
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 472:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2465
+//line sql.y:2577
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 473:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2469
+//line sql.y:2581
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 474:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2473
+//line sql.y:2585
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 475:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2477
+//line sql.y:2589
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 476:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2481
+//line sql.y:2593
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 477:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2485
+//line sql.y:2597
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 478:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2489
+//line sql.y:2601
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 479:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2493
+//line sql.y:2605
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 480:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2609
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 481:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2501
+//line sql.y:2613
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 482:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2505
+//line sql.y:2617
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6038,9 +6604,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 483:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2513
+//line sql.y:2625
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6054,21 +6620,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 484:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2527
+//line sql.y:2639
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 485:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2531
+//line sql.y:2643
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 486:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2535
+//line sql.y:2647
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6076,447 +6642,652 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 491:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2547
+//line sql.y:2659
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ArrayElement, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Position: yyDollar[2].pos, Left: yyDollar[1].expr, Operator: ArrayElement, Right: yyDollar[3].expr}
 		}
-	case 492:
+	case 509:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2663
+		{
+			yyVAL.expr = &SliceExpr{Position: yyDollar[2].pos, Expr: yyDollar[1].expr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 510:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2667
+		{
+			yyVAL.expr = &SliceExpr{Position: yyDollar[2].pos, Expr: yyDollar[1].expr, From: yyDollar[3].expr}
+		}
+	case 511:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2671
+		{
+			yyVAL.expr = &SliceExpr{Position: yyDollar[2].pos, Expr: yyDollar[1].expr, To: yyDollar[4].expr}
+		}
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2551
+//line sql.y:2675
 		{
-			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
+			yyVAL.expr = &ListExpr{Position: yyDollar[1].pos, Exprs: yyDollar[2].exprs}
 		}
-	case 493:
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2679
+		{
+			yyVAL.expr = &ListExpr{Position: yyDollar[1].pos}
+		}
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2555
+//line sql.y:2683
 		{
-			yyVAL.expr = &ObjectFieldAccess{Object: yyDollar[1].expr, Field: yyDollar[3].colIdent}
+			yyVAL.expr = &ObjectExpr{Position: yyDollar[1].pos, Fields: yyDollar[2].objectFields}
 		}
-	case 494:
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2687
+		{
+			yyVAL.expr = &ObjectExpr{Position: yyDollar[1].pos}
+		}
+	case 516:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2691
+		{
+			yyVAL.expr = &ConvertExpr{Position: yyDollar[2].pos, Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
+		}
+	case 517:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2695
+		{
+			yyVAL.expr = &ObjectFieldAccess{Position: yyDollar[2].pos, Object: yyDollar[1].expr, Field: yyDollar[3].colIdent}
+		}
+	case 518:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2699
+		{
+			yyVAL.expr = &FuncExpr{Position: yyDollar[2].pos, Name: NewColIdent("at_time_zone"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[1].expr}, &AliasedExpr{Expr: yyDollar[5].expr}}}
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2705
+		{
+			yyVAL.objectFields = []*ObjectField{yyDollar[1].objectField}
+		}
+	case 520:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2709
+		{
+			yyVAL.objectFields = append(yyDollar[1].objectFields, yyDollar[3].objectField)
+		}
+	case 521:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2715
+		{
+			yyVAL.objectField = &ObjectField{Name: string(yyDollar[1].bytes), Value: yyDollar[3].expr}
+		}
+	case 522:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2719
+		{
+			yyVAL.objectField = &ObjectField{Name: yyDollar[1].colIdent.String(), Value: yyDollar[3].expr}
+		}
+	case 523:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2565
+//line sql.y:2729
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 495:
+	case 524:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2569
+//line sql.y:2733
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 496:
+	case 525:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2573
+//line sql.y:2737
 		{
-			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 497:
+	case 526:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2741
+		{
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[7].overClause}
+		}
+	case 527:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2746
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2750
+		{
+			yyVAL.selectExprs = yyDollar[1].selectExprs
+		}
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2756
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 530:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2760
+		{
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2766
+		{
+			yyVAL.selectExpr = yyDollar[1].selectExpr
+		}
+	case 532:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2770
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr}
+		}
+	case 533:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2780
+		{
+			yyVAL.expr = &LambdaExpr{Position: yyDollar[2].pos, Parameters: Exprs{&ColName{Name: yyDollar[1].colIdent, Position: yyDollar[1].pos}}, Body: yyDollar[3].expr}
+		}
+	case 534:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2784
+		{
+			yyVAL.expr = &LambdaExpr{Position: yyDollar[2].pos, Parameters: lambdaParameters(yyDollar[1].expr), Body: yyDollar[3].expr}
+		}
+	case 535:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2790
+		{
+			yyVAL.overClause = &OverClause{PartitionBy: yyDollar[1].exprs, OrderBy: yyDollar[2].orderBy, Frame: yyDollar[3].windowFrame}
+		}
+	case 536:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2795
+		{
+			yyVAL.exprs = nil
+		}
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2799
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 538:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2804
+		{
+			yyVAL.windowFrame = nil
+		}
+	case 539:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2808
+		{
+			yyVAL.windowFrame = &WindowFrame{Start: yyDollar[2].frameBound, End: &FrameBound{Type: CurrentRowStr}}
+		}
+	case 540:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2812
+		{
+			yyVAL.windowFrame = &WindowFrame{Start: yyDollar[3].frameBound, End: yyDollar[5].frameBound}
+		}
+	case 541:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2818
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPrecedingStr}
+		}
+	case 542:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2822
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowingStr}
+		}
+	case 543:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2826
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRowStr}
+		}
+	case 544:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2830
+		{
+			yyVAL.frameBound = &FrameBound{Type: PrecedingStr, Offset: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2834
+		{
+			yyVAL.frameBound = &FrameBound{Type: FollowingStr, Offset: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2583
+//line sql.y:2844
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 498:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2587
+//line sql.y:2848
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 499:
+	case 548:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2591
+//line sql.y:2852
 		{
-			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+			yyVAL.expr = &ConvertExpr{Position: yyDollar[1].pos, Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 500:
+	case 549:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2595
+//line sql.y:2856
 		{
-			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+			yyVAL.expr = &ConvertExpr{Position: yyDollar[1].pos, Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 501:
+	case 550:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2599
+//line sql.y:2860
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 502:
+	case 551:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2603
+//line sql.y:2864
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 503:
+	case 552:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2607
+//line sql.y:2868
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 504:
+	case 553:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2611
+//line sql.y:2872
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 505:
+	case 554:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2615
+//line sql.y:2876
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 506:
+	case 555:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2619
+//line sql.y:2880
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 507:
+	case 556:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2623
+//line sql.y:2884
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 508:
+	case 557:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2627
+//line sql.y:2888
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 509:
+	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2631
+//line sql.y:2892
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 510:
+	case 559:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2896
+		{
+			// EXTRACT(part FROM t) is just a different syntax for date_part('part', t).
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("date_part"), Exprs: SelectExprs{&AliasedExpr{Expr: NewStrVal([]byte(yyDollar[3].colIdent.Lowered()))}, &AliasedExpr{Expr: yyDollar[5].expr}}}
+		}
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+//line sql.y:2907
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("current_timestamp")}
 		}
-	case 511:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2645
+//line sql.y:2911
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("utc_timestamp")}
 		}
-	case 512:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2649
+//line sql.y:2915
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("utc_time")}
 		}
-	case 513:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2654
+//line sql.y:2920
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("utc_date")}
 		}
-	case 514:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2659
+//line sql.y:2925
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("localtime")}
 		}
-	case 515:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2664
+//line sql.y:2930
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("localtimestamp")}
 		}
-	case 516:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2670
+//line sql.y:2936
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("current_date")}
 		}
-	case 517:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2675
+//line sql.y:2941
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("current_time")}
 		}
-	case 518:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2946
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 519:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2684
+//line sql.y:2950
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 520:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2688
+//line sql.y:2954
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 521:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2693
+//line sql.y:2959
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 522:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2698
+//line sql.y:2964
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 523:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2703
+//line sql.y:2969
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 524:
+	case 574:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2707
+//line sql.y:2973
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 525:
+	case 575:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2711
+//line sql.y:2977
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 528:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2721
+//line sql.y:2987
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 529:
+	case 579:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2731
+//line sql.y:2997
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 530:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2735
+//line sql.y:3001
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 531:
+	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2739
+//line sql.y:3005
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 532:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2743
+//line sql.y:3009
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 533:
+	case 583:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2747
+//line sql.y:3013
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 534:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2751
+//line sql.y:3017
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Position: yyDollar[1].pos, Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 535:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2757
+//line sql.y:3023
 		{
 			yyVAL.str = ""
 		}
-	case 536:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2761
+//line sql.y:3027
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 537:
+	case 587:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2765
+//line sql.y:3031
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 538:
+	case 588:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2769
+//line sql.y:3035
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 539:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2773
+//line sql.y:3039
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 540:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2779
+//line sql.y:3045
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 541:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2783
+//line sql.y:3049
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 542:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2789
+//line sql.y:3055
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 543:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2793
+//line sql.y:3059
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 544:
+	case 594:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3063
+		{
+			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
+		}
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2797
+//line sql.y:3067
 		{
 			yyVAL.convertType = &ConvertTypeList{}
 		}
-	case 545:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2801
+//line sql.y:3071
 		{
 			yyVAL.convertType = &ConvertTypeObject{}
 		}
-	case 546:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2806
+//line sql.y:3076
 		{
 			yyVAL.expr = nil
 		}
-	case 547:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2810
+//line sql.y:3080
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 548:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2815
+//line sql.y:3085
 		{
 			yyVAL.str = string("")
 		}
-	case 549:
+	case 600:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2819
+//line sql.y:3089
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 550:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2825
+//line sql.y:3095
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 551:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2829
+//line sql.y:3099
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 552:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2835
+//line sql.y:3105
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 553:
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2840
+//line sql.y:3110
 		{
 			yyVAL.expr = nil
 		}
-	case 554:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2844
+//line sql.y:3114
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 555:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2850
+//line sql.y:3120
 		{
-			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+			yyVAL.colName = &ColName{Position: yyDollar[1].pos, Name: yyDollar[1].colIdent}
 		}
-	case 556:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2854
+//line sql.y:3124
 		{
-			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+			yyVAL.colName = &ColName{Position: yyDollar[1].pos, Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 557:
+	case 608:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2858
+//line sql.y:3128
 		{
-			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+			yyVAL.colName = &ColName{Position: yyDollar[1].pos, Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 558:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2864
+//line sql.y:3134
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 559:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2868
+//line sql.y:3138
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 560:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2872
+//line sql.y:3142
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 561:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2876
+//line sql.y:3146
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 562:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2880
+//line sql.y:3150
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 563:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2884
+//line sql.y:3154
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 564:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2888
+//line sql.y:3158
 		{
-			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+			yyVAL.expr = &SQLVal{Type: ValArg, Val: yyDollar[1].bytes, Position: yyDollar[1].pos}
 		}
-	case 565:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2892
+//line sql.y:3162
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 566:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2898
+//line sql.y:3168
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6525,287 +7296,335 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 567:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2907
+//line sql.y:3177
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 568:
+	case 619:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2911
+//line sql.y:3181
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 569:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2916
+//line sql.y:3186
 		{
 			yyVAL.exprs = nil
 		}
-	case 570:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2920
+//line sql.y:3190
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 571:
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3196
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 623:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3200
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3206
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 625:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3210
+		{
+			yyVAL.expr = &GroupingSets{Sets: yyDollar[4].exprs}
+		}
+	case 626:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3216
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 627:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3220
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 628:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3226
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 629:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3230
+		{
+			yyVAL.expr = ValTuple{}
+		}
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2925
+//line sql.y:3235
 		{
 			yyVAL.expr = nil
 		}
-	case 572:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2929
+//line sql.y:3239
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 573:
+	case 632:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2934
+//line sql.y:3244
 		{
 			yyVAL.orderBy = nil
 		}
-	case 574:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2938
+//line sql.y:3248
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 575:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2944
+//line sql.y:3254
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 576:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2948
+//line sql.y:3258
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 577:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2954
+//line sql.y:3264
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 578:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2959
+//line sql.y:3269
 		{
 			yyVAL.str = AscScr
 		}
-	case 579:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2963
+//line sql.y:3273
 		{
 			yyVAL.str = AscScr
 		}
-	case 580:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2967
+//line sql.y:3277
 		{
 			yyVAL.str = DescScr
 		}
-	case 581:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2972
+//line sql.y:3282
 		{
 			yyVAL.limit = nil
 		}
-	case 582:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2976
+//line sql.y:3286
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 583:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2980
+//line sql.y:3290
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 584:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2984
+//line sql.y:3294
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 585:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2989
+//line sql.y:3299
 		{
 			yyVAL.str = ""
 		}
-	case 586:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2993
+//line sql.y:3303
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 587:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2997
+//line sql.y:3307
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 588:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3002
+//line sql.y:3312
 		{
 			yyVAL.triggers = nil
 		}
-	case 589:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3006
+//line sql.y:3316
 		{
 			yyVAL.triggers = yyDollar[2].triggers
 		}
-	case 590:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3012
+//line sql.y:3322
 		{
 			yyVAL.triggers = []Trigger{yyDollar[1].trigger}
 		}
-	case 591:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3326
 		{
 			yyVAL.triggers = append(yyDollar[1].triggers, yyDollar[3].trigger)
 		}
-	case 592:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3022
+//line sql.y:3332
 		{
 			yyVAL.trigger = &WatermarkTrigger{}
 		}
-	case 593:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3026
+//line sql.y:3336
 		{
 			yyVAL.trigger = &EndOfStreamTrigger{}
 		}
-	case 594:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3030
+//line sql.y:3340
 		{
 			yyVAL.trigger = &DelayTrigger{Delay: yyDollar[3].expr}
 		}
-	case 595:
+	case 654:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3034
+//line sql.y:3344
 		{
 			yyVAL.trigger = &CountingTrigger{Count: yyDollar[2].expr}
 		}
-	case 596:
+	case 655:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3047
+//line sql.y:3357
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 597:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3051
+//line sql.y:3361
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 598:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3055
+//line sql.y:3365
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 599:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3060
+//line sql.y:3370
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 600:
+	case 659:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3064
+//line sql.y:3374
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 601:
+	case 660:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3068
+//line sql.y:3378
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 602:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3075
+//line sql.y:3385
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 603:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3079
+//line sql.y:3389
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 604:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3083
+//line sql.y:3393
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 605:
+	case 664:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3087
+//line sql.y:3397
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 606:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3092
+//line sql.y:3402
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 607:
+	case 666:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3096
+//line sql.y:3406
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 608:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3102
+//line sql.y:3412
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 609:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3106
+//line sql.y:3416
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 610:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3112
+//line sql.y:3422
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 611:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3116
+//line sql.y:3426
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 612:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3122
+//line sql.y:3432
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 613:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3128
+//line sql.y:3438
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -6813,312 +7632,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 614:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3138
+//line sql.y:3448
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 615:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3142
+//line sql.y:3452
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 616:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3148
+//line sql.y:3458
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 617:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3464
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 618:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3158
+//line sql.y:3468
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 619:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3164
+//line sql.y:3474
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 620:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3168
+//line sql.y:3478
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 621:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3172
+//line sql.y:3482
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 622:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3486
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 624:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3183
+//line sql.y:3493
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 626:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3190
+//line sql.y:3500
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 627:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3194
+//line sql.y:3504
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 628:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3198
+//line sql.y:3508
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 631:
+	case 690:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3207
+//line sql.y:3517
 		{
 			yyVAL.byt = 0
 		}
-	case 632:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3209
+//line sql.y:3519
 		{
 			yyVAL.byt = 1
 		}
-	case 633:
+	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3212
+//line sql.y:3522
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3214
+//line sql.y:3524
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 635:
+	case 694:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3217
+//line sql.y:3527
 		{
 			yyVAL.str = ""
 		}
-	case 636:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3219
+//line sql.y:3529
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 637:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3223
+//line sql.y:3533
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3225
+//line sql.y:3535
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3227
+//line sql.y:3537
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3229
+//line sql.y:3539
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3231
+//line sql.y:3541
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3233
+//line sql.y:3543
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3235
+//line sql.y:3545
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 644:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3237
+//line sql.y:3547
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3239
+//line sql.y:3549
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3241
+//line sql.y:3551
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 706:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3244
+//line sql.y:3554
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 648:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3246
+//line sql.y:3556
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3248
+//line sql.y:3558
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3252
+//line sql.y:3562
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3254
+//line sql.y:3564
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 711:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3257
+//line sql.y:3567
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3259
+//line sql.y:3569
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 654:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3261
+//line sql.y:3571
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 655:
+	case 714:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3264
+//line sql.y:3574
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 656:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3266
+//line sql.y:3576
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 657:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3270
+//line sql.y:3580
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 658:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3274
+//line sql.y:3584
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 660:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3281
+//line sql.y:3591
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 661:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3287
+//line sql.y:3597
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 662:
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3291
+//line sql.y:3601
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 664:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3298
+//line sql.y:3608
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 880:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3539
+//line sql.y:3865
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 881:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3548
+//line sql.y:3874
 		{
 			decNesting(yylex)
 		}
-	case 882:
+	case 957:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3553
+//line sql.y:3879
 		{
 			skipToEnd(yylex)
 		}
-	case 883:
+	case 958:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3558
+//line sql.y:3884
 		{
 			skipToEnd(yylex)
 		}
-	case 884:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3562
+//line sql.y:3888
 		{
 			skipToEnd(yylex)
 		}
-	case 885:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3566
+//line sql.y:3892
 		{
 			skipToEnd(yylex)
 		}
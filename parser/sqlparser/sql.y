@@ -54,6 +54,14 @@ func skipToEnd(yylex interface{}) {
   yylex.(*Tokenizer).SkipToEnd = true
 }
 
+// lambdaParameters returns the elements of the tuple a lambda's parameters got parsed as.
+func lambdaParameters(tuple Expr) Exprs {
+  if paren, ok := tuple.(*ParenExpr); ok {
+    return Exprs{paren.Expr}
+  }
+  return Exprs(tuple.(ValTuple))
+}
+
 %}
 
 %union {
@@ -70,6 +78,7 @@ func skipToEnd(yylex interface{}) {
   ins           *Insert
   byt           byte
   bytes         []byte
+  pos           int
   bytes2        [][]byte
   str           string
   strs          []string
@@ -96,6 +105,9 @@ func skipToEnd(yylex interface{}) {
   when          *When
   orderBy       OrderBy
   order         *Order
+  overClause    *OverClause
+  windowFrame   *WindowFrame
+  frameBound    *FrameBound
   limit         *Limit
   triggers 			[]Trigger
   trigger				Trigger
@@ -129,11 +141,20 @@ func skipToEnd(yylex interface{}) {
   vindexParams  []VindexParam
   showFilter    *ShowFilter
   optLike       *OptLike
+  objectFields  []*ObjectField
+  objectField   *ObjectField
 }
 
 %token LEX_ERROR
-%left <bytes> UNION
+// STAR_MODIFIER is only used to prefer star expression modifiers over set operations when resolving conflicts.
+%nonassoc <bytes> STAR_MODIFIER
+%left <bytes> UNION EXCEPT
+// INTERSECT binds tighter than UNION and EXCEPT.
+%left <bytes> INTERSECT
 %token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR WATERMARK DELAY COUNTING AFTER
+%token <bytes> OVER ROWS PRECEDING FOLLOWING UNBOUNDED CURRENT ROW
+%token <bytes> GROUPING SETS
+%token <bytes> PIVOT UNPIVOT
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
@@ -159,6 +180,7 @@ func skipToEnd(yylex interface{}) {
 %left <bytes> '+' '-'
 %left <bytes> '*' '/' DIV '%' MOD
 %left <bytes> '^'
+%left <bytes> AT
 %right <bytes> '~' NOT_LIKE_REGEXP LIKE_REGEXP_CASE_INSENSITIVE NOT_LIKE_REGEXP_CASE_INSENSITIVE UNARY
 %left <bytes> COLLATE
 %right <bytes> BINARY UNDERSCORE_BINARY UNDERSCORE_UTF8MB4
@@ -209,15 +231,16 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> SUBSTR SUBSTRING
 %token <bytes> GROUP_CONCAT SEPARATOR
 %token <bytes> TIMESTAMPADD TIMESTAMPDIFF
+%token <bytes> EXTRACT ZONE
 
 // Match
-%token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
+%token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION RECURSIVE
 
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement base_select set_operation union_lhs
 %type <bytes> comma_opt
 %type <commonTableExpression> cte
 %type <commonTableExpressions> cte_list
@@ -227,11 +250,13 @@ func skipToEnd(yylex interface{}) {
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement
 %type <bytes2> comment_opt comment_list
-%type <str> union_op insert_or_replace
+%type <str> union_op intersect_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
 %type <expr> like_escape_opt
-%type <selectExprs> select_expression_list select_expression_list_opt
-%type <selectExpr> select_expression
+%type <selectExprs> select_expression_list select_expression_list_opt star_replace_opt
+%type <selectExpr> select_expression function_argument
+%type <selectExprs> function_argument_list function_argument_list_opt
+%type <expr> lambda_expression
 %type <expr> expression
 %type <tableExprs> from_opt table_references
 %type <tableExpr> table_reference table_factor join_table
@@ -257,14 +282,21 @@ func skipToEnd(yylex interface{}) {
 %type <values> tuple_list
 %type <valTuple> row_tuple tuple_or_empty
 %type <expr> tuple_expression
+%type <objectFields> object_field_list
+%type <objectField> object_field
 %type <subquery> subquery
 %type <colName> column_name
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt group_by_list grouping_set_list
+%type <expr> group_by_element grouping_set
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
+%type <overClause> over_clause
+%type <exprs> partition_by_opt
+%type <windowFrame> window_frame_opt
+%type <frameBound> frame_bound
 %type <order> order
 %type <str> asc_desc_opt
 %type <limit> limit_opt
@@ -272,7 +304,7 @@ func skipToEnd(yylex interface{}) {
 %type <triggers> trigger_opt
 %type <triggers> trigger_list
 %type <trigger> trigger
-%type <columns> ins_column_list column_list
+%type <columns> ins_column_list column_list star_except_opt
 %type <partitions> opt_partition_clause partition_list
 %type <updateExprs> on_dup_opt
 %type <updateExprs> update_list
@@ -386,9 +418,17 @@ select_statement:
 	{
 		$$ = &With{CommonTableExpressions: $2, Select: $4}
 	}
-| union_lhs union_op union_rhs order_by_opt limit_opt lock_opt
+| WITH RECURSIVE cte_list comma_opt select_statement
+	{
+		$$ = &With{Recursive: true, CommonTableExpressions: $3, Select: $5}
+	}
+| set_operation order_by_opt limit_opt lock_opt
   {
-    $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
+    union := $1.(*Union)
+    union.OrderBy = $2
+    union.Limit = $3
+    union.Lock = $4
+    $$ = union
   }
 | SELECT comment_opt cache_opt NEXT num_val for_from table_name
   {
@@ -435,13 +475,17 @@ base_select:
     $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Trigger: $11}
   }
 
-union_lhs:
-	openb select_statement closeb
+set_operation:
+  union_lhs union_op union_lhs %prec UNION
   {
-    $$ = &ParenSelect{Select: $2}
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
+  }
+| union_lhs intersect_op union_lhs %prec INTERSECT
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
   }
 
-union_rhs:
+union_lhs:
   base_select
   {
     $$ = $1
@@ -450,6 +494,10 @@ union_rhs:
   {
     $$ = &ParenSelect{Select: $2}
   }
+| set_operation
+  {
+    $$ = $1
+  }
 
 
 insert_statement:
@@ -1821,6 +1869,32 @@ union_op:
   {
     $$ = UnionDistinctStr
   }
+| EXCEPT
+  {
+    $$ = ExceptStr
+  }
+| EXCEPT ALL
+  {
+    $$ = ExceptAllStr
+  }
+| EXCEPT DISTINCT
+  {
+    $$ = ExceptDistinctStr
+  }
+
+intersect_op:
+  INTERSECT
+  {
+    $$ = IntersectStr
+  }
+| INTERSECT ALL
+  {
+    $$ = IntersectAllStr
+  }
+| INTERSECT DISTINCT
+  {
+    $$ = IntersectDistinctStr
+  }
 
 cache_opt:
 {
@@ -1873,27 +1947,46 @@ select_expression_list:
   }
 
 select_expression:
-  '*'
+  '*' star_except_opt star_replace_opt
   {
-    $$ = &StarExpr{}
+    $$ = &StarExpr{Except: $2, Replace: $3}
   }
 | expression as_ci_opt
   {
     $$ = &AliasedExpr{Expr: $1, As: $2}
   }
-| table_id '.' '*'
+| table_id '.' '*' star_except_opt star_replace_opt
   {
-    $$ = &StarExpr{TableName: TableName{Name: $1}}
+    $$ = &StarExpr{TableName: TableName{Name: $1}, Except: $4, Replace: $5}
   }
-| table_id '.' reserved_table_id '.' '*'
+| table_id '.' reserved_table_id '.' '*' star_except_opt star_replace_opt
   {
-    $$ = &StarExpr{TableName: TableName{Qualifier: $1, Name: $3}}
+    $$ = &StarExpr{TableName: TableName{Qualifier: $1, Name: $3}, Except: $6, Replace: $7}
   }
 | value_expression JSON_EXPLODE_OP
   {
     $$ = &ObjectExplode{Object: $1}
   }
 
+star_except_opt:
+  %prec STAR_MODIFIER
+  {
+    $$ = nil
+  }
+| EXCEPT openb column_list closeb
+  {
+    $$ = $3
+  }
+
+star_replace_opt:
+  {
+    $$ = nil
+  }
+| REPLACE openb select_expression_list closeb
+  {
+    $$ = $3
+  }
+
 as_ci_opt:
   {
     $$ = ColIdent{}
@@ -1944,7 +2037,7 @@ table_factor:
   }
 | subquery as_opt table_id
   {
-    $$ = &AliasedTableExpr{Expr:$1, As: $3}
+    $$ = &AliasedTableExpr{Position: $<pos>1, Expr:$1, As: $3}
   }
 | subquery
   {
@@ -1958,17 +2051,25 @@ table_factor:
   }
 | ID openb table_valued_function_arguments_opt closeb as_opt table_id
   {
-    $$ = &TableValuedFunction{Name: NewColIdent(string($1)), Args: $3, As: $6}
+    $$ = &TableValuedFunction{Position: $<pos>1, Name: NewColIdent(string($1)), Args: $3, As: $6}
+  }
+| table_factor PIVOT openb select_expression_list FOR column_name IN openb select_expression_list closeb closeb as_opt_id
+  {
+    $$ = &PivotTableExpr{Source: $1, Aggregates: $4, Column: $6, Values: $9, As: $12}
+  }
+| table_factor UNPIVOT openb sql_id FOR sql_id IN openb column_list closeb closeb as_opt_id
+  {
+    $$ = &UnpivotTableExpr{Source: $1, ValueColumn: $4, NameColumn: $6, Columns: $9, As: $12}
   }
 
 aliased_table_name:
 table_name as_opt_id index_hint_list
   {
-    $$ = &AliasedTableExpr{Expr:$1, As: $2, Hints: $3}
+    $$ = &AliasedTableExpr{Position: $<pos>1, Expr:$1, As: $2, Hints: $3}
   }
 | table_name PARTITION openb partition_list closeb as_opt_id index_hint_list
   {
-    $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
+    $$ = &AliasedTableExpr{Position: $<pos>1, Expr:$1, Partitions: $4, As: $6, Hints: $7}
   }
 
 table_valued_function_arguments_opt:
@@ -2040,19 +2141,19 @@ partition_list:
 join_table:
   table_reference strategy_opt inner_join table_factor join_condition_opt
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Strategy: $2, Join: $3, RightExpr: $4, Condition: $5}
+    $$ = &JoinTableExpr{Position: $<pos>3, LeftExpr: $1, Strategy: $2, Join: $3, RightExpr: $4, Condition: $5}
   }
 | table_reference straight_join table_factor on_expression_opt
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = &JoinTableExpr{Position: $<pos>2, LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
   }
 | table_reference outer_join table_reference join_condition
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = &JoinTableExpr{Position: $<pos>2, LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
   }
 | table_reference natural_join table_factor
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3}
+    $$ = &JoinTableExpr{Position: $<pos>2, LeftExpr: $1, Join: $2, RightExpr: $3}
   }
 
 join_condition:
@@ -2154,10 +2255,13 @@ natural_join:
   }
 | NATURAL outer_join
   {
-    if $2 == LeftJoinStr {
+    switch $2 {
+    case LeftJoinStr:
       $$ = NaturalLeftJoinStr
-    } else {
+    case RightJoinStr:
       $$ = NaturalRightJoinStr
+    default:
+      $$ = NaturalOuterJoinStr
     }
   }
 
@@ -2234,6 +2338,14 @@ expression:
   {
     $$ = &IsExpr{Operator: $3, Expr: $1}
   }
+| expression IS DISTINCT FROM value_expression
+  {
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: IsDistinctFromStr, Right: $5}
+  }
+| expression IS NOT DISTINCT FROM value_expression
+  {
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: IsNotDistinctFromStr, Right: $6}
+  }
 | value_expression
   {
     $$ = $1
@@ -2266,47 +2378,47 @@ boolean_value:
 condition:
   value_expression compare value_expression
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: $2, Right: $3}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: $2, Right: $3}
   }
 | value_expression IN col_tuple
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: InStr, Right: $3}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: InStr, Right: $3}
   }
 | value_expression NOT IN col_tuple
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: NotInStr, Right: $4}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: NotInStr, Right: $4}
   }
 | value_expression LIKE value_expression like_escape_opt
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: LikeStr, Right: $3, Escape: $4}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: LikeStr, Right: $3, Escape: $4}
   }
 | value_expression NOT LIKE value_expression like_escape_opt
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: NotLikeStr, Right: $4, Escape: $5}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: NotLikeStr, Right: $4, Escape: $5}
   }
 | value_expression '~' value_expression
   {
- 	  $$ = &ComparisonExpr{Left: $1, Operator: LikeRegexpStr, Right: $3}
+ 	  $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: LikeRegexpStr, Right: $3}
   }
  | value_expression LIKE_REGEXP_CASE_INSENSITIVE value_expression
    {
-     $$ = &ComparisonExpr{Left: $1, Operator: LikeRegexpCaseInsensitiveStr, Right: $3}
+     $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: LikeRegexpCaseInsensitiveStr, Right: $3}
    }
 | value_expression NOT_LIKE_REGEXP value_expression
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: NotLikeRegexpStr, Right: $3}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: NotLikeRegexpStr, Right: $3}
   }
  | value_expression NOT_LIKE_REGEXP_CASE_INSENSITIVE value_expression
    {
-     $$ = &ComparisonExpr{Left: $1, Operator: NotLikeRegexpCaseInsensitiveStr, Right: $3}
+     $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: NotLikeRegexpCaseInsensitiveStr, Right: $3}
    }
 | value_expression REGEXP value_expression
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: RegexpStr, Right: $3}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: RegexpStr, Right: $3}
   }
 | value_expression NOT REGEXP value_expression
   {
-    $$ = &ComparisonExpr{Left: $1, Operator: NotRegexpStr, Right: $4}
+    $$ = &ComparisonExpr{Position: $<pos>2, Left: $1, Operator: NotRegexpStr, Right: $4}
   }
 | value_expression BETWEEN value_expression AND value_expression
   {
@@ -2439,51 +2551,51 @@ value_expression:
   }
 | value_expression '&' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: BitAndStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: BitAndStr, Right: $3}
   }
 | value_expression '|' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: BitOrStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: BitOrStr, Right: $3}
   }
 | value_expression '^' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: BitXorStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: BitXorStr, Right: $3}
   }
 | value_expression '+' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: PlusStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: PlusStr, Right: $3}
   }
 | value_expression '-' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: MinusStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: MinusStr, Right: $3}
   }
 | value_expression '*' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: MultStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: MultStr, Right: $3}
   }
 | value_expression '/' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: DivStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: DivStr, Right: $3}
   }
 | value_expression DIV value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: IntDivStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: IntDivStr, Right: $3}
   }
 | value_expression '%' value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: ModStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: ModStr, Right: $3}
   }
 | value_expression MOD value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: ModStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: ModStr, Right: $3}
   }
 | value_expression SHIFT_LEFT value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: ShiftLeftStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: ShiftLeftStr, Right: $3}
   }
 | value_expression SHIFT_RIGHT value_expression
   {
-    $$ = &BinaryExpr{Left: $1, Operator: ShiftRightStr, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: ShiftRightStr, Right: $3}
   }
 | value_expression COLLATE charset
   {
@@ -2545,15 +2657,67 @@ value_expression:
 | function_call_conflict
 | value_expression '[' value_expression ']'
   {
-    $$ = &BinaryExpr{Left: $1, Operator: ArrayElement, Right: $3}
+    $$ = &BinaryExpr{Position: $<pos>2, Left: $1, Operator: ArrayElement, Right: $3}
+  }
+| value_expression '[' value_expression ':' value_expression ']'
+  {
+    $$ = &SliceExpr{Position: $<pos>2, Expr: $1, From: $3, To: $5}
+  }
+| value_expression '[' value_expression ':' ']'
+  {
+    $$ = &SliceExpr{Position: $<pos>2, Expr: $1, From: $3}
+  }
+| value_expression '[' ':' value_expression ']'
+  {
+    $$ = &SliceExpr{Position: $<pos>2, Expr: $1, To: $4}
+  }
+| '[' expression_list ']'
+  {
+    $$ = &ListExpr{Position: $<pos>1, Exprs: $2}
+  }
+| LIST_TYPE
+  {
+    $$ = &ListExpr{Position: $<pos>1}
+  }
+| '{' object_field_list '}'
+  {
+    $$ = &ObjectExpr{Position: $<pos>1, Fields: $2}
+  }
+| OBJECT_TYPE
+  {
+    $$ = &ObjectExpr{Position: $<pos>1}
   }
 | value_expression LIST_ARG convert_type
   {
-    $$ = &ConvertExpr{Expr: $1, Type: $3}
+    $$ = &ConvertExpr{Position: $<pos>2, Expr: $1, Type: $3}
   }
 | value_expression JSON_EXTRACT_OP sql_id
   {
-    $$ = &ObjectFieldAccess{Object: $1, Field: $3}
+    $$ = &ObjectFieldAccess{Position: $<pos>2, Object: $1, Field: $3}
+  }
+| value_expression AT TIME ZONE value_expression %prec AT
+  {
+    $$ = &FuncExpr{Position: $<pos>2, Name: NewColIdent("at_time_zone"), Exprs: SelectExprs{&AliasedExpr{Expr: $1}, &AliasedExpr{Expr: $5}}}
+  }
+
+object_field_list:
+  object_field
+  {
+    $$ = []*ObjectField{$1}
+  }
+| object_field_list ',' object_field
+  {
+    $$ = append($1, $3)
+  }
+
+object_field:
+  STRING ':' expression
+  {
+    $$ = &ObjectField{Name: string($1), Value: $3}
+  }
+| sql_id ':' expression
+  {
+    $$ = &ObjectField{Name: $1.String(), Value: $3}
   }
 
 /*
@@ -2561,17 +2725,114 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb function_argument_list_opt closeb
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: $1, Exprs: $3}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Position: $<pos>1, Name: $1, Distinct: true, Exprs: $4}
   }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
-    $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
+    $$ = &FuncExpr{Position: $<pos>1, Qualifier: $1, Name: $3, Exprs: $5}
+  }
+| sql_id openb function_argument_list_opt closeb OVER openb over_clause closeb
+  {
+    $$ = &FuncExpr{Position: $<pos>1, Name: $1, Exprs: $3, Over: $7}
+  }
+
+function_argument_list_opt:
+  {
+    $$ = nil
+  }
+| function_argument_list
+  {
+    $$ = $1
+  }
+
+function_argument_list:
+  function_argument
+  {
+    $$ = SelectExprs{$1}
+  }
+| function_argument_list ',' function_argument
+  {
+    $$ = append($$, $3)
+  }
+
+function_argument:
+  select_expression
+  {
+    $$ = $1
+  }
+| lambda_expression
+  {
+    $$ = &AliasedExpr{Expr: $1}
+  }
+
+/*
+  Lambdas are only allowed as function arguments, where x -> y is parsed as a lambda instead of an object field access.
+  The parameters of a lambda with multiple parameters are parsed as a tuple.
+*/
+lambda_expression:
+  sql_id JSON_EXTRACT_OP expression
+  {
+    $$ = &LambdaExpr{Position: $<pos>2, Parameters: Exprs{&ColName{Name: $1, Position: $<pos>1}}, Body: $3}
+  }
+| tuple_expression JSON_EXTRACT_OP expression
+  {
+    $$ = &LambdaExpr{Position: $<pos>2, Parameters: lambdaParameters($1), Body: $3}
+  }
+
+over_clause:
+  partition_by_opt order_by_opt window_frame_opt
+  {
+    $$ = &OverClause{PartitionBy: $1, OrderBy: $2, Frame: $3}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+window_frame_opt:
+  {
+    $$ = nil
+  }
+| ROWS frame_bound
+  {
+    $$ = &WindowFrame{Start: $2, End: &FrameBound{Type: CurrentRowStr}}
+  }
+| ROWS BETWEEN frame_bound AND frame_bound
+  {
+    $$ = &WindowFrame{Start: $3, End: $5}
+  }
+
+frame_bound:
+  UNBOUNDED PRECEDING
+  {
+    $$ = &FrameBound{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FrameBound{Type: UnboundedFollowingStr}
+  }
+| CURRENT ROW
+  {
+    $$ = &FrameBound{Type: CurrentRowStr}
+  }
+| INTEGRAL PRECEDING
+  {
+    $$ = &FrameBound{Type: PrecedingStr, Offset: NewIntVal($1)}
+  }
+| INTEGRAL FOLLOWING
+  {
+    $$ = &FrameBound{Type: FollowingStr, Offset: NewIntVal($1)}
   }
 
 /*
@@ -2581,19 +2842,19 @@ function_call_generic:
 function_call_keyword:
   LEFT openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("left"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("left"), Exprs: $3}
   }
 | RIGHT openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("right"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("right"), Exprs: $3}
   }
 | CONVERT openb expression ',' convert_type closeb
   {
-    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    $$ = &ConvertExpr{Position: $<pos>1, Expr: $3, Type: $5}
   }
 | CAST openb expression AS convert_type closeb
   {
-    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    $$ = &ConvertExpr{Position: $<pos>1, Expr: $3, Type: $5}
   }
 | CONVERT openb expression USING charset closeb
   {
@@ -2631,6 +2892,11 @@ function_call_keyword:
   {
     $$ = &ValuesFuncExpr{Name: $3}
   }
+| EXTRACT openb sql_id FROM expression closeb
+  {
+    // EXTRACT(part FROM t) is just a different syntax for date_part('part', t).
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("date_part"), Exprs: SelectExprs{&AliasedExpr{Expr: NewStrVal([]byte($3.Lowered()))}, &AliasedExpr{Expr: $5}}}
+  }
 
 /*
   Function calls using non reserved keywords but with special syntax forms.
@@ -2639,41 +2905,41 @@ function_call_keyword:
 function_call_nonkeyword:
   CURRENT_TIMESTAMP func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("current_timestamp")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("current_timestamp")}
   }
 | UTC_TIMESTAMP func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("utc_timestamp")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("utc_timestamp")}
   }
 | UTC_TIME func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("utc_time")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("utc_time")}
   }
 /* doesn't support fsp */
 | UTC_DATE func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("utc_date")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("utc_date")}
   }
   // now
 | LOCALTIME func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("localtime")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("localtime")}
   }
   // now
 | LOCALTIMESTAMP func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("localtimestamp")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("localtimestamp")}
   }
   // curdate
 /* doesn't support fsp */
 | CURRENT_DATE func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("current_date")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("current_date")}
   }
   // curtime
 | CURRENT_TIME func_datetime_opt
   {
-    $$ = &FuncExpr{Name:NewColIdent("current_time")}
+    $$ = &FuncExpr{Position: $<pos>1, Name:NewColIdent("current_time")}
   }
 // these functions can also be called with an optional argument
 |  CURRENT_TIMESTAMP func_datetime_precision
@@ -2729,27 +2995,27 @@ func_datetime_precision:
 function_call_conflict:
   IF openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("if"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("if"), Exprs: $3}
   }
 | DATABASE openb select_expression_list_opt closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("database"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("database"), Exprs: $3}
   }
 | MOD openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("mod"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("mod"), Exprs: $3}
   }
 | REPLACE openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("replace"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("replace"), Exprs: $3}
   }
 | SUBSTR openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("substr"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("substr"), Exprs: $3}
   }
 | SUBSTRING openb select_expression_list closeb
   {
-    $$ = &FuncExpr{Name: NewColIdent("substr"), Exprs: $3}
+    $$ = &FuncExpr{Position: $<pos>1, Name: NewColIdent("substr"), Exprs: $3}
   }
 
 match_option:
@@ -2789,6 +3055,10 @@ convert_type:
   {
     $$ = &ConvertTypeSimple{Name: string($1)}
   }
+| INTERVAL
+  {
+    $$ = &ConvertTypeSimple{Name: string($1)}
+  }
 | non_reserved_keyword
   {
     $$ = &ConvertTypeSimple{Name: string($1)}
@@ -2848,15 +3118,15 @@ else_expression_opt:
 column_name:
   sql_id
   {
-    $$ = &ColName{Name: $1}
+    $$ = &ColName{Position: $<pos>1, Name: $1}
   }
 | table_id '.' reserved_sql_id
   {
-    $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
+    $$ = &ColName{Position: $<pos>1, Qualifier: TableName{Name: $1}, Name: $3}
   }
 | table_id '.' reserved_table_id '.' reserved_sql_id
   {
-    $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
+    $$ = &ColName{Position: $<pos>1, Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
   }
 
 value:
@@ -2886,7 +3156,7 @@ value:
   }
 | VALUE_ARG
   {
-    $$ = NewValArg($1)
+    $$ = &SQLVal{Type: ValArg, Val: $1, Position: $<pos>1}
   }
 | NULL
   {
@@ -2916,11 +3186,51 @@ group_by_opt:
   {
     $$ = nil
   }
-| GROUP BY expression_list
+| GROUP BY group_by_list
   {
     $$ = $3
   }
 
+group_by_list:
+  group_by_element
+  {
+    $$ = Exprs{$1}
+  }
+| group_by_list ',' group_by_element
+  {
+    $$ = append($1, $3)
+  }
+
+group_by_element:
+  expression
+  {
+    $$ = $1
+  }
+| GROUPING SETS openb grouping_set_list closeb
+  {
+    $$ = &GroupingSets{Sets: $4}
+  }
+
+grouping_set_list:
+  grouping_set
+  {
+    $$ = Exprs{$1}
+  }
+| grouping_set_list ',' grouping_set
+  {
+    $$ = append($1, $3)
+  }
+
+grouping_set:
+  expression
+  {
+    $$ = $1
+  }
+| openb closeb
+  {
+    $$ = ValTuple{}
+  }
+
 having_opt:
   {
     $$ = nil
@@ -3313,6 +3623,7 @@ reserved_keyword:
 | AND
 | AS
 | ASC
+| AT
 | AUTO_INCREMENT
 | BETWEEN
 | BINARY
@@ -3338,8 +3649,10 @@ reserved_keyword:
 | DROP
 | ELSE
 | ESCAPE
+| EXCEPT
 | EXISTS
 | EXPLAIN
+| EXTRACT
 | FALSE
 | FOR
 | FORCE
@@ -3352,6 +3665,7 @@ reserved_keyword:
 | INDEX
 | INNER
 | INSERT
+| INTERSECT
 | INTERVAL
 | INTO
 | IS
@@ -3375,6 +3689,7 @@ reserved_keyword:
 | OR
 | ORDER
 | OUTER
+| PIVOT
 | REGEXP
 | RENAME
 | REPLACE
@@ -3395,6 +3710,7 @@ reserved_keyword:
 | UNION
 | UNIQUE
 | UNLOCK
+| UNPIVOT
 | UPDATE
 | USE
 | USING
@@ -3433,6 +3749,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COUNTING
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3447,10 +3764,12 @@ non_reserved_keyword:
 | FIELDS
 | FLUSH
 | FOREIGN
+| FOLLOWING
 | FULLTEXT
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| GROUPING
 | INT
 | INTEGER
 | ISOLATION
@@ -3483,19 +3802,24 @@ non_reserved_keyword:
 | PLUGINS
 | POINT
 | POLYGON
+| PRECEDING
 | PRIMARY
 | PROCEDURE
 | QUERY
 | READ
 | REAL
+| RECURSIVE
 | REFERENCES
 | REORGANIZE
 | REPAIR
 | REPEATABLE
 | RESTRICT
 | ROLLBACK
+| ROW
+| ROWS
 | SCHEMAS
 | SESSION
+| SETS
 | SERIALIZABLE
 | SHARE
 | SIGNED
@@ -3513,6 +3837,7 @@ non_reserved_keyword:
 | TINYTEXT
 | TRANSACTION
 | TRUNCATE
+| UNBOUNDED
 | UNCOMMITTED
 | UNSIGNED
 | UNUSED
@@ -3533,6 +3858,7 @@ non_reserved_keyword:
 | WRITE
 | YEAR
 | ZEROFILL
+| ZONE
 
 openb:
   '('
@@ -24,7 +24,6 @@ package sqlparser
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"io"
 
@@ -46,7 +45,9 @@ type Tokenizer struct {
 	SkipToEnd           bool
 	lastChar            uint16
 	Position            int
+	tokenPosition       int
 	lastToken           []byte
+	lastTokenPosition   int
 	LastError           error
 	posVarIndex         int
 	ParseTree           Statement
@@ -58,6 +59,14 @@ type Tokenizer struct {
 	buf     []byte
 	bufPos  int
 	bufSize int
+
+	// brackets contains the brackets and braces the tokenizer is in, innermost last.
+	// It's true for brackets following an operand, which are subscripts or slices, like l[1:n].
+	brackets []bool
+	// lastTokenEndsOperand is true if the last token is a literal, an identifier or a closing bracket.
+	// Inside of brackets, a colon following such a token is a slice or object field separator, not a parameter.
+	lastTokenEndsOperand bool
+	lastTokenType        int
 }
 
 // NewStringTokenizer creates a new Tokenizer for the
@@ -102,6 +111,7 @@ var keywords = map[string]int{
 	"as":                  AS,
 	"asc":                 ASC,
 	"asensitive":          UNUSED,
+	"at":                  AT,
 	"auto_increment":      AUTO_INCREMENT,
 	"before":              UNUSED,
 	"begin":               BEGIN,
@@ -141,6 +151,7 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -180,10 +191,12 @@ var keywords = map[string]int{
 	"enum":                ENUM,
 	"escape":              ESCAPE,
 	"escaped":             UNUSED,
+	"except":              EXCEPT,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
 	"expansion":           EXPANSION,
+	"extract":             EXTRACT,
 	"false":               FALSE,
 	"fetch":               UNUSED,
 	"fields":              FIELDS,
@@ -191,6 +204,7 @@ var keywords = map[string]int{
 	"float4":              UNUSED,
 	"float8":              UNUSED,
 	"flush":               FLUSH,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
@@ -204,6 +218,7 @@ var keywords = map[string]int{
 	"global":              GLOBAL,
 	"grant":               UNUSED,
 	"group":               GROUP,
+	"grouping":            GROUPING,
 	"group_concat":        GROUP_CONCAT,
 	"having":              HAVING,
 	"high_priority":       UNUSED,
@@ -226,6 +241,7 @@ var keywords = map[string]int{
 	"int4":                UNUSED,
 	"int8":                UNUSED,
 	"integer":             INTEGER,
+	"intersect":           INTERSECT,
 	"interval":            INTERVAL,
 	"into":                INTO,
 	"io_after_gtids":      UNUSED,
@@ -298,10 +314,13 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"over":                OVER,
 	"partition":           PARTITION,
+	"pivot":               PIVOT,
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
@@ -311,6 +330,7 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
@@ -328,6 +348,8 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"schema":              SCHEMA,
 	"schemas":             SCHEMAS,
 	"second_microsecond":  UNUSED,
@@ -337,6 +359,7 @@ var keywords = map[string]int{
 	"serializable":        SERIALIZABLE,
 	"session":             SESSION,
 	"set":                 SET,
+	"sets":                SETS,
 	"share":               SHARE,
 	"show":                SHOW,
 	"signal":              UNUSED,
@@ -379,11 +402,13 @@ var keywords = map[string]int{
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
 	"unique":              UNIQUE,
 	"unlock":              UNLOCK,
+	"unpivot":             UNPIVOT,
 	"unsigned":            UNSIGNED,
 	"update":              UPDATE,
 	"usage":               UNUSED,
@@ -419,6 +444,7 @@ var keywords = map[string]int{
 	"year":                YEAR,
 	"year_month":          UNUSED,
 	"zerofill":            ZEROFILL,
+	"zone":                ZONE,
 }
 
 // keywordStrings contains the reverse mapping of token to keyword strings
@@ -464,10 +490,53 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		tkn.partialDDL = nil
 	}
 	lval.bytes = val
+	lval.pos = tkn.tokenPosition
 	tkn.lastToken = val
+	tkn.lastTokenPosition = tkn.tokenPosition
+	tkn.lastTokenType = typ
+	tkn.lastTokenEndsOperand = endsOperand(typ)
 	return typ
 }
 
+// endsOperand checks if the token may be the end of an operand.
+func endsOperand(typ int) bool {
+	switch typ {
+	case ID, STRING, INTEGRAL, FLOAT, HEXNUM, HEX, BIT_LITERAL, VALUE_ARG, NULL, TRUE, FALSE, LIST_TYPE, OBJECT_TYPE, ')', ']', '}':
+		return true
+	}
+	return false
+}
+
+// colonStartsParameter checks if a colon followed by a letter starts a parameter, like :name.
+// In a subscript, a colon right after the opening bracket starts a slice, like l[:n].
+// Otherwise, inside of brackets a colon following an operand is a slice or object field separator, like l[1:n] or {a:b}.
+func (tkn *Tokenizer) colonStartsParameter() bool {
+	if len(tkn.brackets) == 0 {
+		return true
+	}
+	if tkn.brackets[len(tkn.brackets)-1] && tkn.lastTokenType == '[' {
+		return false
+	}
+	return !tkn.lastTokenEndsOperand
+}
+
+func (tkn *Tokenizer) closeBracket() {
+	if len(tkn.brackets) > 0 {
+		tkn.brackets = tkn.brackets[:len(tkn.brackets)-1]
+	}
+}
+
+// PositionedError is a parsing error which occurred at a position in the parsed SQL.
+type PositionedError struct {
+	Message string
+	// Position is the 1-based byte offset of the token the error occurred at.
+	Position int
+}
+
+func (err *PositionedError) Error() string {
+	return err.Message
+}
+
 // Error is called by go yacc if there's a parsing error.
 func (tkn *Tokenizer) Error(err string) {
 	buf := &bytes2.Buffer{}
@@ -476,7 +545,7 @@ func (tkn *Tokenizer) Error(err string) {
 	} else {
 		fmt.Fprintf(buf, "%s at position %v", err, tkn.Position)
 	}
-	tkn.LastError = errors.New(buf.String())
+	tkn.LastError = &PositionedError{Message: buf.String(), Position: tkn.lastTokenPosition}
 
 	// Try and re-sync to the next statement
 	tkn.skipStatement()
@@ -502,6 +571,7 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenPosition = tkn.Position
 	switch ch := tkn.lastChar; {
 	case isLetter(ch):
 		tkn.next()
@@ -543,16 +613,20 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 				tkn.next()
 				return LIST_TYPE, nil
 			}
+			tkn.brackets = append(tkn.brackets, tkn.lastTokenEndsOperand)
 			return int(ch), nil
 		case ']':
+			tkn.closeBracket()
 			return int(ch), nil
 		case '{':
 			if tkn.lastChar == '}' {
 				tkn.next()
 				return OBJECT_TYPE, nil
 			}
+			tkn.brackets = append(tkn.brackets, false)
 			return int(ch), nil
 		case '}':
+			tkn.closeBracket()
 			return int(ch), nil
 		case '=', ',', '(', ')', '+', '*', '%', '^':
 			if tkn.lastChar == '>' {
@@ -597,6 +671,14 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 				tkn.next()
 				return LIST_ARG, nil
 			}
+			if isLetter(tkn.lastChar) && tkn.colonStartsParameter() {
+				return tkn.scanParameter(':', func(ch uint16) bool { return isLetter(ch) || isDigit(ch) })
+			}
+			return int(ch), nil
+		case '$':
+			if isDigit(tkn.lastChar) {
+				return tkn.scanParameter('$', isDigit)
+			}
 			return int(ch), nil
 		case '/':
 			switch tkn.lastChar {
@@ -810,6 +892,17 @@ func (tkn *Tokenizer) scanBindVar() (int, []byte) {
 	return token, buffer.Bytes()
 }
 
+// scanParameter scans a query parameter placeholder, like :name or $1, whose prefix has already been consumed.
+func (tkn *Tokenizer) scanParameter(prefix byte, isNameChar func(ch uint16) bool) (int, []byte) {
+	buffer := &bytes2.Buffer{}
+	buffer.WriteByte(prefix)
+	for isNameChar(tkn.lastChar) {
+		buffer.WriteByte(byte(tkn.lastChar))
+		tkn.next()
+	}
+	return VALUE_ARG, buffer.Bytes()
+}
+
 func (tkn *Tokenizer) scanMantissa(base int, buffer *bytes2.Buffer) {
 	for digitVal(tkn.lastChar) < base {
 		tkn.consumeNext(buffer)
@@ -50,6 +50,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 				Arguments: node.GroupBy.Key,
 			},
 		}, withTypeInfo))
+		if node.GroupBy.GroupingSets != nil {
+			out.AddField("grouping_sets", fmt.Sprint(node.GroupBy.GroupingSets))
+		}
 		out.AddChild("source", ExplainNode(node.GroupBy.Source, withTypeInfo))
 
 	case NodeTypeStreamJoin:
@@ -135,6 +138,49 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 		out.AddField("is_left_outer", fmt.Sprint(node.OuterJoin.IsLeft))
 		out.AddField("is_right_outer", fmt.Sprint(node.OuterJoin.IsRight))
 
+	case NodeTypeSemiJoin:
+		if node.SemiJoin.IsAnti {
+			out = graph.NewNode("anti join")
+		} else {
+			out = graph.NewNode("semi join")
+		}
+		out.AddChild("right", ExplainNode(node.SemiJoin.Right, withTypeInfo))
+		out.AddChild("left", ExplainNode(node.SemiJoin.Left, withTypeInfo))
+		out.AddChild("right_key", ExplainExpr(Expression{
+			ExpressionType: ExpressionTypeTuple,
+			Tuple: &Tuple{
+				Arguments: node.SemiJoin.RightKey,
+			},
+		}, withTypeInfo))
+		out.AddChild("left_key", ExplainExpr(Expression{
+			ExpressionType: ExpressionTypeTuple,
+			Tuple: &Tuple{
+				Arguments: node.SemiJoin.LeftKey,
+			},
+		}, withTypeInfo))
+
+	case NodeTypeWindow:
+		out = graph.NewNode("window")
+		for i := range node.Window.PartitionBy {
+			out.AddChild(fmt.Sprintf("partition_by_%d", i), ExplainExpr(node.Window.PartitionBy[i], withTypeInfo))
+		}
+		for i := range node.Window.OrderBy {
+			if node.Window.OrderByDirectionMultipliers[i] == 1 {
+				out.AddChild("asc", ExplainExpr(node.Window.OrderBy[i], withTypeInfo))
+			} else {
+				out.AddChild("desc", ExplainExpr(node.Window.OrderBy[i], withTypeInfo))
+			}
+		}
+		for i, function := range node.Window.Functions {
+			out.AddChild(fmt.Sprintf("%s_%d", function.Name, i), ExplainExpr(Expression{
+				ExpressionType: ExpressionTypeTuple,
+				Tuple: &Tuple{
+					Arguments: function.Arguments,
+				},
+			}, withTypeInfo))
+		}
+		out.AddChild("source", ExplainNode(node.Window.Source, withTypeInfo))
+
 	case NodeTypeOrderSensitiveTransform:
 		out = graph.NewNode("sort")
 		for i := range node.OrderSensitiveTransform.OrderByKey {
@@ -154,6 +200,33 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 			out.AddChild("source", prev)
 		}
 
+	case NodeTypeRecursiveCTE:
+		out = graph.NewNode("recursive cte")
+		out.AddField("name", node.RecursiveCTE.Name)
+		out.AddField("distinct", fmt.Sprint(node.RecursiveCTE.Distinct))
+		out.AddField("max_iterations", fmt.Sprint(node.RecursiveCTE.MaxIterations))
+		out.AddChild("anchor", ExplainNode(node.RecursiveCTE.Anchor, withTypeInfo))
+		out.AddChild("recursive", ExplainNode(node.RecursiveCTE.Recursive, withTypeInfo))
+
+	case NodeTypeRecursiveReference:
+		out = graph.NewNode("recursive reference")
+		out.AddField("name", node.RecursiveReference.Name)
+
+	case NodeTypeUnionAll:
+		out = graph.NewNode("union all")
+		out.AddChild("first", ExplainNode(node.UnionAll.First, withTypeInfo))
+		out.AddChild("second", ExplainNode(node.UnionAll.Second, withTypeInfo))
+
+	case NodeTypeSetOperation:
+		if node.SetOperation.IsExcept {
+			out = graph.NewNode("except")
+		} else {
+			out = graph.NewNode("intersect")
+		}
+		out.AddField("distinct", fmt.Sprint(node.SetOperation.Distinct))
+		out.AddChild("first", ExplainNode(node.SetOperation.First, withTypeInfo))
+		out.AddChild("second", ExplainNode(node.SetOperation.Second, withTypeInfo))
+
 	default:
 		panic("unexhaustive node type match")
 	}
@@ -208,18 +281,52 @@ func ExplainExpr(expr Expression, withTypeInfo bool) *graph.Node {
 		out = graph.NewNode("subquery")
 		out.AddChild("source", ExplainNode(expr.QueryExpression.Source, withTypeInfo))
 
+	case ExpressionTypeExists:
+		out = graph.NewNode("exists")
+		out.AddChild("source", ExplainNode(expr.Exists.Source, withTypeInfo))
+
 	case ExpressionTypeCoalesce:
 		out = graph.NewNode("coalesce")
 		for i := range expr.Coalesce.Arguments {
 			out.AddChild(fmt.Sprintf("arg_%d", i), ExplainExpr(expr.Coalesce.Arguments[i], withTypeInfo))
 		}
 
+	case ExpressionTypeCase:
+		out = graph.NewNode("case")
+		for i := range expr.Case.Conditions {
+			out.AddChild(fmt.Sprintf("when_%d", i), ExplainExpr(expr.Case.Conditions[i], withTypeInfo))
+			out.AddChild(fmt.Sprintf("then_%d", i), ExplainExpr(expr.Case.Values[i], withTypeInfo))
+		}
+		out.AddChild("else", ExplainExpr(expr.Case.Else, withTypeInfo))
+
 	case ExpressionTypeTuple:
 		out = graph.NewNode("tuple")
 		for i := range expr.Tuple.Arguments {
 			out.AddChild(fmt.Sprintf("arg_%d", i), ExplainExpr(expr.Tuple.Arguments[i], withTypeInfo))
 		}
 
+	case ExpressionTypeList:
+		out = graph.NewNode("list")
+		for i := range expr.List.Elements {
+			out.AddChild(fmt.Sprintf("element_%d", i), ExplainExpr(expr.List.Elements[i], withTypeInfo))
+		}
+
+	case ExpressionTypeObject:
+		out = graph.NewNode("object")
+		for i := range expr.Object.Values {
+			out.AddChild(expr.Object.Names[i], ExplainExpr(expr.Object.Values[i], withTypeInfo))
+		}
+
+	case ExpressionTypeListFunction:
+		out = graph.NewNode(expr.ListFunction.Name)
+		for i := range expr.ListFunction.Parameters {
+			out.AddField(fmt.Sprintf("parameter_%d", i), expr.ListFunction.Parameters[i].Name)
+		}
+		for i := range expr.ListFunction.Arguments {
+			out.AddChild(fmt.Sprintf("arg_%d", i), ExplainExpr(expr.ListFunction.Arguments[i], withTypeInfo))
+		}
+		out.AddChild("body", ExplainExpr(expr.ListFunction.Body, withTypeInfo))
+
 	case ExpressionTypeTypeAssertion:
 		out = graph.NewNode("type assertion")
 		out.AddField("type", expr.TypeAssertion.TargetType.String())
@@ -25,6 +25,11 @@ type Expression struct {
 	TypeAssertion     *TypeAssertion
 	TypeCast          *TypeCast
 	ObjectFieldAccess *ObjectFieldAccess
+	Case              *Case
+	Exists            *Exists
+	List              *List
+	Object            *Object
+	ListFunction      *ListFunction
 }
 
 type ExpressionType int
@@ -41,6 +46,11 @@ const (
 	ExpressionTypeTypeAssertion
 	ExpressionTypeTypeCast
 	ExpressionTypeObjectFieldAccess
+	ExpressionTypeCase
+	ExpressionTypeExists
+	ExpressionTypeList
+	ExpressionTypeObject
+	ExpressionTypeListFunction
 )
 
 func (t ExpressionType) String() string {
@@ -67,6 +77,16 @@ func (t ExpressionType) String() string {
 		return "cast"
 	case ExpressionTypeObjectFieldAccess:
 		return "object_field_access"
+	case ExpressionTypeCase:
+		return "case"
+	case ExpressionTypeExists:
+		return "exists"
+	case ExpressionTypeList:
+		return "list"
+	case ExpressionTypeObject:
+		return "object"
+	case ExpressionTypeListFunction:
+		return "list_function"
 	}
 	return "unknown"
 }
@@ -98,6 +118,10 @@ type QueryExpression struct {
 	Source Node
 }
 
+type Exists struct {
+	Source Node
+}
+
 type Coalesce struct {
 	Arguments []Expression
 }
@@ -121,6 +145,30 @@ type ObjectFieldAccess struct {
 	Field  string
 }
 
+type Case struct {
+	Conditions []Expression
+	Values     []Expression
+	Else       Expression
+}
+
+type List struct {
+	Elements []Expression
+}
+
+type Object struct {
+	Names  []string
+	Values []Expression
+}
+
+// ListFunction is a higher-order function, which evaluates the lambda body for the elements of the list in the first argument.
+type ListFunction struct {
+	Name      string
+	Arguments []Expression
+	// Parameters are the lambda parameters, the body gets evaluated with them as an additional record.
+	Parameters []SchemaField
+	Body       Expression
+}
+
 func (expr *Expression) Materialize(ctx context.Context, env Environment) (execution.Expression, error) {
 	switch expr.ExpressionType {
 	case ExpressionTypeVariable:
@@ -157,7 +205,15 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 				}
 			}
 		}
-		return execution.NewFunctionCall(expr.FunctionCall.FunctionDescriptor.Function, expressions, nullCheckIndices), nil
+		function := expr.FunctionCall.FunctionDescriptor.Function
+		if expr.FunctionCall.FunctionDescriptor.TypedFunction != nil {
+			argumentTypes := make([]octosql.Type, len(expr.FunctionCall.Arguments))
+			for i := range expr.FunctionCall.Arguments {
+				argumentTypes[i] = expr.FunctionCall.Arguments[i].Type
+			}
+			function = expr.FunctionCall.FunctionDescriptor.TypedFunction(argumentTypes, expr.Type)
+		}
+		return execution.NewFunctionCall(function, expressions, nullCheckIndices), nil
 	case ExpressionTypeAnd:
 		expressions := make([]execution.Expression, len(expr.And.Arguments))
 		for i := range expr.And.Arguments {
@@ -193,6 +249,13 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		} else {
 			return execution.NewSingleColumnQueryExpression(source), nil
 		}
+	case ExpressionTypeExists:
+		source, err := expr.Exists.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize exists source: %w", err)
+		}
+
+		return execution.NewExists(source), nil
 	case ExpressionTypeCoalesce:
 		expressions := make([]execution.Expression, len(expr.Coalesce.Arguments))
 		for i := range expr.Coalesce.Arguments {
@@ -263,6 +326,90 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		}
 
 		return execution.NewObjectFieldAccess(object, fieldIndex), nil
+	case ExpressionTypeCase:
+		conditions := make([]execution.Expression, len(expr.Case.Conditions))
+		for i := range expr.Case.Conditions {
+			expression, err := expr.Case.Conditions[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE condition with index %d: %w", i, err)
+			}
+			conditions[i] = expression
+		}
+		values := make([]execution.Expression, len(expr.Case.Values))
+		for i := range expr.Case.Values {
+			expression, err := expr.Case.Values[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE value with index %d: %w", i, err)
+			}
+			values[i] = expression
+		}
+		elseValue, err := expr.Case.Else.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize CASE else value: %w", err)
+		}
+		sourceTypes := make([]octosql.Type, len(expr.Case.Values)+1)
+		for i := range expr.Case.Values {
+			sourceTypes[i] = expr.Case.Values[i].Type
+		}
+		sourceTypes[len(expr.Case.Values)] = expr.Case.Else.Type
+
+		return execution.NewCase(conditions, values, elseValue, execution.NewObjectLayoutFixer(expr.Type, sourceTypes)), nil
+	case ExpressionTypeList:
+		elements := make([]execution.Expression, len(expr.List.Elements))
+		for i := range expr.List.Elements {
+			expression, err := expr.List.Elements[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize list element with index %d: %w", i, err)
+			}
+			elements[i] = expression
+		}
+		if len(elements) == 0 {
+			return execution.NewList(elements, nil), nil
+		}
+		sourceTypes := make([]octosql.Type, len(expr.List.Elements))
+		for i := range expr.List.Elements {
+			sourceTypes[i] = expr.List.Elements[i].Type
+		}
+
+		return execution.NewList(elements, execution.NewObjectLayoutFixer(*expr.Type.List.Element, sourceTypes)), nil
+	case ExpressionTypeObject:
+		values := make([]execution.Expression, len(expr.Object.Values))
+		for i := range expr.Object.Values {
+			expression, err := expr.Object.Values[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize object field %s: %w", expr.Object.Names[i], err)
+			}
+			values[i] = expression
+		}
+
+		return execution.NewObject(values), nil
+	case ExpressionTypeListFunction:
+		arguments := make([]execution.Expression, len(expr.ListFunction.Arguments))
+		for i := range expr.ListFunction.Arguments {
+			expression, err := expr.ListFunction.Arguments[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize %s argument with index %d: %w", expr.ListFunction.Name, i, err)
+			}
+			arguments[i] = expression
+		}
+		body, err := expr.ListFunction.Body.Materialize(ctx, env.WithRecordSchema(NewSchema(expr.ListFunction.Parameters, -1)))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize %s lambda body: %w", expr.ListFunction.Name, err)
+		}
+
+		switch expr.ListFunction.Name {
+		case "list_transform":
+			return execution.NewListTransform(arguments[0], body), nil
+		case "list_filter":
+			return execution.NewListFilter(arguments[0], body), nil
+		case "list_reduce":
+			return execution.NewListReduce(arguments[0], arguments[1], body), nil
+		case "list_any":
+			return execution.NewListMatch(arguments[0], body, false), nil
+		case "list_all":
+			return execution.NewListMatch(arguments[0], body, true), nil
+		}
+		panic(fmt.Sprintf("unknown list function: %s", expr.ListFunction.Name))
 	}
 
 	panic("unexhaustive expression type match")
@@ -329,6 +476,47 @@ func (expr Expression) variablesUsed(acc map[string]struct{}) {
 	case ExpressionTypeTypeCast:
 		expr.TypeCast.Expression.variablesUsed(acc)
 		return
+	case ExpressionTypeCase:
+		for i := range expr.Case.Conditions {
+			expr.Case.Conditions[i].variablesUsed(acc)
+			expr.Case.Values[i].variablesUsed(acc)
+		}
+		expr.Case.Else.variablesUsed(acc)
+		return
+	case ExpressionTypeList:
+		for _, element := range expr.List.Elements {
+			element.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeObject:
+		for _, value := range expr.Object.Values {
+			value.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeListFunction:
+		for _, arg := range expr.ListFunction.Arguments {
+			arg.variablesUsed(acc)
+		}
+		bodyAcc := make(map[string]struct{})
+		expr.ListFunction.Body.variablesUsed(bodyAcc)
+		for _, parameter := range expr.ListFunction.Parameters {
+			delete(bodyAcc, parameter.Name)
+		}
+		for name := range bodyAcc {
+			acc[name] = struct{}{}
+		}
+		return
+	case ExpressionTypeExists:
+		// The subquery may reference variables from outer scopes anywhere in its tree.
+		(&Transformers{
+			ExpressionTransformer: func(expr Expression) Expression {
+				if expr.ExpressionType == ExpressionTypeVariable {
+					acc[expr.Variable.Name] = struct{}{}
+				}
+				return expr
+			},
+		}).TransformNode(expr.Exists.Source)
+		return
 	}
 
 	panic("unexhaustive expression type match")
@@ -28,6 +28,12 @@ type Node struct {
 	InMemoryRecords         *InMemoryRecords
 	OuterJoin               *OuterJoin
 	OrderSensitiveTransform *OrderSensitiveTransform
+	UnionAll                *UnionAll
+	SemiJoin                *SemiJoin
+	Window                  *Window
+	RecursiveCTE            *RecursiveCTE
+	RecursiveReference      *RecursiveReference
+	SetOperation            *SetOperation
 }
 
 type Schema struct {
@@ -79,6 +85,12 @@ const (
 	NodeTypeInMemoryRecords
 	NodeTypeOuterJoin
 	NodeTypeOrderSensitiveTransform
+	NodeTypeUnionAll
+	NodeTypeSemiJoin
+	NodeTypeWindow
+	NodeTypeRecursiveCTE
+	NodeTypeRecursiveReference
+	NodeTypeSetOperation
 )
 
 func (t NodeType) String() string {
@@ -109,6 +121,18 @@ func (t NodeType) String() string {
 		return "outer_join"
 	case NodeTypeOrderSensitiveTransform:
 		return "order_sensitive_transform"
+	case NodeTypeUnionAll:
+		return "union_all"
+	case NodeTypeSemiJoin:
+		return "semi_join"
+	case NodeTypeWindow:
+		return "window"
+	case NodeTypeRecursiveCTE:
+		return "recursive_cte"
+	case NodeTypeRecursiveReference:
+		return "recursive_reference"
+	case NodeTypeSetOperation:
+		return "set_operation"
 	}
 	return "unknown"
 }
@@ -178,6 +202,17 @@ type GroupBy struct {
 	// Either index to Key, or -1, if none.
 	KeyEventTimeIndex int
 	Trigger           Trigger
+	// GroupingSets lists the indices of the Key expressions each grouping set groups by, or is nil for a normal grouping.
+	// With grouping sets, the key fields are followed by the index of the grouping set the record belongs to.
+	GroupingSets [][]int
+}
+
+// KeyFieldCount returns the number of output fields preceding the aggregates.
+func (node *GroupBy) KeyFieldCount() int {
+	if node.GroupingSets != nil {
+		return len(node.Key) + 1
+	}
+	return len(node.Key)
 }
 
 type Aggregate struct {
@@ -277,6 +312,68 @@ type OrderSensitiveTransform struct {
 	Limit                       *Expression
 }
 
+type UnionAll struct {
+	First, Second Node
+}
+
+// SemiJoin outputs the records of the left side which have (or, if IsAnti is set, don't have)
+// a matching record on the right side.
+type SemiJoin struct {
+	Left, Right       Node
+	LeftKey, RightKey []Expression
+	IsAnti            bool
+}
+
+// Window appends the values of the window functions to each record of the source.
+type Window struct {
+	Source                      Node
+	PartitionBy                 []Expression
+	OrderBy                     []Expression
+	OrderByDirectionMultipliers []int
+	Functions                   []WindowFunction
+}
+
+type WindowFunction struct {
+	Name      string
+	Arguments []Expression
+	// Aggregate is set if an aggregate is used as the window function.
+	Aggregate *Aggregate
+	// Frame is nil for the default frame.
+	Frame *WindowFrame
+}
+
+// WindowFrame describes the rows a window function is computed over, as offsets relative to the current row.
+// A nil bound is unbounded.
+type WindowFrame struct {
+	Start, End *int
+}
+
+// RecursiveCTE evaluates a recursive common table expression.
+// The recursive part is evaluated repeatedly over the records produced by the previous iteration, until no new records get produced.
+type RecursiveCTE struct {
+	Name      string
+	Anchor    Node
+	Recursive Node
+	// Distinct is set for UNION, which discards records which have already been produced.
+	Distinct bool
+	// MaxIterations is the maximum number of iterations of the recursive part.
+	MaxIterations int
+}
+
+// RecursiveReference is a reference to the records produced by the previous iteration of the recursive common table expression with the given name.
+type RecursiveReference struct {
+	Name string
+}
+
+// SetOperation outputs the records present in both sources (INTERSECT) or, if IsExcept is set,
+// the records of the first source which aren't present in the second one (EXCEPT).
+type SetOperation struct {
+	First, Second Node
+	IsExcept      bool
+	// Distinct discards duplicates, otherwise the sources are treated as multisets.
+	Distinct bool
+}
+
 func (node *Node) Materialize(ctx context.Context, env Environment) (execution.Node, error) {
 	switch node.NodeType {
 	case NodeTypeDatasource:
@@ -337,12 +434,12 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			}
 			expressions[i] = expr
 		}
-		if node.GroupBy.Trigger.TriggerType == TriggerTypeEndOfStream {
+		if node.GroupBy.Trigger.TriggerType == TriggerTypeEndOfStream && node.GroupBy.GroupingSets == nil {
 			return nodes.NewSimpleGroupBy(aggregates, expressions, key, source), nil
 		}
 		trigger := node.GroupBy.Trigger.Materialize(ctx, env)
 
-		return nodes.NewCustomTriggerGroupBy(aggregates, expressions, key, node.GroupBy.KeyEventTimeIndex, source, trigger), nil
+		return nodes.NewCustomTriggerGroupBy(aggregates, expressions, key, node.GroupBy.GroupingSets, node.GroupBy.KeyEventTimeIndex, source, trigger), nil
 	case NodeTypeStreamJoin:
 		left, err := node.StreamJoin.Left.Materialize(ctx, env)
 		if err != nil {
@@ -371,6 +468,129 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		}
 
 		return nodes.NewStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
+	case NodeTypeSemiJoin:
+		left, err := node.SemiJoin.Left.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize left join source: %w", err)
+		}
+		right, err := node.SemiJoin.Right.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize right join source: %w", err)
+		}
+
+		leftKeyExprs := make([]execution.Expression, len(node.SemiJoin.LeftKey))
+		for i := range node.SemiJoin.LeftKey {
+			expr, err := node.SemiJoin.LeftKey[i].Materialize(ctx, env.WithRecordSchema(node.SemiJoin.Left.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize semi join left key expression with index %d: %w", i, err)
+			}
+			leftKeyExprs[i] = expr
+		}
+		rightKeyExprs := make([]execution.Expression, len(node.SemiJoin.RightKey))
+		for i := range node.SemiJoin.RightKey {
+			expr, err := node.SemiJoin.RightKey[i].Materialize(ctx, env.WithRecordSchema(node.SemiJoin.Right.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize semi join right key expression with index %d: %w", i, err)
+			}
+			rightKeyExprs[i] = expr
+		}
+
+		return nodes.NewSemiJoin(left, right, leftKeyExprs, rightKeyExprs, node.SemiJoin.IsAnti), nil
+	case NodeTypeWindow:
+		source, err := node.Window.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize window source: %w", err)
+		}
+		partitionByExprs := make([]execution.Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			expr, err := node.Window.PartitionBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize partition by expression with index %d: %w", i, err)
+			}
+			partitionByExprs[i] = expr
+		}
+		orderByKeyExprs := make([]execution.Expression, len(node.Window.OrderBy))
+		for i := range node.Window.OrderBy {
+			expr, err := node.Window.OrderBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize order by key with index %d: %w", i, err)
+			}
+			orderByKeyExprs[i] = expr
+		}
+		functions := make([]nodes.WindowFunction, len(node.Window.Functions))
+		for i, function := range node.Window.Functions {
+			args := make([]execution.Expression, len(function.Arguments))
+			for j := range function.Arguments {
+				expr, err := function.Arguments[j].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize window function %s argument with index %d: %w", function.Name, j, err)
+				}
+				args[j] = expr
+			}
+			var frame *nodes.WindowFrame
+			if function.Frame != nil {
+				frame = &nodes.WindowFrame{
+					Start: function.Frame.Start,
+					End:   function.Frame.End,
+				}
+			}
+
+			if function.Aggregate != nil {
+				functions[i] = nodes.NewWindowAggregate(function.Aggregate.AggregateDescriptor.Prototype, args[0], frame)
+				continue
+			}
+			switch function.Name {
+			case "row_number":
+				functions[i] = nodes.NewRowNumber()
+			case "rank":
+				functions[i] = nodes.NewRank(false)
+			case "dense_rank":
+				functions[i] = nodes.NewRank(true)
+			case "lag", "lead":
+				// The offset is validated to be a constant during typechecking.
+				functions[i] = nodes.NewLag(args[0], function.Arguments[1].Constant.Value.Int, args[2], function.Name == "lead")
+			case "first_value":
+				functions[i] = nodes.NewFrameValue(args[0], frame, false)
+			case "last_value":
+				functions[i] = nodes.NewFrameValue(args[0], frame, true)
+			default:
+				return nil, fmt.Errorf("unknown window function: %s", function.Name)
+			}
+		}
+
+		return nodes.NewWindow(source, partitionByExprs, orderByKeyExprs, node.Window.OrderByDirectionMultipliers, functions, node.Schema.TimeField), nil
+	case NodeTypeRecursiveCTE:
+		anchor, err := node.RecursiveCTE.Anchor.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize recursive common table expression anchor: %w", err)
+		}
+		input := nodes.NewRecursiveCTEInput()
+		recursiveEnv := env
+		recursiveEnv.RecursiveCTEInputs = make(map[string]*nodes.RecursiveCTEInput, len(env.RecursiveCTEInputs)+1)
+		for k, v := range env.RecursiveCTEInputs {
+			recursiveEnv.RecursiveCTEInputs[k] = v
+		}
+		recursiveEnv.RecursiveCTEInputs[node.RecursiveCTE.Name] = input
+		recursive, err := node.RecursiveCTE.Recursive.Materialize(ctx, recursiveEnv)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize recursive part of common table expression: %w", err)
+		}
+
+		objectLayoutFixers := make([]*execution.ObjectLayoutFixer, len(node.Schema.Fields))
+		for i := range node.Schema.Fields {
+			objectLayoutFixers[i] = execution.NewObjectLayoutFixer(node.Schema.Fields[i].Type, []octosql.Type{
+				node.RecursiveCTE.Anchor.Schema.Fields[i].Type,
+				node.RecursiveCTE.Recursive.Schema.Fields[i].Type,
+			})
+		}
+
+		return nodes.NewRecursiveCTE(anchor, recursive, input, node.RecursiveCTE.Distinct, node.RecursiveCTE.MaxIterations, objectLayoutFixers), nil
+	case NodeTypeRecursiveReference:
+		input, ok := env.RecursiveCTEInputs[node.RecursiveReference.Name]
+		if !ok {
+			return nil, fmt.Errorf("recursive common table expression %s not found", node.RecursiveReference.Name)
+		}
+		return input, nil
 	case NodeTypeLookupJoin:
 		source, err := node.LookupJoin.Source.Materialize(ctx, env)
 		if err != nil {
@@ -487,6 +707,46 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 
 		// Probably shouldn't happen...
 		return source, nil
+
+	case NodeTypeUnionAll:
+		first, err := node.UnionAll.First.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize first union source: %w", err)
+		}
+		second, err := node.UnionAll.Second.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize second union source: %w", err)
+		}
+
+		objectLayoutFixers := make([]*execution.ObjectLayoutFixer, len(node.Schema.Fields))
+		for i := range node.Schema.Fields {
+			objectLayoutFixers[i] = execution.NewObjectLayoutFixer(node.Schema.Fields[i].Type, []octosql.Type{
+				node.UnionAll.First.Schema.Fields[i].Type,
+				node.UnionAll.Second.Schema.Fields[i].Type,
+			})
+		}
+
+		return nodes.NewUnionAll(first, second, objectLayoutFixers), nil
+
+	case NodeTypeSetOperation:
+		first, err := node.SetOperation.First.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize first set operation source: %w", err)
+		}
+		second, err := node.SetOperation.Second.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize second set operation source: %w", err)
+		}
+
+		objectLayoutFixers := make([]*execution.ObjectLayoutFixer, len(node.Schema.Fields))
+		for i := range node.Schema.Fields {
+			objectLayoutFixers[i] = execution.NewObjectLayoutFixer(node.Schema.Fields[i].Type, []octosql.Type{
+				node.SetOperation.First.Schema.Fields[i].Type,
+				node.SetOperation.Second.Schema.Fields[i].Type,
+			})
+		}
+
+		return nodes.NewSetOperation(first, second, node.SetOperation.IsExcept, node.SetOperation.Distinct, objectLayoutFixers), nil
 	}
 
 	panic(fmt.Sprintf("unexhaustive node type match: %d", node.NodeType))
@@ -17,6 +17,8 @@ type Environment struct {
 	Functions       map[string]FunctionDetails
 	PhysicalConfig  map[string]interface{}
 	VariableContext *VariableContext
+	// RecursiveCTEInputs holds the inputs of recursive common table expression references during materialization.
+	RecursiveCTEInputs map[string]*nodes.RecursiveCTEInput
 }
 
 func (env Environment) WithRecordSchema(schema Schema) Environment {
@@ -90,9 +92,18 @@ type DatasourceImplementation interface {
 	PushDownPredicates(newPredicates, pushedDownPredicates []Expression) (rejected, pushedDown []Expression, changed bool)
 }
 
+// UnboundedDatasourceImplementation may be implemented by datasources which can keep producing records forever, like tailed files.
+type UnboundedDatasourceImplementation interface {
+	Unbounded() bool
+}
+
 type FunctionDetails struct {
 	Description string
 	Descriptors []FunctionDescriptor
+
+	// NonDeterministic functions may return different results for the same arguments, like now or uuid.
+	// Calls to them must never be deduplicated nor constant-folded.
+	NonDeterministic bool
 }
 
 type FunctionDescriptor struct {
@@ -101,4 +112,11 @@ type FunctionDescriptor struct {
 	TypeFn        func([]octosql.Type) (octosql.Type, bool) `json:"-"`
 	Strict        bool
 	Function      func([]octosql.Value) (octosql.Value, error) `json:"-"`
+
+	// ConstantTypeFn is used instead of TypeFn if set.
+	// It additionally gets the values of the arguments which are constants, nil for the other ones.
+	ConstantTypeFn func([]octosql.Type, []*octosql.Value) (octosql.Type, bool) `json:"-"`
+	// TypedFunction is used instead of Function if set.
+	// It gets the types of the arguments and the output type, and returns the function to use for them.
+	TypedFunction func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) `json:"-"`
 }
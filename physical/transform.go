@@ -72,6 +72,7 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Key:                  key,
 				KeyEventTimeIndex:    node.GroupBy.KeyEventTimeIndex,
 				Trigger:              node.GroupBy.Trigger,
+				GroupingSets:         node.GroupBy.GroupingSets,
 			},
 		}
 	case NodeTypeStreamJoin:
@@ -205,6 +206,65 @@ func (t *Transformers) TransformNode(node Node) Node {
 			},
 		}
 
+	case NodeTypeSemiJoin:
+		leftKey := make([]Expression, len(node.SemiJoin.LeftKey))
+		for i := range node.SemiJoin.LeftKey {
+			leftKey[i] = t.TransformExpr(node.SemiJoin.LeftKey[i])
+		}
+		rightKey := make([]Expression, len(node.SemiJoin.RightKey))
+		for i := range node.SemiJoin.RightKey {
+			rightKey[i] = t.TransformExpr(node.SemiJoin.RightKey[i])
+		}
+
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			SemiJoin: &SemiJoin{
+				Left:     t.TransformNode(node.SemiJoin.Left),
+				Right:    t.TransformNode(node.SemiJoin.Right),
+				LeftKey:  leftKey,
+				RightKey: rightKey,
+				IsAnti:   node.SemiJoin.IsAnti,
+			},
+		}
+
+	case NodeTypeWindow:
+		partitionBy := make([]Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			partitionBy[i] = t.TransformExpr(node.Window.PartitionBy[i])
+		}
+		orderBy := make([]Expression, len(node.Window.OrderBy))
+		for i := range node.Window.OrderBy {
+			orderBy[i] = t.TransformExpr(node.Window.OrderBy[i])
+		}
+		orderByDirectionMultipliers := make([]int, len(node.Window.OrderByDirectionMultipliers))
+		copy(orderByDirectionMultipliers, node.Window.OrderByDirectionMultipliers)
+		functions := make([]WindowFunction, len(node.Window.Functions))
+		for i, function := range node.Window.Functions {
+			arguments := make([]Expression, len(function.Arguments))
+			for j := range function.Arguments {
+				arguments[j] = t.TransformExpr(function.Arguments[j])
+			}
+			functions[i] = WindowFunction{
+				Name:      function.Name,
+				Arguments: arguments,
+				Aggregate: function.Aggregate,
+				Frame:     function.Frame,
+			}
+		}
+
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			Window: &Window{
+				Source:                      t.TransformNode(node.Window.Source),
+				PartitionBy:                 partitionBy,
+				OrderBy:                     orderBy,
+				OrderByDirectionMultipliers: orderByDirectionMultipliers,
+				Functions:                   functions,
+			},
+		}
+
 	case NodeTypeOrderSensitiveTransform:
 		orderByKeyExprs := make([]Expression, len(node.OrderSensitiveTransform.OrderByKey))
 		for i := range node.OrderSensitiveTransform.OrderByKey {
@@ -229,6 +289,46 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Limit:                       limit,
 			},
 		}
+	case NodeTypeRecursiveCTE:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			RecursiveCTE: &RecursiveCTE{
+				Name:          node.RecursiveCTE.Name,
+				Anchor:        t.TransformNode(node.RecursiveCTE.Anchor),
+				Recursive:     t.TransformNode(node.RecursiveCTE.Recursive),
+				Distinct:      node.RecursiveCTE.Distinct,
+				MaxIterations: node.RecursiveCTE.MaxIterations,
+			},
+		}
+	case NodeTypeRecursiveReference:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			RecursiveReference: &RecursiveReference{
+				Name: node.RecursiveReference.Name,
+			},
+		}
+	case NodeTypeUnionAll:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			UnionAll: &UnionAll{
+				First:  t.TransformNode(node.UnionAll.First),
+				Second: t.TransformNode(node.UnionAll.Second),
+			},
+		}
+	case NodeTypeSetOperation:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			SetOperation: &SetOperation{
+				First:    t.TransformNode(node.SetOperation.First),
+				Second:   t.TransformNode(node.SetOperation.Second),
+				IsExcept: node.SetOperation.IsExcept,
+				Distinct: node.SetOperation.Distinct,
+			},
+		}
 	default:
 		panic("unexhaustive node type match")
 	}
@@ -309,6 +409,14 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 				Source: t.TransformNode(expr.QueryExpression.Source),
 			},
 		}
+	case ExpressionTypeExists:
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Exists: &Exists{
+				Source: t.TransformNode(expr.Exists.Source),
+			},
+		}
 	case ExpressionTypeCoalesce:
 		arguments := make([]Expression, len(expr.Coalesce.Arguments))
 		for i := range expr.Coalesce.Arguments {
@@ -322,6 +430,68 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 				Arguments: arguments,
 			},
 		}
+	case ExpressionTypeCase:
+		conditions := make([]Expression, len(expr.Case.Conditions))
+		for i := range expr.Case.Conditions {
+			conditions[i] = t.TransformExpr(expr.Case.Conditions[i])
+		}
+		values := make([]Expression, len(expr.Case.Values))
+		for i := range expr.Case.Values {
+			values[i] = t.TransformExpr(expr.Case.Values[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Case: &Case{
+				Conditions: conditions,
+				Values:     values,
+				Else:       t.TransformExpr(expr.Case.Else),
+			},
+		}
+	case ExpressionTypeList:
+		elements := make([]Expression, len(expr.List.Elements))
+		for i := range expr.List.Elements {
+			elements[i] = t.TransformExpr(expr.List.Elements[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			List: &List{
+				Elements: elements,
+			},
+		}
+	case ExpressionTypeObject:
+		values := make([]Expression, len(expr.Object.Values))
+		for i := range expr.Object.Values {
+			values[i] = t.TransformExpr(expr.Object.Values[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Object: &Object{
+				Names:  expr.Object.Names,
+				Values: values,
+			},
+		}
+	case ExpressionTypeListFunction:
+		arguments := make([]Expression, len(expr.ListFunction.Arguments))
+		for i := range expr.ListFunction.Arguments {
+			arguments[i] = t.TransformExpr(expr.ListFunction.Arguments[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			ListFunction: &ListFunction{
+				Name:       expr.ListFunction.Name,
+				Arguments:  arguments,
+				Parameters: expr.ListFunction.Parameters,
+				Body:       t.TransformExpr(expr.ListFunction.Body),
+			},
+		}
 	case ExpressionTypeTuple:
 		arguments := make([]Expression, len(expr.Tuple.Arguments))
 		for i := range expr.Tuple.Arguments {
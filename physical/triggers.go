@@ -2,6 +2,7 @@ package physical
 
 import (
 	"context"
+	"time"
 
 	"github.com/cube2222/octosql/execution"
 )
@@ -10,6 +11,7 @@ type Trigger struct {
 	TriggerType TriggerType
 	// Only one of the below may be non-null.
 	CountingTrigger    *CountingTrigger
+	DelayTrigger       *DelayTrigger
 	EndOfStreamTrigger *EndOfStreamTrigger
 	WatermarkTrigger   *WatermarkTrigger
 	MultiTrigger       *MultiTrigger
@@ -22,6 +24,7 @@ const (
 	TriggerTypeEndOfStream
 	TriggerTypeWatermark
 	TriggerTypeMulti
+	TriggerTypeDelay
 )
 
 func (t TriggerType) String() string {
@@ -34,6 +37,8 @@ func (t TriggerType) String() string {
 		return "watermark"
 	case TriggerTypeMulti:
 		return "multi"
+	case TriggerTypeDelay:
+		return "delay"
 	}
 	return "unknown"
 }
@@ -42,6 +47,10 @@ type CountingTrigger struct {
 	TriggerAfter uint
 }
 
+type DelayTrigger struct {
+	Delay time.Duration
+}
+
 type EndOfStreamTrigger struct {
 }
 
@@ -67,6 +76,8 @@ func (t *Trigger) Materialize(ctx context.Context, env Environment) func() execu
 			prototypes[i] = t.MultiTrigger.Triggers[i].Materialize(ctx, env)
 		}
 		return execution.NewMultiTriggerPrototype(prototypes)
+	case TriggerTypeDelay:
+		return execution.NewDelayTriggerPrototype(t.DelayTrigger.Delay)
 	}
 
 	panic("unexhaustive trigger type match")
@@ -76,7 +87,7 @@ func (t *Trigger) Materialize(ctx context.Context, env Environment) func() execu
 // In other words, if a single key can be triggered multiple times.
 func (t *Trigger) NoRetractions() bool {
 	switch t.TriggerType {
-	case TriggerTypeCounting, TriggerTypeMulti:
+	case TriggerTypeCounting, TriggerTypeMulti, TriggerTypeDelay:
 		return false
 	case TriggerTypeEndOfStream, TriggerTypeWatermark:
 		return true
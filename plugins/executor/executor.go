@@ -258,7 +258,7 @@ func (p *PhysicalDatasource) PushDownPredicates(newPredicates, pushedDownPredica
 func containsSubquery(expr physical.Expression) (out bool) {
 	(&physical.Transformers{
 		ExpressionTransformer: func(expr physical.Expression) physical.Expression {
-			if expr.ExpressionType == physical.ExpressionTypeQueryExpression {
+			if expr.ExpressionType == physical.ExpressionTypeQueryExpression || expr.ExpressionType == physical.ExpressionTypeExists {
 				out = true
 			}
 			return expr
@@ -335,7 +335,11 @@ func (e *ExecutionDatasource) Run(ctx execution.ExecutionContext, produce execut
 			return fmt.Errorf("couldn't get next message from plugin message stream: %w", err)
 		}
 		if msg.Record != nil {
-			if err := produce(produceCtx, msg.Record.ToNativeRecord()); err != nil {
+			record, err := msg.Record.ToNativeRecord()
+			if err != nil {
+				return fmt.Errorf("couldn't decode record from plugin: %w", err)
+			}
+			if err := produce(produceCtx, record); err != nil {
 				// TODO: Now we can't bubble up the error back to the plugin, which is different than with other streams. Is this a problem?
 				return fmt.Errorf("couldn't produce record: %w", err)
 			}
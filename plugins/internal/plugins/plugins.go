@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -15,16 +16,19 @@ import (
 
 const APILevel = 2
 
-func (x *Record) ToNativeRecord() execution.Record {
+func (x *Record) ToNativeRecord() (execution.Record, error) {
 	values := make([]octosql.Value, len(x.Values))
 	for i := range x.Values {
-		values[i] = x.Values[i].ToNativeValue()
+		var err error
+		if values[i], err = x.Values[i].ToNativeValue(); err != nil {
+			return execution.Record{}, fmt.Errorf("invalid value with index %d: %w", i, err)
+		}
 	}
 	return execution.Record{
 		Values:     values,
 		Retraction: x.Retraction,
 		EventTime:  x.EventTime.AsTime(),
-	}
+	}, nil
 }
 
 func NativeRecordToProto(record execution.Record) *Record {
@@ -71,6 +75,12 @@ func NativeValueToProto(value octosql.Value) *Value {
 		out.Time = timestamppb.New(value.Time)
 	case octosql.TypeIDDuration:
 		out.Duration = durationpb.New(value.Duration)
+	case octosql.TypeIDDecimal:
+		out.Decimal = octosql.FormatDecimal(value.Decimal)
+	case octosql.TypeIDInterval:
+		out.IntervalMonths = int64(value.Interval.Months)
+		out.IntervalDays = int64(value.Interval.Days)
+		out.Duration = durationpb.New(value.Interval.Duration)
 	case octosql.TypeIDList:
 		elements := make([]*Value, len(value.List))
 		for i := range value.List {
@@ -96,7 +106,7 @@ func NativeValueToProto(value octosql.Value) *Value {
 	return out
 }
 
-func (x *Value) ToNativeValue() octosql.Value {
+func (x *Value) ToNativeValue() (octosql.Value, error) {
 	out := octosql.Value{
 		TypeID: octosql.TypeID(x.TypeId),
 	}
@@ -114,28 +124,49 @@ func (x *Value) ToNativeValue() octosql.Value {
 		out.Time = x.Time.AsTime()
 	case octosql.TypeIDDuration:
 		out.Duration = x.Duration.AsDuration()
+	case octosql.TypeIDDecimal:
+		d, err := decimal.NewFromString(x.Decimal)
+		if err != nil {
+			return octosql.Value{}, fmt.Errorf("couldn't parse decimal '%s': %w", x.Decimal, err)
+		}
+		out.Decimal = d
+	case octosql.TypeIDInterval:
+		out.Interval = octosql.CalendarInterval{
+			Months:   int(x.IntervalMonths),
+			Days:     int(x.IntervalDays),
+			Duration: x.Duration.AsDuration(),
+		}
 	case octosql.TypeIDList:
 		elements := make([]octosql.Value, len(x.List))
 		for i := range x.List {
-			elements[i] = x.List[i].ToNativeValue()
+			var err error
+			if elements[i], err = x.List[i].ToNativeValue(); err != nil {
+				return octosql.Value{}, err
+			}
 		}
 		out.List = elements
 	case octosql.TypeIDStruct:
 		elements := make([]octosql.Value, len(x.Struct))
 		for i := range x.Struct {
-			elements[i] = x.Struct[i].ToNativeValue()
+			var err error
+			if elements[i], err = x.Struct[i].ToNativeValue(); err != nil {
+				return octosql.Value{}, err
+			}
 		}
 		out.Struct = elements
 	case octosql.TypeIDTuple:
 		elements := make([]octosql.Value, len(x.Tuple))
 		for i := range x.Tuple {
-			elements[i] = x.Tuple[i].ToNativeValue()
+			var err error
+			if elements[i], err = x.Tuple[i].ToNativeValue(); err != nil {
+				return octosql.Value{}, err
+			}
 		}
 		out.Tuple = elements
 	default:
 		panic(fmt.Sprintf("invalid type to proto: %v %v", x.TypeId, x))
 	}
-	return out
+	return out, nil
 }
 
 func NativeSchemaToProto(schema physical.Schema) *Schema {
@@ -173,7 +204,10 @@ func NativeTypeToProto(t octosql.Type) *Type {
 		TypeId: int32(t.TypeID),
 	}
 	switch t.TypeID {
-	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDAny:
+	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDInterval, octosql.TypeIDAny:
+	case octosql.TypeIDDecimal:
+		out.DecimalPrecision = int32(t.Decimal.Precision)
+		out.DecimalScale = int32(t.Decimal.Scale)
 	case octosql.TypeIDList:
 		if t.List.Element != nil {
 			out.List = NativeTypeToProto(*t.List.Element)
@@ -210,7 +244,10 @@ func (x *Type) ToNativeType() octosql.Type {
 		TypeID: octosql.TypeID(x.TypeId),
 	}
 	switch octosql.TypeID(x.TypeId) {
-	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDAny:
+	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDInterval, octosql.TypeIDAny:
+	case octosql.TypeIDDecimal:
+		out.Decimal.Precision = int(x.DecimalPrecision)
+		out.Decimal.Scale = int(x.DecimalScale)
 	case octosql.TypeIDList:
 		if x.List != nil {
 			t := x.List.ToNativeType()
@@ -298,19 +335,22 @@ func NativeExecutionVariableContextToProto(c *execution.VariableContext) *Execut
 	}
 }
 
-func (x *ExecutionVariableContext) ToNativeExecutionVariableContext() *execution.VariableContext {
+func (x *ExecutionVariableContext) ToNativeExecutionVariableContext() (*execution.VariableContext, error) {
 	var out *execution.VariableContext
 	for i := len(x.Frames) - 1; i >= 0; i-- {
 		values := make([]octosql.Value, len(x.Frames[i].Values))
 		for j := range x.Frames[i].Values {
-			values[j] = x.Frames[i].Values[j].ToNativeValue()
+			var err error
+			if values[j], err = x.Frames[i].Values[j].ToNativeValue(); err != nil {
+				return nil, fmt.Errorf("invalid variable value with index %d: %w", j, err)
+			}
 		}
 		out = &execution.VariableContext{
 			Values: values,
 			Parent: out,
 		}
 	}
-	return out
+	return out, nil
 }
 
 func RepopulatePhysicalExpressionFunctions(expr physical.Expression) (physical.Expression, bool) {
@@ -331,8 +371,41 @@ func RepopulatePhysicalExpressionFunctions(expr physical.Expression) (physical.E
 				return expr
 			}
 
+			argumentTypes := make([]octosql.Type, len(expr.FunctionCall.Arguments))
+			for i := range expr.FunctionCall.Arguments {
+				argumentTypes[i] = expr.FunctionCall.Arguments[i].Type
+				if receivedDescriptor.Strict {
+					argumentTypes[i] = octosql.NonNullable(argumentTypes[i])
+				}
+			}
+
+			// Descriptors with a TypeFn don't have a serializable signature,
+			// so we match them the same way the typechecker does, picking the last one that matches.
+			var typeFnDescriptor *physical.FunctionDescriptor
+			for i, descriptor := range details.Descriptors {
+				if descriptor.TypeFn == nil || receivedDescriptor.ArgumentTypes != nil {
+					continue
+				}
+				if descriptor.Strict != receivedDescriptor.Strict {
+					continue
+				}
+				if _, ok := descriptor.TypeFn(argumentTypes); ok {
+					typeFnDescriptor = &details.Descriptors[i]
+				}
+			}
+			if typeFnDescriptor != nil {
+				expr.FunctionCall.FunctionDescriptor.TypeFn = typeFnDescriptor.TypeFn
+				expr.FunctionCall.FunctionDescriptor.Function = typeFnDescriptor.Function
+				expr.FunctionCall.FunctionDescriptor.TypedFunction = typeFnDescriptor.TypedFunction
+				return expr
+			}
+
 		descriptorLoop:
 			for _, descriptor := range details.Descriptors {
+				// Descriptors with a ConstantTypeFn depend on the constant arguments, so they can't be matched here either.
+				if descriptor.TypeFn != nil || descriptor.ConstantTypeFn != nil {
+					continue descriptorLoop
+				}
 				if len(descriptor.ArgumentTypes) != len(receivedDescriptor.ArgumentTypes) {
 					continue descriptorLoop
 				}
@@ -349,11 +422,12 @@ func RepopulatePhysicalExpressionFunctions(expr physical.Expression) (physical.E
 				}
 				expr.FunctionCall.FunctionDescriptor.TypeFn = descriptor.TypeFn
 				expr.FunctionCall.FunctionDescriptor.Function = descriptor.Function
+				expr.FunctionCall.FunctionDescriptor.TypedFunction = descriptor.TypedFunction
 				return expr
 			}
 
 			log.Printf("Unknown function signature, rejecting predicate: %s", expr.FunctionCall.Name)
-			ok = false
+			outOk = false
 			return expr
 		},
 	}).TransformExpr(expr)
@@ -1,12 +1,17 @@
 package plugins
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/functions"
+	"github.com/cube2222/octosql/logical"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
@@ -56,6 +61,10 @@ func TestPhysicalVariableContext(t *testing.T) {
 						Name: "test9",
 						Type: octosql.Null,
 					},
+					{
+						Name: "test10",
+						Type: octosql.NewDecimalType(10, 2),
+					},
 				},
 				Parent: nil,
 			},
@@ -78,18 +87,93 @@ func TestExecutionVariableContext(t *testing.T) {
 			Values: []octosql.Value{
 				octosql.NewDuration(time.Second * 3),
 				octosql.NewBoolean(false),
+				octosql.NewInterval(octosql.CalendarInterval{Months: 14, Days: -2, Duration: time.Hour + time.Millisecond}),
 			},
 			Parent: &execution.VariableContext{
 				Values: []octosql.Value{
 					octosql.NewString("test2"),
 					octosql.NewNull(),
+					octosql.NewDecimal(decimal.RequireFromString("12.50")),
 				},
 				Parent: nil,
 			},
 		},
 	}
 
-	outC := NativeExecutionVariableContextToProto(c).ToNativeExecutionVariableContext()
+	outC, err := NativeExecutionVariableContextToProto(c).ToNativeExecutionVariableContext()
+	assert.NoError(t, err)
 
 	assert.Equal(t, c, outC)
 }
+
+func TestInvalidDecimalValue(t *testing.T) {
+	value := NativeValueToProto(octosql.NewList([]octosql.Value{octosql.NewDecimal(decimal.RequireFromString("1.5"))}))
+	value.List[0].Decimal = "1.5.5"
+
+	_, err := value.ToNativeValue()
+	assert.Error(t, err)
+}
+
+func TestRepopulatePhysicalExpressionFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		expr logical.Expression
+		want octosql.Value
+	}{
+		{
+			name: "not in tuple",
+			expr: logical.NewFunctionExpression("not in", []logical.Expression{
+				logical.NewConstant(octosql.NewInt(2)),
+				logical.NewTuple([]logical.Expression{
+					logical.NewConstant(octosql.NewInt(1)),
+					logical.NewConstant(octosql.NewInt(2)),
+				}),
+			}),
+			want: octosql.NewBoolean(false),
+		},
+		{
+			name: "between",
+			expr: logical.NewFunctionExpression("between", []logical.Expression{
+				logical.NewConstant(octosql.NewInt(3)),
+				logical.NewConstant(octosql.NewInt(1)),
+				logical.NewConstant(octosql.NewInt(2)),
+			}),
+			want: octosql.NewBoolean(false),
+		},
+		{
+			name: "is not distinct from",
+			expr: logical.NewFunctionExpression("is not distinct from", []logical.Expression{
+				logical.NewConstant(octosql.NewNull()),
+				logical.NewConstant(octosql.NewNull()),
+			}),
+			want: octosql.NewBoolean(true),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := physical.Environment{
+				Functions:       functions.FunctionMap(),
+				VariableContext: &physical.VariableContext{},
+			}
+			logicalEnv := logical.Environment{
+				UniqueVariableNames: &logical.VariableMapping{},
+				UniqueNameGenerator: map[string]int{},
+			}
+			expr := tt.expr.Typecheck(context.Background(), env, logicalEnv)
+
+			data, err := json.Marshal(expr)
+			assert.NoError(t, err)
+			var received physical.Expression
+			assert.NoError(t, json.Unmarshal(data, &received))
+
+			repopulated, ok := RepopulatePhysicalExpressionFunctions(received)
+			assert.True(t, ok)
+
+			materialized, err := repopulated.Materialize(context.Background(), env)
+			assert.NoError(t, err)
+			value, err := materialized.Evaluate(execution.ExecutionContext{Context: context.Background()})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+}
@@ -147,11 +147,15 @@ type executionServer struct {
 }
 
 func (e *executionServer) Run(request *plugins.RunRequest, stream plugins.ExecutionDatasource_RunServer) error {
+	variableContext, err := request.VariableContext.ToNativeExecutionVariableContext()
+	if err != nil {
+		return fmt.Errorf("couldn't decode variable context: %w", err)
+	}
 	// TODO: Maybe run this asynchronously here, like in JOIN? This way the serialization overhead will be separate from what's underneath.
 	if err := e.node.Run(
 		execution.ExecutionContext{
 			Context:         stream.Context(),
-			VariableContext: request.VariableContext.ToNativeExecutionVariableContext(),
+			VariableContext: variableContext,
 		},
 		func(ctx execution.ProduceContext, record execution.Record) error {
 			if err := stream.Send(&plugins.RunResponseMessage{
@@ -3,6 +3,7 @@ package table_valued_functions
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/logical"
@@ -49,7 +50,8 @@ var Tumble = logical.TableValuedFunctionDescription{
 					Required:                               true,
 					TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeExpression,
 					Expression: &logical.TableValuedFunctionArgumentMatcherExpression{
-						Type: octosql.Duration,
+						// A calendar interval of whole months creates windows following calendar months.
+						Type: octosql.TypeSum(octosql.Duration, octosql.Interval),
 					},
 				},
 				"time_field": {
@@ -172,11 +174,22 @@ func (t *tumble) Run(ctx execution.ExecutionContext, produce execution.ProduceFn
 	if err != nil {
 		return fmt.Errorf("couldn't evaluate offset: %w", err)
 	}
+	if windowLength.TypeID == octosql.TypeIDInterval {
+		if windowLength.Interval.Months <= 0 || windowLength.Interval.Days != 0 || windowLength.Interval.Duration != 0 {
+			return fmt.Errorf("calendar window_length must be a positive number of months, is %s, use a Duration otherwise", windowLength.Interval)
+		}
+	}
 
 	if err := t.source.Run(ctx, func(ctx execution.ProduceContext, record execution.Record) error {
 		timeValue := record.Values[t.timeFieldIndex].Time
-		windowStart := timeValue.Add(-1 * offset.Duration).Truncate(windowLength.Duration).Add(offset.Duration)
-		windowEnd := windowStart.Add(windowLength.Duration)
+		var windowStart, windowEnd time.Time
+		if windowLength.TypeID == octosql.TypeIDInterval {
+			windowStart = calendarWindowStart(timeValue.Add(-1*offset.Duration), windowLength.Interval.Months).Add(offset.Duration)
+			windowEnd = windowLength.Interval.AddTo(windowStart.Add(-1 * offset.Duration)).Add(offset.Duration)
+		} else {
+			windowStart = timeValue.Add(-1 * offset.Duration).Truncate(windowLength.Duration).Add(offset.Duration)
+			windowEnd = windowStart.Add(windowLength.Duration)
+		}
 		record.Values = append(record.Values, octosql.NewTime(windowStart), octosql.NewTime(windowEnd))
 
 		if err := produce(ctx, record); err != nil {
@@ -190,3 +203,11 @@ func (t *tumble) Run(ctx execution.ExecutionContext, produce execution.ProduceFn
 
 	return nil
 }
+
+// calendarWindowStart returns the start of the window of the given number of months which contains the time.
+// Windows are aligned to January of year 0 in the time zone of the time, so e.g. quarters start with the year.
+func calendarWindowStart(t time.Time, months int) time.Time {
+	monthIndex := t.Year()*12 + int(t.Month()-1)
+	monthIndex -= monthIndex % months
+	return time.Date(monthIndex/12, time.Month(monthIndex%12+1), 1, 0, 0, 0, 0, t.Location())
+}